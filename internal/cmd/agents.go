@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/constants"
@@ -30,12 +31,107 @@ const (
 	AgentPolecat
 )
 
+// agentTypeNames are the string forms of the agent types, used for display
+// and JSON.
+var agentTypeNames = map[AgentType]string{
+	AgentMayor:    "mayor",
+	AgentDeacon:   "deacon",
+	AgentWitness:  "witness",
+	AgentRefinery: "refinery",
+	AgentCrew:     "crew",
+	AgentPolecat:  "polecat",
+}
+
+// String returns the agent type's name, e.g. "witness".
+func (t AgentType) String() string {
+	if name, ok := agentTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("AgentType(%d)", int(t))
+}
+
+// Valid reports whether t is one of the known agent types.
+func (t AgentType) Valid() bool {
+	_, ok := agentTypeNames[t]
+	return ok
+}
+
+// parseAgentType returns the agent type with the given name.
+func parseAgentType(name string) (AgentType, error) {
+	for t, n := range agentTypeNames {
+		if n == name {
+			return t, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown agent type %q", name)
+}
+
+// MarshalJSON encodes the agent type as its name.
+func (t AgentType) MarshalJSON() ([]byte, error) {
+	if !t.Valid() {
+		return nil, fmt.Errorf("unknown agent type %d", int(t))
+	}
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON decodes an agent type from its name.
+func (t *AgentType) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return fmt.Errorf("agent type must be a string: %w", err)
+	}
+	parsed, err := parseAgentType(name)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
 // AgentSession represents a categorized tmux session.
 type AgentSession struct {
 	Name      string
 	Type      AgentType
-	Rig       string // For rig-specific agents
-	AgentName string // e.g., crew name, polecat name
+	Rig       string    // For rig-specific agents
+	AgentName string    // e.g., crew name, polecat name
+	CreatedAt time.Time // tmux session creation time (zero if unknown)
+}
+
+// NewAgentSession returns the session for an agent of type typ. rig and
+// agentName may be empty for agents that don't have them. Returns an error
+// if typ isn't a known agent type.
+func NewAgentSession(name string, typ AgentType, rig, agentName string) (*AgentSession, error) {
+	if !typ.Valid() {
+		return nil, fmt.Errorf("session %s: unknown agent type %d", name, int(typ))
+	}
+	return &AgentSession{Name: name, Type: typ, Rig: rig, AgentName: agentName}, nil
+}
+
+// Age returns how long ago the session was created, or 0 if CreatedAt is unknown.
+func (a *AgentSession) Age(now time.Time) time.Duration {
+	if a.CreatedAt.IsZero() {
+		return 0
+	}
+	return now.Sub(a.CreatedAt)
+}
+
+// sessionCreatedAt returns when a tmux session was created, from
+// #{session_created}. Returns the zero time if tmux can't report it.
+func sessionCreatedAt(t *tmux.Tmux, name string) time.Time {
+	created, err := t.GetSessionCreatedUnix(name)
+	if err != nil {
+		return time.Time{}
+	}
+	return unixCreatedAt(created)
+}
+
+// unixCreatedAt converts a #{session_created} value to a time, with the zero
+// time for a missing (0) value.
+func unixCreatedAt(created int64) time.Time {
+	if created <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(created, 0)
 }
 
 // AgentTypeColors maps agent types to tmux color codes.
@@ -126,45 +222,65 @@ func init() {
 	rootCmd.AddCommand(agentsCmd)
 }
 
+// roleAgentTypes maps session roles to the agent types gt agents shows.
+var roleAgentTypes = map[session.Role]AgentType{
+	session.RoleMayor:    AgentMayor,
+	session.RoleDeacon:   AgentDeacon,
+	session.RoleWitness:  AgentWitness,
+	session.RoleRefinery: AgentRefinery,
+	session.RoleCrew:     AgentCrew,
+	session.RolePolecat:  AgentPolecat,
+}
+
 // categorizeSession determines the agent type from a session name.
+// Returns nil if the name isn't an agent session.
 func categorizeSession(name string) *AgentSession {
-	sess := &AgentSession{Name: name}
-
 	identity, err := session.ParseSessionName(name)
 	if err != nil {
 		return nil
 	}
-
-	sess.Rig = identity.Rig
-	sess.AgentName = identity.Name
-
-	switch identity.Role {
-	case session.RoleMayor:
-		sess.Type = AgentMayor
-	case session.RoleDeacon:
-		sess.Type = AgentDeacon
-	case session.RoleWitness:
-		sess.Type = AgentWitness
-	case session.RoleRefinery:
-		sess.Type = AgentRefinery
-	case session.RoleCrew:
-		sess.Type = AgentCrew
-	case session.RolePolecat:
-		sess.Type = AgentPolecat
-	default:
+	typ, ok := roleAgentTypes[identity.Role]
+	if !ok {
+		return nil
+	}
+	sess, err := NewAgentSession(name, typ, identity.Rig, identity.Name)
+	if err != nil {
 		return nil
 	}
-
 	return sess
 }
 
+// SessionLister lists the running Gas Town agent sessions.
+// Commands that resolve targets take one so tests can run without tmux.
+type SessionLister interface {
+	ListSessions() ([]*AgentSession, error)
+}
+
+// TmuxSessionLister lists agent sessions (including polecats) from the tmux server.
+type TmuxSessionLister struct{}
+
+// ListSessions returns all categorized Gas Town sessions.
+func (TmuxSessionLister) ListSessions() ([]*AgentSession, error) {
+	return getAgentSessions(true)
+}
+
+// nudgeSessionLister is where gt nudge gets sessions to match patterns
+// against. Tests replace it with a fixed list of sessions.
+var nudgeSessionLister SessionLister = TmuxSessionLister{}
+
 // getAgentSessions returns all categorized Gas Town sessions.
 func getAgentSessions(includePolecats bool) ([]*AgentSession, error) {
-	t := tmux.NewTmux()
-	sessions, err := t.ListSessions()
+	// One list-sessions call gets every creation time, rather than a
+	// display-message per session
+	created, err := tmux.NewTmux().ListSessionsCreated()
 	if err != nil {
 		return nil, err
 	}
+	sessions := make([]string, 0, len(created))
+	for name := range created {
+		sessions = append(sessions, name)
+	}
+	sort.Strings(sessions)
 
 	var agents []*AgentSession
 	for _, name := range sessions {
@@ -179,6 +295,7 @@ func getAgentSessions(includePolecats bool) ([]*AgentSession, error) {
 		if agent.Name == session.BootSessionName() {
 			continue
 		}
+		agent.CreatedAt = unixCreatedAt(created[name])
 		agents = append(agents, agent)
 	}
 
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// staticSessionLister returns a fixed list of sessions.
+type staticSessionLister []*AgentSession
+
+// ListSessions returns the fixed sessions.
+func (s staticSessionLister) ListSessions() ([]*AgentSession, error) {
+	return s, nil
+}
+
+func TestAgentTypeJSONRoundTrip(t *testing.T) {
+	for typ, name := range agentTypeNames {
+		if typ.String() != name {
+			t.Errorf("%d.String() = %q, want %q", int(typ), typ.String(), name)
+		}
+
+		data, err := json.Marshal(typ)
+		if err != nil {
+			t.Fatalf("Marshal(%s): %v", name, err)
+		}
+		if string(data) != `"`+name+`"` {
+			t.Errorf("Marshal(%s) = %s, want the quoted name", name, data)
+		}
+
+		var got AgentType
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", data, err)
+		}
+		if got != typ {
+			t.Errorf("round trip of %s = %v", name, got)
+		}
+	}
+
+	// Agent types inside structs use the string form too
+	data, err := json.Marshal(struct {
+		Type AgentType `json:"type"`
+	}{AgentRefinery})
+	if err != nil || string(data) != `{"type":"refinery"}` {
+		t.Errorf("struct Marshal = %s, %v", data, err)
+	}
+}
+
+func TestAgentTypeJSONErrors(t *testing.T) {
+	if _, err := json.Marshal(AgentType(99)); err == nil {
+		t.Error("Marshal of an unknown agent type should fail")
+	}
+	if s := AgentType(99).String(); s != "AgentType(99)" {
+		t.Errorf("String of unknown type = %q", s)
+	}
+
+	var typ AgentType
+	for _, bad := range []string{`"dog"`, `3`, `null`} {
+		if err := json.Unmarshal([]byte(bad), &typ); err == nil {
+			t.Errorf("Unmarshal(%s) should fail", bad)
+		}
+	}
+}
+
+func TestNewAgentSession(t *testing.T) {
+	sess, err := NewAgentSession("gt-crew-max", AgentCrew, "gastown", "max")
+	if err != nil {
+		t.Fatalf("NewAgentSession: %v", err)
+	}
+	if sess.Name != "gt-crew-max" || sess.Type != AgentCrew || sess.Rig != "gastown" || sess.AgentName != "max" {
+		t.Errorf("NewAgentSession = %+v", sess)
+	}
+
+	for _, typ := range []AgentType{AgentPolecat + 1, -1, 99} {
+		if typ.Valid() {
+			t.Errorf("AgentType(%d).Valid() = true, want false", int(typ))
+		}
+		if _, err := NewAgentSession("gt-toast", typ, "gastown", "toast"); err == nil {
+			t.Errorf("NewAgentSession with type %d should fail", int(typ))
+		}
+	}
+	for typ := AgentMayor; typ <= AgentPolecat; typ++ {
+		if !typ.Valid() {
+			t.Errorf("%s.Valid() = false, want true", typ)
+		}
+	}
+}
@@ -45,8 +45,13 @@ Examples:
 }
 
 func runBroadcast(cmd *cobra.Command, args []string) error {
-	message := args[0]
+	return broadcastNudge(args[0], broadcastRig, broadcastAll, broadcastDryRun)
+}
 
+// broadcastNudge nudges every running worker, optionally scoped to one rig.
+// With includeAll, infrastructure agents (mayor, deacon, witness, refinery)
+// are nudged too. The sender's own session is always skipped.
+func broadcastNudge(message, rigName string, includeAll, dryRun bool) error {
 	if message == "" {
 		return fmt.Errorf("message cannot be empty")
 	}
@@ -64,12 +69,12 @@ func runBroadcast(cmd *cobra.Command, args []string) error {
 	var targets []*AgentSession
 	for _, agent := range agents {
 		// Filter by rig if specified
-		if broadcastRig != "" && agent.Rig != broadcastRig {
+		if rigName != "" && agent.Rig != rigName {
 			continue
 		}
 
 		// Unless --all, only include workers (crew + polecats)
-		if !broadcastAll {
+		if !includeAll {
 			if agent.Type != AgentCrew && agent.Type != AgentPolecat {
 				continue
 			}
@@ -84,15 +89,19 @@ func runBroadcast(cmd *cobra.Command, args []string) error {
 	}
 
 	if len(targets) == 0 {
-		fmt.Println("No workers running to broadcast to.")
-		if broadcastRig != "" {
-			fmt.Printf("  (filtered by rig: %s)\n", broadcastRig)
+		if includeAll {
+			fmt.Println("No agents running to broadcast to.")
+		} else {
+			fmt.Println("No workers running to broadcast to.")
+		}
+		if rigName != "" {
+			fmt.Printf("  (filtered by rig: %s)\n", rigName)
 		}
 		return nil
 	}
 
 	// Dry run - just show what would be sent
-	if broadcastDryRun {
+	if dryRun {
 		fmt.Printf("Would broadcast to %d agent(s):\n\n", len(targets))
 		for _, agent := range targets {
 			fmt.Printf("  %s %s\n", AgentTypeIcons[agent.Type], formatAgentName(agent))
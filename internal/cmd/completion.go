@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// completeRigNames is a cobra ValidArgsFunction that suggests registered rig
+// names for the first positional argument.
+func completeRigNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	// Completion may run before persistentPreRun has loaded rigs.json,
+	// so build the registry directly from the town.
+	reg := session.DefaultRegistry()
+	if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
+		if r, err := session.BuildPrefixRegistryFromTown(townRoot); err == nil {
+			reg = r
+		}
+	}
+
+	return rigNameCompletions(reg, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// rigNameCompletions returns registered rig names that start with toComplete.
+func rigNameCompletions(reg *session.PrefixRegistry, toComplete string) []string {
+	var names []string
+	for _, e := range reg.List() {
+		if strings.HasPrefix(e.RigName, toComplete) {
+			names = append(names, e.RigName)
+		}
+	}
+	return names
+}
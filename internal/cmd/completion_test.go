@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+func TestRigNameCompletions(t *testing.T) {
+	reg := session.NewPrefixRegistry()
+	reg.Register("gt", "gastown")
+	reg.Register("gp", "greenplace")
+	reg.Register("bd", "beads")
+
+	got := rigNameCompletions(reg, "g")
+	if len(got) != 2 || got[0] != "gastown" || got[1] != "greenplace" {
+		t.Errorf("rigNameCompletions(g) = %v, want [gastown greenplace]", got)
+	}
+
+	if got := rigNameCompletions(session.NewPrefixRegistry(), ""); len(got) != 0 {
+		t.Errorf("empty registry completions = %v, want none", got)
+	}
+}
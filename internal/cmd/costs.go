@@ -34,6 +34,8 @@ var (
 	// Record subcommand flags
 	recordSession  string
 	recordWorkItem string
+	recordModel    string
+	recordDetailed bool
 
 	// Digest subcommand flags
 	digestYesterday bool
@@ -64,7 +66,12 @@ Examples:
 
 Subcommands:
   gt costs record       # Record session cost to local log file (Stop hook)
-  gt costs digest       # Aggregate log entries into daily digest bead (Deacon patrol)`,
+  gt costs digest       # Aggregate log entries into daily digest bead (Deacon patrol)
+  gt costs set-rate     # Override per-model pricing used for cost estimates
+  gt costs clear        # Delete old entries from the costs log
+  gt costs breakdown    # Per-turn token usage for one session
+  gt costs alert        # Alert the deacon when today's costs exceed a threshold
+  gt costs summary      # One-line summary for a shell prompt or status bar`,
 	RunE: runCosts,
 }
 
@@ -82,9 +89,14 @@ due to database availability.
 Session costs are aggregated daily by 'gt costs digest' into a single
 permanent "Cost Report YYYY-MM-DD" bead for audit purposes.
 
+With --detailed, per-turn token usage is also saved to
+~/.gt/costs-detail/<session>.json for 'gt costs breakdown'.
+
 Examples:
   gt costs record --session gt-gastown-toast
-  gt costs record --session gt-gastown-toast --work-item gt-abc123`,
+  gt costs record --session gt-gastown-toast --work-item gt-abc123
+  gt costs record --session gt-gastown-toast --detailed
+  gt costs record --session gt-gastown-toast --model claude-opus-4-5-20251101`,
 	RunE: runCostsRecord,
 }
 
@@ -141,6 +153,8 @@ func init() {
 	costsCmd.AddCommand(costsRecordCmd)
 	costsRecordCmd.Flags().StringVar(&recordSession, "session", "", "Tmux session name to record")
 	costsRecordCmd.Flags().StringVar(&recordWorkItem, "work-item", "", "Work item ID (bead) for attribution")
+	costsRecordCmd.Flags().StringVar(&recordModel, "model", "", "Model used in the session (default: detected from transcript)")
+	costsRecordCmd.Flags().BoolVar(&recordDetailed, "detailed", false, "Also save per-turn token usage for 'gt costs breakdown'")
 
 	// Add digest subcommand
 	costsCmd.AddCommand(costsDigestCmd)
@@ -173,6 +187,7 @@ type CostEntry struct {
 	StartedAt time.Time `json:"started_at"`
 	EndedAt   time.Time `json:"ended_at"`
 	WorkItem  string    `json:"work_item,omitempty"`
+	Model     string    `json:"model,omitempty"`
 }
 
 // CostsOutput is the JSON output structure.
@@ -197,8 +212,8 @@ type TranscriptMessage struct {
 
 // TranscriptMessageBody contains the message content and usage info.
 type TranscriptMessageBody struct {
-	Model string          `json:"model"`
-	Role  string          `json:"role"`
+	Model string           `json:"model"`
+	Role  string           `json:"role"`
 	Usage *TranscriptUsage `json:"usage,omitempty"`
 }
 
@@ -219,14 +234,17 @@ type TokenUsage struct {
 	OutputTokens             int
 }
 
+// ModelPricing is the USD price per million tokens for a model.
+type ModelPricing struct {
+	InputPerMillion       float64 `json:"input_per_million"`
+	OutputPerMillion      float64 `json:"output_per_million"`
+	CacheReadPerMillion   float64 `json:"cache_read_per_million"`   // 90% discount on input price
+	CacheCreatePerMillion float64 `json:"cache_create_per_million"` // 25% premium on input price
+}
+
 // Model pricing per million tokens (as of Jan 2025).
 // See: https://www.anthropic.com/pricing
-var modelPricing = map[string]struct {
-	InputPerMillion       float64
-	OutputPerMillion      float64
-	CacheReadPerMillion   float64 // 90% discount on input price
-	CacheCreatePerMillion float64 // 25% premium on input price
-}{
+var modelPricing = map[string]ModelPricing{
 	// Claude Opus 4.5
 	"claude-opus-4-5-20251101": {15.0, 75.0, 1.5, 18.75},
 	// Claude Sonnet 4
@@ -258,6 +276,7 @@ func runLiveCosts() error {
 
 	var costs []SessionCost
 	var total float64
+	rates := loadPricingRates()
 
 	for _, sess := range sessions {
 		// Only process Gas Town sessions
@@ -278,7 +297,7 @@ func runLiveCosts() error {
 		}
 
 		// Extract cost from Claude transcript
-		cost, err := extractCostFromWorkDir(workDir)
+		cost, err := extractCostFromWorkDir(workDir, rates)
 		if err != nil {
 			if costsVerbose {
 				fmt.Fprintf(os.Stderr, "[costs] could not extract cost for %s: %v\n", sess, err)
@@ -798,16 +817,14 @@ func parseTranscriptUsage(transcriptPath string) (*TokenUsage, error) {
 }
 
 // calculateCost converts token usage to USD cost based on model pricing.
-func calculateCost(usage *TokenUsage) float64 {
+// rates holds the user overrides from loadPricingRates.
+func calculateCost(usage *TokenUsage, rates map[string]ModelPricing) float64 {
 	if usage == nil {
 		return 0.0
 	}
 
-	// Look up pricing for the model
-	pricing, ok := modelPricing[usage.Model]
-	if !ok {
-		pricing = modelPricing["default"]
-	}
+	// Look up pricing for the model (user overrides from set-rate win)
+	pricing := lookupModelPricing(rates, usage.Model)
 
 	// Calculate cost (prices are per million tokens)
 	inputCost := float64(usage.InputTokens) / 1_000_000 * pricing.InputPerMillion
@@ -820,23 +837,33 @@ func calculateCost(usage *TokenUsage) float64 {
 
 // extractCostFromWorkDir extracts cost from Claude Code transcript for a working directory.
 // This reads the most recent transcript file and sums all token usage.
-func extractCostFromWorkDir(workDir string) (float64, error) {
+func extractCostFromWorkDir(workDir string, rates map[string]ModelPricing) (float64, error) {
+	usage, err := extractUsageFromWorkDir(workDir)
+	if err != nil {
+		return 0, err
+	}
+	return calculateCost(usage, rates), nil
+}
+
+// extractUsageFromWorkDir reads aggregate token usage from the most recent
+// Claude Code transcript for a working directory.
+func extractUsageFromWorkDir(workDir string) (*TokenUsage, error) {
 	projectDir, err := getClaudeProjectDir(workDir)
 	if err != nil {
-		return 0, fmt.Errorf("getting project dir: %w", err)
+		return nil, fmt.Errorf("getting project dir: %w", err)
 	}
 
 	transcriptPath, err := findLatestTranscript(projectDir)
 	if err != nil {
-		return 0, fmt.Errorf("finding transcript: %w", err)
+		return nil, fmt.Errorf("finding transcript: %w", err)
 	}
 
 	usage, err := parseTranscriptUsage(transcriptPath)
 	if err != nil {
-		return 0, fmt.Errorf("parsing transcript: %w", err)
+		return nil, fmt.Errorf("parsing transcript: %w", err)
 	}
 
-	return calculateCost(usage), nil
+	return usage, nil
 }
 
 // getTmuxSessionWorkDir gets the current working directory of a tmux session.
@@ -934,14 +961,19 @@ func outputLedgerHuman(output CostsOutput, entries []CostEntry) error {
 }
 
 // CostLogEntry represents a single entry in the costs.jsonl log file.
+// Token counts are zero for entries recorded before they were tracked.
 type CostLogEntry struct {
-	SessionID string    `json:"session_id"`
-	Role      string    `json:"role"`
-	Rig       string    `json:"rig,omitempty"`
-	Worker    string    `json:"worker,omitempty"`
-	CostUSD   float64   `json:"cost_usd"`
-	EndedAt   time.Time `json:"ended_at"`
-	WorkItem  string    `json:"work_item,omitempty"`
+	SessionID    string    `json:"session_id"`
+	Role         string    `json:"role"`
+	Rig          string    `json:"rig,omitempty"`
+	Worker       string    `json:"worker,omitempty"`
+	CostUSD      float64   `json:"cost_usd"`
+	EndedAt      time.Time `json:"ended_at"`
+	WorkItem     string    `json:"work_item,omitempty"`
+	Model        string    `json:"model,omitempty"`
+	InputTokens  int       `json:"input_tokens,omitempty"`
+	OutputTokens int       `json:"output_tokens,omitempty"`
+	CachedTokens int       `json:"cached_tokens,omitempty"` // Cache reads plus cache writes
 }
 
 // getCostsLogPath returns the path to the costs log file (~/.gt/costs.jsonl).
@@ -987,31 +1019,51 @@ func runCostsRecord(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// Extract cost from Claude transcript
+	// Extract cost from Claude transcript. An explicit --model overrides the
+	// model detected in the transcript for pricing purposes.
 	var cost float64
+	var tokens TokenUsage
+	model := recordModel
+	rates := loadPricingRates()
 	if workDir != "" {
-		var err error
-		cost, err = extractCostFromWorkDir(workDir)
+		usage, err := extractUsageFromWorkDir(workDir)
 		if err != nil {
 			if costsVerbose {
 				fmt.Fprintf(os.Stderr, "[costs] could not extract cost from transcript: %v\n", err)
 			}
-			cost = 0.0
+		} else {
+			if model != "" {
+				usage.Model = model
+			}
+			model = usage.Model
+			cost = calculateCost(usage, rates)
+			tokens = *usage
 		}
 	}
 
 	// Parse session name
 	role, rig, worker := parseSessionName(session)
 
+	// Per-turn detail is best-effort: the aggregate entry below is what matters
+	if recordDetailed && workDir != "" {
+		if err := recordCostDetail(session, rig, workDir, recordModel, rates); err != nil && costsVerbose {
+			fmt.Fprintf(os.Stderr, "[costs] could not record per-turn detail: %v\n", err)
+		}
+	}
+
 	// Build log entry
 	entry := CostLogEntry{
-		SessionID: session,
-		Role:      role,
-		Rig:       rig,
-		Worker:    worker,
-		CostUSD:   cost,
-		EndedAt:   time.Now(),
-		WorkItem:  recordWorkItem,
+		SessionID:    session,
+		Role:         role,
+		Rig:          rig,
+		Worker:       worker,
+		CostUSD:      cost,
+		EndedAt:      time.Now(),
+		WorkItem:     recordWorkItem,
+		Model:        model,
+		InputTokens:  tokens.InputTokens,
+		OutputTokens: tokens.OutputTokens,
+		CachedTokens: tokens.CacheReadInputTokens + tokens.CacheCreationInputTokens,
 	}
 
 	// Marshal to JSON
@@ -1042,6 +1094,7 @@ func runCostsRecord(cmd *cobra.Command, args []string) error {
 	if _, err := f.Write(append(entryJSON, '\n')); err != nil {
 		return fmt.Errorf("writing to costs log: %w", err)
 	}
+	checkCostAlert(cost)
 
 	// Output confirmation (silent if cost is zero and no work item)
 	if cost > 0 || recordWorkItem != "" {
@@ -1275,6 +1328,7 @@ func querySessionCostEntries(targetDate time.Time) ([]CostEntry, error) {
 			CostUSD:   logEntry.CostUSD,
 			EndedAt:   logEntry.EndedAt,
 			WorkItem:  logEntry.WorkItem,
+			Model:     logEntry.Model,
 		})
 	}
 
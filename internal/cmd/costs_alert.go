@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// Alert subcommand flags
+var alertThreshold float64
+
+var costsAlertCmd = &cobra.Command{
+	Use:   "alert",
+	Short: "Alert the deacon when today's costs exceed a threshold",
+	Long: `Set a daily cost threshold, in USD.
+
+The threshold is stored per town, in <town>/settings/cost-alert.json. After
+each 'gt costs record', today's total from ~/.gt/costs.jsonl is compared with
+the threshold of the town the session runs in (that log is shared by every
+town on the machine, so the total covers all of them). The record that takes
+the total over the threshold nudges the deacon with a COST_ALERT message (or,
+if that fails, prints it to stderr). Each day alerts at most once, when the
+threshold is first crossed.
+
+Without --threshold, shows the current threshold.
+
+Examples:
+  gt costs alert --threshold 50
+  gt costs alert
+  gt costs alert disable`,
+	Args: cobra.NoArgs,
+	RunE: runCostsAlert,
+}
+
+var costsAlertDisableCmd = &cobra.Command{
+	Use:   "disable",
+	Short: "Remove the daily cost threshold",
+	Args:  cobra.NoArgs,
+	RunE:  runCostsAlertDisable,
+}
+
+func init() {
+	costsCmd.AddCommand(costsAlertCmd)
+	costsAlertCmd.AddCommand(costsAlertDisableCmd)
+	costsAlertCmd.Flags().Float64Var(&alertThreshold, "threshold", 0, "Daily cost limit in USD")
+}
+
+// CostAlert is the daily cost alert configuration.
+type CostAlert struct {
+	ThresholdUSD float64 `json:"threshold_usd"`
+}
+
+// getCostAlertPath returns the path to the town's cost alert config.
+// Path: <townRoot>/settings/cost-alert.json
+func getCostAlertPath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirSettings, "cost-alert.json")
+}
+
+// loadCostAlert reads the town's cost alert config. It returns nil if no
+// threshold is set.
+func loadCostAlert(townRoot string) (*CostAlert, error) {
+	data, err := os.ReadFile(getCostAlertPath(townRoot))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading cost alert: %w", err)
+	}
+	var alert CostAlert
+	if err := json.Unmarshal(data, &alert); err != nil {
+		return nil, fmt.Errorf("parsing cost alert: %w", err)
+	}
+	return &alert, nil
+}
+
+// saveCostAlert writes the town's cost alert config.
+func saveCostAlert(townRoot string, alert CostAlert) error {
+	path := getCostAlertPath(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating cost alert directory: %w", err)
+	}
+	data, err := json.MarshalIndent(alert, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+func runCostsAlert(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	if !cmd.Flags().Changed("threshold") {
+		alert, err := loadCostAlert(townRoot)
+		if err != nil {
+			return err
+		}
+		if alert == nil {
+			fmt.Println("No cost alert set. Set one with: gt costs alert --threshold <usd>")
+			return nil
+		}
+		fmt.Printf("Daily cost alert threshold: $%.2f\n", alert.ThresholdUSD)
+		return nil
+	}
+
+	if alertThreshold <= 0 {
+		return fmt.Errorf("--threshold must be a positive amount in USD")
+	}
+	if err := saveCostAlert(townRoot, CostAlert{ThresholdUSD: alertThreshold}); err != nil {
+		return fmt.Errorf("saving cost alert: %w", err)
+	}
+	fmt.Printf("%s Deacon will be alerted when today's costs exceed $%.2f\n", style.Success.Render("✓"), alertThreshold)
+	return nil
+}
+
+func runCostsAlertDisable(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	if err := os.Remove(getCostAlertPath(townRoot)); err != nil {
+		if os.IsNotExist(err) {
+			fmt.Println("No cost alert set.")
+			return nil
+		}
+		return fmt.Errorf("removing cost alert: %w", err)
+	}
+	fmt.Printf("%s Cost alert disabled\n", style.Success.Render("✓"))
+	return nil
+}
+
+// costAlertCrossed reports whether a record of cost took today's total (which
+// includes it) over threshold, so only the crossing record alerts.
+func costAlertCrossed(total, cost, threshold float64) bool {
+	return total > threshold && total-cost <= threshold
+}
+
+// checkCostAlert alerts the deacon if the cost just recorded took today's
+// total over the threshold of the current town. Failures are reported with
+// --verbose only, so they never fail the Stop hook.
+func checkCostAlert(cost float64) {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return // Not in a town: no threshold applies
+	}
+	alert, err := loadCostAlert(townRoot)
+	if err != nil || alert == nil || cost <= 0 {
+		if err != nil && costsVerbose {
+			fmt.Fprintf(os.Stderr, "[costs] %v\n", err)
+		}
+		return
+	}
+
+	entries, err := querySessionCostEntries(time.Now())
+	if err != nil {
+		if costsVerbose {
+			fmt.Fprintf(os.Stderr, "[costs] checking cost alert: %v\n", err)
+		}
+		return
+	}
+	var total float64
+	for _, e := range entries {
+		total += e.CostUSD
+	}
+	if !costAlertCrossed(total, cost, alert.ThresholdUSD) {
+		return
+	}
+
+	message := fmt.Sprintf("COST_ALERT: today's costs are $%.2f, over the $%.2f daily threshold", total, alert.ThresholdUSD)
+	gtPath, err := os.Executable()
+	if err != nil {
+		gtPath = "gt"
+	}
+	out, err := exec.Command(gtPath, "nudge", "deacon", message).CombinedOutput() //nolint:gosec // G204: args are constructed internally
+	// gt nudge succeeds without delivering when the deacon isn't running
+	if err != nil || strings.Contains(string(out), "not running") {
+		fmt.Fprintf(os.Stderr, "%s %s\n", style.Warning.Render("⚠"), message)
+	}
+}
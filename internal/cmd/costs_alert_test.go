@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestCostAlertCrossed(t *testing.T) {
+	tests := []struct {
+		total, cost, threshold float64
+		want                   bool
+	}{
+		{total: 40, cost: 5, threshold: 50, want: false},   // still under
+		{total: 52, cost: 5, threshold: 50, want: true},    // this record crossed it
+		{total: 60, cost: 5, threshold: 50, want: false},   // already over: alerted earlier
+		{total: 50, cost: 5, threshold: 50, want: false},   // reaching it isn't exceeding it
+		{total: 55, cost: 5, threshold: 50, want: true},    // from exactly the threshold to over
+		{total: 200, cost: 200, threshold: 50, want: true}, // first record of the day
+	}
+	for _, tt := range tests {
+		if got := costAlertCrossed(tt.total, tt.cost, tt.threshold); got != tt.want {
+			t.Errorf("costAlertCrossed(%v, %v, %v) = %v, want %v", tt.total, tt.cost, tt.threshold, got, tt.want)
+		}
+	}
+}
+
+func TestCostAlertConfig(t *testing.T) {
+	townRoot, otherTown := t.TempDir(), t.TempDir()
+
+	if alert, err := loadCostAlert(townRoot); err != nil || alert != nil {
+		t.Fatalf("loadCostAlert with no config = %+v, %v; want nil, nil", alert, err)
+	}
+	if err := saveCostAlert(townRoot, CostAlert{ThresholdUSD: 25}); err != nil {
+		t.Fatalf("saveCostAlert: %v", err)
+	}
+	alert, err := loadCostAlert(townRoot)
+	if err != nil || alert == nil || alert.ThresholdUSD != 25 {
+		t.Errorf("loadCostAlert = %+v, %v; want threshold 25", alert, err)
+	}
+	if got, want := getCostAlertPath(townRoot), filepath.Join(townRoot, "settings", "cost-alert.json"); got != want {
+		t.Errorf("getCostAlertPath = %q, want %q", got, want)
+	}
+
+	// Each town has its own threshold
+	if alert, err := loadCostAlert(otherTown); err != nil || alert != nil {
+		t.Errorf("loadCostAlert for another town = %+v, %v; want nil, nil", alert, err)
+	}
+}
@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Breakdown subcommand flags
+var breakdownJSON bool
+
+var costsBreakdownCmd = &cobra.Command{
+	Use:   "breakdown <rig> <session-id>",
+	Short: "Show per-turn token usage for a session",
+	Long: `Show token usage and running cost for each turn of a session.
+
+Per-turn records are only kept for sessions recorded with
+'gt costs record --detailed', which snapshots the session's transcript to
+~/.gt/costs-detail/<session-id>.json each time it runs. The session ID is
+the tmux session name (e.g. gt-gastown-toast).
+
+Columns: turn number, input tokens, output tokens, cached tokens (cache
+reads plus cache writes), model, and the cumulative cost after that turn.
+
+Examples:
+  gt costs breakdown gastown gt-gastown-toast
+  gt costs breakdown gastown gt-gastown-toast --json`,
+	Args: cobra.ExactArgs(2),
+	RunE: runCostsBreakdown,
+}
+
+func init() {
+	costsCmd.AddCommand(costsBreakdownCmd)
+	costsBreakdownCmd.Flags().BoolVar(&breakdownJSON, "json", false, "Output as JSON")
+}
+
+// CostTurn is the token usage of one assistant turn in a session.
+type CostTurn struct {
+	Turn                     int     `json:"turn"`
+	Model                    string  `json:"model,omitempty"`
+	InputTokens              int     `json:"input_tokens"`
+	OutputTokens             int     `json:"output_tokens"`
+	CacheReadInputTokens     int     `json:"cache_read_input_tokens"`
+	CacheCreationInputTokens int     `json:"cache_creation_input_tokens"`
+	CumulativeCostUSD        float64 `json:"cumulative_cost_usd"`
+}
+
+// CostDetail is the per-turn record written by 'gt costs record --detailed'.
+type CostDetail struct {
+	SessionID  string     `json:"session_id"`
+	Rig        string     `json:"rig,omitempty"`
+	RecordedAt time.Time  `json:"recorded_at"`
+	Turns      []CostTurn `json:"turns"`
+}
+
+// getCostsDetailPath returns the per-turn record for a session
+// (~/.gt/costs-detail/<session-id>.json), next to the costs log.
+func getCostsDetailPath(sessionID string) (string, error) {
+	if sessionID == "" || strings.ContainsAny(sessionID, `/\`) || strings.HasPrefix(sessionID, ".") {
+		return "", fmt.Errorf("invalid session ID %q", sessionID)
+	}
+	return filepath.Join(filepath.Dir(getCostsLogPath()), "costs-detail", sessionID+".json"), nil
+}
+
+// parseTranscriptTurns reads per-turn usage from a transcript. Each assistant
+// message with usage is one turn, matching what parseTranscriptUsage sums.
+// A non-empty model overrides the transcript's model for pricing, and rates
+// are the user overrides from loadPricingRates.
+func parseTranscriptTurns(transcriptPath, model string, rates map[string]ModelPricing) ([]CostTurn, error) {
+	file, err := os.Open(transcriptPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var turns []CostTurn
+	var cumulative float64
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 256*1024), 1024*1024)
+	for scanner.Scan() {
+		var msg TranscriptMessage
+		if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil {
+			continue // Skip malformed lines
+		}
+		if msg.Type != "assistant" || msg.Message == nil || msg.Message.Usage == nil {
+			continue
+		}
+
+		u := msg.Message.Usage
+		turn := CostTurn{
+			Turn:                     len(turns) + 1,
+			Model:                    msg.Message.Model,
+			InputTokens:              u.InputTokens,
+			OutputTokens:             u.OutputTokens,
+			CacheReadInputTokens:     u.CacheReadInputTokens,
+			CacheCreationInputTokens: u.CacheCreationInputTokens,
+		}
+		if model != "" {
+			turn.Model = model
+		}
+		cumulative += calculateCost(&TokenUsage{
+			Model:                    turn.Model,
+			InputTokens:              turn.InputTokens,
+			CacheCreationInputTokens: turn.CacheCreationInputTokens,
+			CacheReadInputTokens:     turn.CacheReadInputTokens,
+			OutputTokens:             turn.OutputTokens,
+		}, rates)
+		turn.CumulativeCostUSD = cumulative
+		turns = append(turns, turn)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return turns, nil
+}
+
+// recordCostDetail snapshots per-turn usage for a session from the latest
+// transcript in workDir. The record is replaced on each call, since every
+// Stop hook sees the whole transcript so far.
+func recordCostDetail(sessionID, rigName, workDir, model string, rates map[string]ModelPricing) error {
+	path, err := getCostsDetailPath(sessionID)
+	if err != nil {
+		return err
+	}
+	projectDir, err := getClaudeProjectDir(workDir)
+	if err != nil {
+		return fmt.Errorf("getting project dir: %w", err)
+	}
+	transcriptPath, err := findLatestTranscript(projectDir)
+	if err != nil {
+		return fmt.Errorf("finding transcript: %w", err)
+	}
+	turns, err := parseTranscriptTurns(transcriptPath, model, rates)
+	if err != nil {
+		return fmt.Errorf("parsing transcript: %w", err)
+	}
+
+	data, err := json.MarshalIndent(CostDetail{
+		SessionID:  sessionID,
+		Rig:        rigName,
+		RecordedAt: time.Now(),
+		Turns:      turns,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating cost detail directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("writing cost detail: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
+
+// readCostDetail loads the per-turn record for a session.
+func readCostDetail(sessionID string) (*CostDetail, error) {
+	path, err := getCostsDetailPath(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no per-turn record for session %s (record it with 'gt costs record --detailed')", sessionID)
+		}
+		return nil, err
+	}
+	var detail CostDetail
+	if err := json.Unmarshal(data, &detail); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return &detail, nil
+}
+
+func runCostsBreakdown(cmd *cobra.Command, args []string) error {
+	rigName, sessionID := args[0], args[1]
+
+	detail, err := readCostDetail(sessionID)
+	if err != nil {
+		return err
+	}
+	if detail.Rig != rigName {
+		return fmt.Errorf("session %s belongs to rig %q, not %q", sessionID, detail.Rig, rigName)
+	}
+
+	if breakdownJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(detail)
+	}
+
+	if len(detail.Turns) == 0 {
+		fmt.Println(style.Dim.Render("No turns recorded for " + sessionID))
+		return nil
+	}
+
+	fmt.Printf("\n%s %s %s\n\n", style.Bold.Render("💰"), sessionID,
+		style.Dim.Render("(recorded "+detail.RecordedAt.Local().Format("2006-01-02 15:04:05")+")"))
+	fmt.Printf("%5s %10s %10s %12s  %-28s %10s\n",
+		"Turn", "Input", "Output", "Cached", "Model", "Cumulative")
+	fmt.Println(strings.Repeat("─", 82))
+	for _, t := range detail.Turns {
+		fmt.Printf("%5d %10d %10d %12d  %-28s %10s\n",
+			t.Turn,
+			t.InputTokens,
+			t.OutputTokens,
+			t.CacheReadInputTokens+t.CacheCreationInputTokens,
+			t.Model,
+			fmt.Sprintf("$%.4f", t.CumulativeCostUSD))
+	}
+	fmt.Println(strings.Repeat("─", 82))
+	last := detail.Turns[len(detail.Turns)-1]
+	fmt.Printf("%s $%.2f over %d turns\n", style.Bold.Render("Total:"), last.CumulativeCostUSD, len(detail.Turns))
+	return nil
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const breakdownTranscript = `{"type":"user","message":{"role":"user"}}
+{"type":"assistant","message":{"model":"claude-sonnet-4-20250514","role":"assistant","usage":{"input_tokens":1000,"output_tokens":200,"cache_read_input_tokens":5000,"cache_creation_input_tokens":100}}}
+not json
+{"type":"assistant","message":{"model":"claude-sonnet-4-20250514","role":"assistant","usage":{"input_tokens":2000,"output_tokens":300}}}
+`
+
+func TestParseTranscriptTurns(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "transcript.jsonl")
+	if err := os.WriteFile(path, []byte(breakdownTranscript), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	turns, err := parseTranscriptTurns(path, "", nil)
+	if err != nil {
+		t.Fatalf("parseTranscriptTurns: %v", err)
+	}
+	if len(turns) != 2 {
+		t.Fatalf("got %d turns, want 2", len(turns))
+	}
+	if turns[0].Turn != 1 || turns[1].Turn != 2 {
+		t.Errorf("turn numbers = %d, %d", turns[0].Turn, turns[1].Turn)
+	}
+	if turns[0].CacheReadInputTokens != 5000 || turns[0].CacheCreationInputTokens != 100 {
+		t.Errorf("turn 1 cache tokens = %+v", turns[0])
+	}
+
+	// The last cumulative cost matches the whole-session cost
+	usage, err := parseTranscriptUsage(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := turns[1].CumulativeCostUSD, calculateCost(usage, nil); math.Abs(got-want) > 1e-9 {
+		t.Errorf("cumulative cost = %v, want %v", got, want)
+	}
+	if turns[1].CumulativeCostUSD <= turns[0].CumulativeCostUSD {
+		t.Errorf("cumulative cost did not grow: %v then %v", turns[0].CumulativeCostUSD, turns[1].CumulativeCostUSD)
+	}
+
+	overridden, err := parseTranscriptTurns(path, "claude-opus-4-5-20251101", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if overridden[0].Model != "claude-opus-4-5-20251101" || overridden[1].CumulativeCostUSD <= turns[1].CumulativeCostUSD {
+		t.Errorf("model override not applied: %+v", overridden[1])
+	}
+}
+
+func TestRecordAndReadCostDetail(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	workDir := "/work/gastown/polecats/toast"
+	projectDir, err := getClaudeProjectDir(workDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(projectDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(projectDir, "abc.jsonl"), []byte(breakdownTranscript), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := readCostDetail("gt-gastown-toast"); err == nil || !strings.Contains(err.Error(), "--detailed") {
+		t.Errorf("expected a hint about --detailed for a missing record, got %v", err)
+	}
+
+	if err := recordCostDetail("gt-gastown-toast", "gastown", workDir, "", nil); err != nil {
+		t.Fatalf("recordCostDetail: %v", err)
+	}
+	detail, err := readCostDetail("gt-gastown-toast")
+	if err != nil {
+		t.Fatalf("readCostDetail: %v", err)
+	}
+	if detail.Rig != "gastown" || len(detail.Turns) != 2 || detail.RecordedAt.IsZero() {
+		t.Errorf("detail = %+v", detail)
+	}
+
+	if _, err := readCostDetail("../escape"); err == nil {
+		t.Error("expected error for a session ID containing a path separator")
+	}
+}
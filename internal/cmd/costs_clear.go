@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Clear subcommand flags
+var (
+	clearOlderThan string
+	clearAllRigs   bool
+	clearDryRun    bool
+)
+
+var costsClearCmd = &cobra.Command{
+	Use:   "clear [rig]",
+	Short: "Delete old entries from the costs log",
+	Long: `Delete session cost entries older than a given age from ~/.gt/costs.jsonl.
+
+Entries are matched by rig and by when the session ended. Use --all-rigs
+to clear old entries for every rig (including town-level sessions such as
+mayor and deacon, which have no rig).
+
+Entries that have not been digested yet are lost when cleared; run
+'gt costs digest' first if you want them in the daily cost report.
+
+Examples:
+  gt costs clear gastown --older-than 30d
+  gt costs clear --all-rigs --older-than 720h
+  gt costs clear gastown --older-than 7d --dry-run`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runCostsClear,
+}
+
+func init() {
+	costsCmd.AddCommand(costsClearCmd)
+	costsClearCmd.Flags().StringVar(&clearOlderThan, "older-than", "", "Delete entries older than this age (e.g. 30d, 72h) (required)")
+	costsClearCmd.Flags().BoolVar(&clearAllRigs, "all-rigs", false, "Clear entries for all rigs")
+	costsClearCmd.Flags().BoolVar(&clearDryRun, "dry-run", false, "Show how many entries would be deleted without deleting them")
+	_ = costsClearCmd.MarkFlagRequired("older-than")
+}
+
+func runCostsClear(cmd *cobra.Command, args []string) error {
+	if clearAllRigs == (len(args) == 1) {
+		return fmt.Errorf("specify exactly one of <rig> or --all-rigs")
+	}
+	var rigName string
+	if len(args) == 1 {
+		rigName = args[0]
+	}
+
+	age, err := parseDuration(clearOlderThan)
+	if err != nil {
+		return fmt.Errorf("invalid --older-than %q: %w", clearOlderThan, err)
+	}
+	if age <= 0 {
+		return fmt.Errorf("--older-than must be positive")
+	}
+	cutoff := time.Now().Add(-age)
+
+	deleted, freed, err := clearCostEntries(getCostsLogPath(), rigName, cutoff, clearDryRun)
+	if err != nil {
+		return err
+	}
+
+	scope := "all rigs"
+	if rigName != "" {
+		scope = "rig " + rigName
+	}
+
+	if clearDryRun {
+		fmt.Printf("%s Would delete %d entries for %s older than %s (%s)\n",
+			style.Dim.Render("[dry-run]"), deleted, scope, clearOlderThan, formatBytes(freed))
+		return nil
+	}
+	if deleted == 0 {
+		fmt.Printf("%s No entries for %s older than %s\n", style.Dim.Render("○"), scope, clearOlderThan)
+		return nil
+	}
+	fmt.Printf("%s Deleted %d entries for %s older than %s, recovered %s\n",
+		style.Success.Render("✓"), deleted, scope, clearOlderThan, formatBytes(freed))
+	return nil
+}
+
+// clearCostEntries removes entries from the costs log that ended before cutoff.
+// An empty rigName matches every entry. It returns the number of entries
+// removed and the bytes they occupied; with dryRun the log is left untouched.
+func clearCostEntries(logPath, rigName string, cutoff time.Time, dryRun bool) (int, int64, error) {
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, 0, nil // No log file
+		}
+		return 0, 0, fmt.Errorf("reading costs log: %w", err)
+	}
+
+	var keepLines []string
+	deleted := 0
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		var logEntry CostLogEntry
+		if err := json.Unmarshal([]byte(line), &logEntry); err != nil {
+			// Keep unparseable lines (shouldn't happen but be safe)
+			keepLines = append(keepLines, line)
+			continue
+		}
+
+		if (rigName == "" || logEntry.Rig == rigName) && logEntry.EndedAt.Before(cutoff) {
+			deleted++
+			continue
+		}
+		keepLines = append(keepLines, line)
+	}
+
+	if deleted == 0 {
+		return 0, 0, nil
+	}
+
+	newContent := strings.Join(keepLines, "\n")
+	if len(keepLines) > 0 {
+		newContent += "\n"
+	}
+	freed := int64(len(data) - len(newContent))
+
+	if dryRun {
+		return deleted, freed, nil
+	}
+	if err := os.WriteFile(logPath, []byte(newContent), 0644); err != nil {
+		return 0, 0, fmt.Errorf("rewriting costs log: %w", err)
+	}
+	return deleted, freed, nil
+}
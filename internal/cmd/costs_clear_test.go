@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClearCostEntries(t *testing.T) {
+	now := time.Now()
+	entries := []CostLogEntry{
+		{SessionID: "gt-gastown-toast", Rig: "gastown", EndedAt: now.Add(-48 * time.Hour)},
+		{SessionID: "gt-gastown-nux", Rig: "gastown", EndedAt: now.Add(-time.Hour)},
+		{SessionID: "gt-beads-max", Rig: "beads", EndedAt: now.Add(-48 * time.Hour)},
+		{SessionID: "hq-mayor", EndedAt: now.Add(-48 * time.Hour)},
+	}
+
+	writeLog := func(t *testing.T) string {
+		t.Helper()
+		var sb strings.Builder
+		for _, e := range entries {
+			data, err := json.Marshal(e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sb.Write(data)
+			sb.WriteByte('\n')
+		}
+		path := filepath.Join(t.TempDir(), "costs.jsonl")
+		if err := os.WriteFile(path, []byte(sb.String()), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	cutoff := now.Add(-24 * time.Hour)
+
+	t.Run("rig", func(t *testing.T) {
+		path := writeLog(t)
+		before, _ := os.ReadFile(path)
+
+		deleted, freed, err := clearCostEntries(path, "gastown", cutoff, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		after, _ := os.ReadFile(path)
+		if deleted != 1 {
+			t.Errorf("deleted = %d, want 1", deleted)
+		}
+		if freed != int64(len(before)-len(after)) {
+			t.Errorf("freed = %d, want %d", freed, len(before)-len(after))
+		}
+		if strings.Contains(string(after), "gt-gastown-toast") || !strings.Contains(string(after), "gt-beads-max") {
+			t.Errorf("unexpected log after clear:\n%s", after)
+		}
+	})
+
+	t.Run("all rigs", func(t *testing.T) {
+		path := writeLog(t)
+		deleted, _, err := clearCostEntries(path, "", cutoff, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if deleted != 3 {
+			t.Errorf("deleted = %d, want 3", deleted)
+		}
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		path := writeLog(t)
+		before, _ := os.ReadFile(path)
+		deleted, freed, err := clearCostEntries(path, "", cutoff, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		after, _ := os.ReadFile(path)
+		if deleted != 3 || freed == 0 {
+			t.Errorf("dry run = (%d, %d), want 3 entries and non-zero bytes", deleted, freed)
+		}
+		if string(before) != string(after) {
+			t.Error("dry run modified the log")
+		}
+	})
+}
@@ -0,0 +1,215 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Export subcommand flags
+var (
+	exportFormat string
+	exportOutput string
+	exportSince  string
+	exportUntil  string
+)
+
+var costsExportCmd = &cobra.Command{
+	Use:   "export <rig>",
+	Short: "Export a rig's session costs as CSV or JSON",
+	Long: `Export session cost records for a rig from ~/.gt/costs.jsonl, for use
+with external cost-tracking tools.
+
+CSV (the default) has a header row and the columns:
+  timestamp,rig,agent_type,agent_name,session_id,model,
+  input_tokens,output_tokens,cached_tokens,cost_usd
+
+JSON is an array of objects with the same fields. The timestamp is when the
+session ended (RFC 3339, UTC). cached_tokens counts cache reads plus cache
+writes. Token counts are 0 for sessions recorded before they were tracked.
+
+--since and --until take a date (2026-01-31), an RFC 3339 time, or an age
+such as 7d or 12h. --until is exclusive.
+
+Examples:
+  gt costs export gastown
+  gt costs export gastown --format json --output costs.json
+  gt costs export gastown --since 2026-01-01 --until 2026-02-01`,
+	Args: cobra.ExactArgs(1),
+	RunE: runCostsExport,
+}
+
+func init() {
+	costsCmd.AddCommand(costsExportCmd)
+	costsExportCmd.Flags().StringVar(&exportFormat, "format", "csv", "Output format: csv or json")
+	costsExportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "Write to this file instead of stdout")
+	costsExportCmd.Flags().StringVar(&exportSince, "since", "", "Only sessions that ended at or after this date, time, or age (e.g. 2026-01-01, 7d)")
+	costsExportCmd.Flags().StringVar(&exportUntil, "until", "", "Only sessions that ended before this date, time, or age")
+}
+
+// costExportColumns is the CSV header; costExportRecord has matching JSON fields.
+var costExportColumns = []string{
+	"timestamp", "rig", "agent_type", "agent_name", "session_id", "model",
+	"input_tokens", "output_tokens", "cached_tokens", "cost_usd",
+}
+
+// costExportRecord is one exported session cost.
+type costExportRecord struct {
+	Timestamp    time.Time `json:"timestamp"`
+	Rig          string    `json:"rig"`
+	AgentType    string    `json:"agent_type"`
+	AgentName    string    `json:"agent_name"`
+	SessionID    string    `json:"session_id"`
+	Model        string    `json:"model"`
+	InputTokens  int       `json:"input_tokens"`
+	OutputTokens int       `json:"output_tokens"`
+	CachedTokens int       `json:"cached_tokens"`
+	CostUSD      float64   `json:"cost_usd"`
+}
+
+func runCostsExport(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+	if exportFormat != "csv" && exportFormat != "json" {
+		return fmt.Errorf("invalid --format %q: must be csv or json", exportFormat)
+	}
+
+	now := time.Now()
+	var since, until time.Time
+	var err error
+	if exportSince != "" {
+		if since, err = parseCostsTime(exportSince, now); err != nil {
+			return fmt.Errorf("invalid --since: %w", err)
+		}
+	}
+	if exportUntil != "" {
+		if until, err = parseCostsTime(exportUntil, now); err != nil {
+			return fmt.Errorf("invalid --until: %w", err)
+		}
+	}
+
+	records, err := readCostExportRecords(getCostsLogPath(), rigName, since, until)
+	if err != nil {
+		return err
+	}
+
+	if exportOutput == "" {
+		return writeCostExport(os.Stdout, records, exportFormat)
+	}
+	f, err := os.Create(exportOutput)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", exportOutput, err)
+	}
+	if err := writeCostExport(f, records, exportFormat); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("writing %s: %w", exportOutput, err)
+	}
+	fmt.Fprintf(os.Stderr, "%s Exported %d record(s) to %s\n", style.Success.Render("✓"), len(records), exportOutput)
+	return nil
+}
+
+// parseCostsTime parses a --since/--until value: a date (local midnight),
+// an RFC 3339 time, or an age before now such as "7d" or "12h".
+func parseCostsTime(s string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", s, now.Location()); err == nil {
+		return t, nil
+	}
+	age, err := parseDuration(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a date (YYYY-MM-DD), RFC 3339 time, or age (e.g. 7d)", s)
+	}
+	return now.Add(-age), nil
+}
+
+// readCostExportRecords reads the costs log entries for rigName that ended
+// in [since, until). A zero since or until leaves that end open.
+// A missing log yields no records.
+func readCostExportRecords(logPath, rigName string, since, until time.Time) ([]costExportRecord, error) {
+	f, err := os.Open(logPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading costs log: %w", err)
+	}
+	defer f.Close()
+
+	var records []costExportRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e CostLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue // Skip malformed lines
+		}
+		if e.Rig != rigName {
+			continue
+		}
+		if (!since.IsZero() && e.EndedAt.Before(since)) || (!until.IsZero() && !e.EndedAt.Before(until)) {
+			continue
+		}
+		records = append(records, costExportRecord{
+			Timestamp:    e.EndedAt.UTC(),
+			Rig:          e.Rig,
+			AgentType:    e.Role,
+			AgentName:    e.Worker,
+			SessionID:    e.SessionID,
+			Model:        e.Model,
+			InputTokens:  e.InputTokens,
+			OutputTokens: e.OutputTokens,
+			CachedTokens: e.CachedTokens,
+			CostUSD:      e.CostUSD,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading costs log: %w", err)
+	}
+	return records, nil
+}
+
+// writeCostExport writes records to w as "csv" or "json".
+func writeCostExport(w io.Writer, records []costExportRecord, format string) error {
+	if format == "json" {
+		if records == nil {
+			records = []costExportRecord{} // "[]", not "null"
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(records)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(costExportColumns); err != nil {
+		return err
+	}
+	for _, r := range records {
+		if err := cw.Write([]string{
+			r.Timestamp.Format(time.RFC3339),
+			r.Rig,
+			r.AgentType,
+			r.AgentName,
+			r.SessionID,
+			r.Model,
+			strconv.Itoa(r.InputTokens),
+			strconv.Itoa(r.OutputTokens),
+			strconv.Itoa(r.CachedTokens),
+			strconv.FormatFloat(r.CostUSD, 'f', 4, 64),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
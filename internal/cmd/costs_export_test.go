@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadCostExportRecords(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 12, 0, 0, 0, time.UTC) }
+	entries := []CostLogEntry{
+		{SessionID: "gt-gastown-toast", Role: "polecat", Rig: "gastown", Worker: "toast", CostUSD: 1.5, EndedAt: day(1), Model: "claude-sonnet-4", InputTokens: 100, OutputTokens: 20, CachedTokens: 300},
+		{SessionID: "gt-gastown-nux", Role: "polecat", Rig: "gastown", Worker: "nux", CostUSD: 0.25, EndedAt: day(5)},
+		{SessionID: "gt-beads-max", Role: "crew", Rig: "beads", Worker: "max", CostUSD: 3, EndedAt: day(3)},
+	}
+	var sb strings.Builder
+	for _, e := range entries {
+		data, err := json.Marshal(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sb.Write(data)
+		sb.WriteByte('\n')
+	}
+	sb.WriteString("{not json\n")
+	path := filepath.Join(t.TempDir(), "costs.jsonl")
+	if err := os.WriteFile(path, []byte(sb.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := readCostExportRecords(path, "gastown", time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2 for gastown", len(records))
+	}
+
+	// --until is exclusive
+	records, err = readCostExportRecords(path, "gastown", day(1), day(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].SessionID != "gt-gastown-toast" {
+		t.Fatalf("records = %+v, want only gt-gastown-toast", records)
+	}
+
+	var buf bytes.Buffer
+	if err := writeCostExport(&buf, records, "csv"); err != nil {
+		t.Fatal(err)
+	}
+	want := "timestamp,rig,agent_type,agent_name,session_id,model,input_tokens,output_tokens,cached_tokens,cost_usd\n" +
+		"2026-01-01T12:00:00Z,gastown,polecat,toast,gt-gastown-toast,claude-sonnet-4,100,20,300,1.5000\n"
+	if buf.String() != want {
+		t.Errorf("csv =\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	buf.Reset()
+	if err := writeCostExport(&buf, records, "json"); err != nil {
+		t.Fatal(err)
+	}
+	var got []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || len(got[0]) != len(costExportColumns) || got[0]["cached_tokens"] != float64(300) {
+		t.Errorf("json = %s", buf.String())
+	}
+
+	buf.Reset()
+	if err := writeCostExport(&buf, nil, "json"); err != nil {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(buf.String()) != "[]" {
+		t.Errorf("empty json export = %q, want []", buf.String())
+	}
+}
+
+func TestParseCostsTime(t *testing.T) {
+	now := time.Date(2026, 2, 10, 8, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"2026-01-31", time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)},
+		{"2026-01-31T15:04:05Z", time.Date(2026, 1, 31, 15, 4, 5, 0, time.UTC)},
+		{"7d", now.Add(-7 * 24 * time.Hour)},
+		{"12h", now.Add(-12 * time.Hour)},
+	}
+	for _, tt := range tests {
+		got, err := parseCostsTime(tt.in, now)
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("parseCostsTime(%q) = %v, %v; want %v", tt.in, got, err, tt.want)
+		}
+	}
+	if _, err := parseCostsTime("last week", now); err == nil {
+		t.Error("parseCostsTime(last week) should fail")
+	}
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Set-rate subcommand flags
+var setRateOutput float64
+
+var costsSetRateCmd = &cobra.Command{
+	Use:   "set-rate <model> <rate>",
+	Short: "Override pricing for a model",
+	Long: `Set the USD price per million input tokens for a model.
+
+Overrides are stored in ~/.gt/cost-rates.json and take precedence over the
+built-in pricing table when costs are calculated (gt costs, gt costs record).
+Use "default" as the model name to change the fallback for unknown models.
+
+Output, cache-read, and cache-creation rates are derived from the input rate
+using Anthropic's standard ratios (5x, 0.1x, 1.25x) unless --output is given.
+
+Examples:
+  gt costs set-rate claude-opus-4-5-20251101 5
+  gt costs set-rate claude-sonnet-4-20250514 3 --output 15`,
+	Args: cobra.ExactArgs(2),
+	RunE: runCostsSetRate,
+}
+
+func init() {
+	costsCmd.AddCommand(costsSetRateCmd)
+	costsSetRateCmd.Flags().Float64Var(&setRateOutput, "output", 0, "USD per million output tokens (default: 5x input rate)")
+}
+
+// getCostRatesPath returns the path to the user pricing overrides (~/.gt/cost-rates.json).
+func getCostRatesPath() string {
+	return filepath.Join(filepath.Dir(getCostsLogPath()), "cost-rates.json")
+}
+
+// loadCostRates reads user pricing overrides. A missing file is not an error.
+func loadCostRates() (map[string]ModelPricing, error) {
+	data, err := os.ReadFile(getCostRatesPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]ModelPricing{}, nil
+		}
+		return nil, fmt.Errorf("reading cost rates: %w", err)
+	}
+
+	rates := map[string]ModelPricing{}
+	if err := json.Unmarshal(data, &rates); err != nil {
+		return nil, fmt.Errorf("parsing cost rates: %w", err)
+	}
+	return rates, nil
+}
+
+// saveCostRates writes user pricing overrides.
+func saveCostRates(rates map[string]ModelPricing) error {
+	path := getCostRatesPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating rates directory: %w", err)
+	}
+	data, err := json.MarshalIndent(rates, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// loadPricingRates reads the user pricing overrides once for a command, so
+// pricing many sessions or turns doesn't re-read the file each time. An
+// unreadable file is ignored and the built-in table is used.
+func loadPricingRates() map[string]ModelPricing {
+	rates, err := loadCostRates()
+	if err != nil {
+		if costsVerbose {
+			fmt.Fprintf(os.Stderr, "[costs] ignoring cost rates: %v\n", err)
+		}
+		return map[string]ModelPricing{}
+	}
+	return rates
+}
+
+// lookupModelPricing returns pricing for a model, checking user overrides
+// (from loadPricingRates) first, then the built-in table, then the default
+// entry of each.
+func lookupModelPricing(rates map[string]ModelPricing, model string) ModelPricing {
+	if p, ok := rates[model]; ok {
+		return p
+	}
+	if p, ok := modelPricing[model]; ok {
+		return p
+	}
+	if p, ok := rates["default"]; ok {
+		return p
+	}
+	return modelPricing["default"]
+}
+
+// pricingFromInputRate derives a full pricing entry from an input rate.
+func pricingFromInputRate(input, output float64) ModelPricing {
+	if output <= 0 {
+		output = input * 5
+	}
+	return ModelPricing{
+		InputPerMillion:       input,
+		OutputPerMillion:      output,
+		CacheReadPerMillion:   input * 0.1,
+		CacheCreatePerMillion: input * 1.25,
+	}
+}
+
+func runCostsSetRate(cmd *cobra.Command, args []string) error {
+	model := args[0]
+	rate, err := strconv.ParseFloat(args[1], 64)
+	if err != nil || rate < 0 {
+		return fmt.Errorf("invalid rate %q: must be a non-negative number (USD per million input tokens)", args[1])
+	}
+	if setRateOutput < 0 {
+		return fmt.Errorf("--output must be non-negative")
+	}
+
+	rates, err := loadCostRates()
+	if err != nil {
+		return err
+	}
+
+	pricing := pricingFromInputRate(rate, setRateOutput)
+	rates[model] = pricing
+	if err := saveCostRates(rates); err != nil {
+		return fmt.Errorf("saving cost rates: %w", err)
+	}
+
+	fmt.Printf("%s Set rate for %s: $%.2f/M input, $%.2f/M output\n",
+		style.Success.Render("✓"), model, pricing.InputPerMillion, pricing.OutputPerMillion)
+	return nil
+}
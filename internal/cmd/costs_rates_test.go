@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLookupModelPricing_OverrideWins(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	model := "claude-sonnet-4-20250514"
+	if got := lookupModelPricing(loadPricingRates(), model); got != modelPricing[model] {
+		t.Fatalf("without overrides, got %+v, want built-in %+v", got, modelPricing[model])
+	}
+
+	if err := saveCostRates(map[string]ModelPricing{model: pricingFromInputRate(10, 0)}); err != nil {
+		t.Fatalf("saveCostRates: %v", err)
+	}
+
+	got := lookupModelPricing(loadPricingRates(), model)
+	if got.InputPerMillion != 10 || got.OutputPerMillion != 50 {
+		t.Errorf("override not applied: %+v", got)
+	}
+}
+
+func TestLookupModelPricing_UnknownUsesDefault(t *testing.T) {
+	if got := lookupModelPricing(nil, "some-future-model"); got != modelPricing["default"] {
+		t.Errorf("unknown model got %+v, want default %+v", got, modelPricing["default"])
+	}
+
+	rates := map[string]ModelPricing{"default": pricingFromInputRate(1, 2)}
+	if got := lookupModelPricing(rates, "some-future-model"); got.OutputPerMillion != 2 {
+		t.Errorf("default override not applied: %+v", got)
+	}
+}
+
+func TestLoadPricingRates_IgnoresBadFile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	path := getCostRatesPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if rates := loadPricingRates(); len(rates) != 0 {
+		t.Errorf("rates = %+v, want none", rates)
+	}
+}
+
+func TestCalculateCost_UsesModel(t *testing.T) {
+	usage := &TokenUsage{InputTokens: 1_000_000, OutputTokens: 1_000_000}
+
+	usage.Model = "claude-opus-4-5-20251101"
+	opus := calculateCost(usage, nil)
+	usage.Model = "claude-3-5-haiku-20241022"
+	haiku := calculateCost(usage, nil)
+
+	if opus != 90 {
+		t.Errorf("opus cost = %v, want 90", opus)
+	}
+	if haiku != 6 {
+		t.Errorf("haiku cost = %v, want 6", haiku)
+	}
+}
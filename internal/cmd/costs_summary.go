@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// Summary subcommand flags
+var (
+	summaryFormat  string
+	summaryCompact bool
+)
+
+// defaultCostSummaryFormat is the --format used when none is given.
+const defaultCostSummaryFormat = "${{.TodayUSD}} today, ${{.WeekUSD}} this week, {{.ActiveSessions}} active"
+
+var costsSummaryCmd = &cobra.Command{
+	Use:   "summary",
+	Short: "Print a one-line cost summary for a shell prompt or status bar",
+	Long: `Print a one-line cost summary, fast enough to run on every prompt.
+
+The summary reads only ~/.gt/costs.jsonl and the tmux session list; it
+never queries beads. WeekUSD therefore covers the last 7 days of entries
+still in the log: days already folded into a digest bead by
+'gt costs digest' are not included. Use 'gt costs --week' for the full
+figure.
+
+--format takes a Go template with these fields:
+  {{.TodayUSD}}        Cost of sessions that ended today (e.g. 1.23)
+  {{.WeekUSD}}         Cost of sessions that ended in the last 7 days
+  {{.ActiveSessions}}  Number of running Gas Town agent sessions
+
+The USD fields print with two decimals; use printf for other precision,
+e.g. {{printf "%.0f" .WeekUSD}}.
+
+--compact prints just today's cost, as $1.23/day.
+
+Examples:
+  gt costs summary
+  gt costs summary --compact
+  gt costs summary --format '{{.ActiveSessions}}⚙ ${{.TodayUSD}}'
+  PS1='$(gt costs summary --compact) \w \$ '`,
+	Args: cobra.NoArgs,
+	RunE: runCostsSummary,
+}
+
+func init() {
+	costsCmd.AddCommand(costsSummaryCmd)
+	costsSummaryCmd.Flags().StringVar(&summaryFormat, "format", defaultCostSummaryFormat, "Go template for the summary line")
+	costsSummaryCmd.Flags().BoolVar(&summaryCompact, "compact", false, "Print only today's cost, as $1.23/day")
+}
+
+// usd is a dollar amount that prints with two decimals in templates.
+type usd float64
+
+func (u usd) String() string {
+	return fmt.Sprintf("%.2f", float64(u))
+}
+
+// costSummary holds the fields available to 'gt costs summary --format'.
+type costSummary struct {
+	TodayUSD       usd
+	WeekUSD        usd
+	ActiveSessions int
+}
+
+func runCostsSummary(cmd *cobra.Command, args []string) error {
+	if summaryCompact && cmd.Flags().Changed("format") {
+		return fmt.Errorf("cannot use --compact with --format")
+	}
+	format := summaryFormat
+	if summaryCompact {
+		format = "${{.TodayUSD}}/day"
+	}
+	tmpl, err := template.New("summary").Parse(format)
+	if err != nil {
+		return fmt.Errorf("invalid --format: %w", err)
+	}
+
+	summary, err := summarizeCostLog(getCostsLogPath(), time.Now())
+	if err != nil {
+		return err
+	}
+	summary.ActiveSessions = countAgentSessions()
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, summary); err != nil {
+		return fmt.Errorf("invalid --format: %w", err)
+	}
+	fmt.Println(sb.String())
+	return nil
+}
+
+// summarizeCostLog totals the costs log for today and the 7 days ending
+// today, in now's location. A missing log is all zeros.
+func summarizeCostLog(path string, now time.Time) (costSummary, error) {
+	var summary costSummary
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return summary, nil
+		}
+		return summary, fmt.Errorf("reading costs log: %w", err)
+	}
+	defer f.Close()
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	weekStart := today.AddDate(0, 0, -6)
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry CostLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		ended := entry.EndedAt.In(now.Location())
+		if ended.Before(weekStart) {
+			continue
+		}
+		summary.WeekUSD += usd(entry.CostUSD)
+		if !ended.Before(today) {
+			summary.TodayUSD += usd(entry.CostUSD)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return summary, fmt.Errorf("reading costs log: %w", err)
+	}
+	return summary, nil
+}
+
+// countAgentSessions returns the number of running Gas Town tmux sessions,
+// or 0 if tmux isn't running.
+func countAgentSessions() int {
+	sessions, err := tmux.NewTmux().ListSessions()
+	if err != nil {
+		return 0
+	}
+	count := 0
+	for _, sess := range sessions {
+		if session.IsKnownSession(sess) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+)
+
+func TestSummarizeCostLog(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.Local)
+	entries := []CostLogEntry{
+		{SessionID: "gt-gastown-toast", CostUSD: 1.5, EndedAt: now.Add(-time.Hour)},
+		{SessionID: "gt-gastown-nux", CostUSD: 0.25, EndedAt: now.Add(-16 * time.Hour)}, // Yesterday
+		{SessionID: "hq-mayor", CostUSD: 2, EndedAt: now.AddDate(0, 0, -6)},
+		{SessionID: "hq-deacon", CostUSD: 100, EndedAt: now.AddDate(0, 0, -8)}, // Outside the week
+	}
+	var sb strings.Builder
+	for _, e := range entries {
+		data, err := json.Marshal(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sb.Write(data)
+		sb.WriteByte('\n')
+	}
+	sb.WriteString("not json\n")
+	path := filepath.Join(t.TempDir(), "costs.jsonl")
+	if err := os.WriteFile(path, []byte(sb.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	summary, err := summarizeCostLog(path, now)
+	if err != nil {
+		t.Fatalf("summarizeCostLog: %v", err)
+	}
+	if summary.TodayUSD != 1.5 || summary.WeekUSD != 3.75 {
+		t.Errorf("summary = %+v, want today 1.50, week 3.75", summary)
+	}
+
+	summary.ActiveSessions = 4
+	tmpl := template.Must(template.New("summary").Parse(defaultCostSummaryFormat))
+	var out strings.Builder
+	if err := tmpl.Execute(&out, summary); err != nil {
+		t.Fatal(err)
+	}
+	if want := "$1.50 today, $3.75 this week, 4 active"; out.String() != want {
+		t.Errorf("default format = %q, want %q", out.String(), want)
+	}
+
+	if summary, err := summarizeCostLog(filepath.Join(t.TempDir(), "missing.jsonl"), now); err != nil || summary != (costSummary{}) {
+		t.Errorf("missing log = %+v, %v; want zeros", summary, err)
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/crew"
+	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/mail"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/style"
@@ -85,15 +86,9 @@ func runCrewRemove(cmd *cobra.Command, args []string) error {
 		// Remove the workspace
 		if isWorktree {
 			// For worktrees, use git worktree remove
-			mayorRigPath := constants.RigMayorPath(r.Path)
-			removeArgs := []string{"worktree", "remove", crewPath}
-			if forceRemove {
-				removeArgs = []string{"worktree", "remove", "--force", crewPath}
-			}
-			removeCmd := exec.Command("git", removeArgs...)
-			removeCmd.Dir = mayorRigPath
-			if output, err := removeCmd.CombinedOutput(); err != nil {
-				fmt.Printf("Error removing worktree %s: %v\n%s", arg, err, string(output))
+			mayorGit := git.NewGit(constants.RigMayorPath(r.Path))
+			if err := mayorGit.WorktreeRemove(crewPath, forceRemove); err != nil {
+				fmt.Printf("Error removing worktree %s: %v\n", arg, err)
 				lastErr = err
 				continue
 			}
@@ -2,11 +2,13 @@ package cmd
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
 )
 
@@ -16,6 +18,11 @@ var (
 	doctorRig             string
 	doctorRestartSessions bool
 	doctorSlow            string
+	doctorDryRun          bool
+	doctorRemoveBackups   bool
+	doctorJSON            bool
+	doctorWatch           time.Duration
+	doctorOutput          string
 )
 
 var doctorCmd = &cobra.Command{
@@ -42,6 +49,7 @@ Town root protection:
 Infrastructure checks:
   - stale-binary             Check if gt binary is up to date with repo
   - beads-binary             Check that beads (bd) is installed and meets minimum version
+  - tmux-version             Check that tmux is installed and meets minimum version
   - daemon                   Check if daemon is running (fixable)
   - boot-health              Check Boot watchdog health (vet mode)
   - town-beads-config        Verify town .beads/config.yaml exists (fixable)
@@ -58,11 +66,15 @@ Clone divergence checks:
   - clone-divergence         Detect clones significantly behind origin/main
   - default-branch-all-rigs  Verify default_branch exists on remote for all rigs
   - worktree-gitdir-valid    Verify worktree .git files reference existing paths (fixable)
+  - bare-repo-health         Verify each rig's .repo.git passes git fsck
 
 Crew workspace checks:
   - crew-state               Validate crew worker state.json files (fixable)
   - crew-worktrees           Detect stale cross-rig worktrees (fixable)
 
+Polecat checks:
+  - polecat-state            Detect working polecats with no commits in 24h
+
 Migration checks (fixable):
   - sparse-checkout          Detect legacy sparse checkout across all rigs
 
@@ -84,6 +96,7 @@ Routing checks (fixable):
 Session hook checks:
   - session-hooks            Check settings.json use session-start.sh
   - claude-settings          Check Claude settings.json match templates (fixable)
+  - mayor-settings           Check mayor settings.json has interactive-role hooks
   - deprecated-merge-queue-keys  Detect stale deprecated keys in merge_queue config (fixable)
   - stale-task-dispatch      Detect stale task-dispatch guard in settings.json (fixable)
 
@@ -98,24 +111,55 @@ Patrol checks:
   - patrol-hooks-wired       Verify daemon triggers patrols
   - patrol-not-stuck         Detect stale wisps (>1h)
   - patrol-plugins-accessible Verify plugin directories
+  - witness-running          Verify a witness session is running for each rig
 
 Use --fix to attempt automatic fixes for issues that support it.
-Use --rig to check a specific rig instead of the entire workspace.
-Use --slow to highlight slow checks (default threshold: 1s, e.g. --slow=500ms).`,
+Use --fix --dry-run to preview fixes without changing anything. Checks
+that can't describe their fix are marked "(would fix)" and left alone.
+Fixes that rewrite or delete a Claude settings file keep the original as
+settings.json.bak (in mayor/.claude/ for town-root files); claude-settings warns until these are reviewed and
+deleted with --fix --remove-backups.
+Use --rig to check a specific rig instead of the entire workspace: per-rig
+checks only examine that rig and town-wide checks still run.
+'gt rig doctor <rig>' is shorthand for 'gt doctor --rig <rig>'.
+Use --slow to highlight slow checks (default threshold: 1s, e.g. --slow=500ms).
+Use --verbose to show details for passing checks and print checks' debug
+logs (e.g. per-rig git fsck timings) to stderr.
+Use --json to print a JSON array of {name, status, message, details} per check
+(for CI); the exit code is non-zero on errors either way. With --fix, fix
+progress is printed to stderr.
+Use --output <file> to also write that JSON report to a file for sharing,
+alongside the normal output. An existing file is overwritten with a warning.
+Use --watch to re-run the checks on an interval (e.g. --watch=30s) until Ctrl-C.
+A desktop notification is sent (osascript on macOS, notify-send on Linux)
+whenever a check changes between OK and Error.
+'gt doctor list' lists the available checks without running them.`,
 	RunE: runDoctor,
 }
 
 func init() {
-	doctorCmd.Flags().BoolVar(&doctorFix, "fix", false, "Attempt to automatically fix issues")
-	doctorCmd.Flags().BoolVarP(&doctorVerbose, "verbose", "v", false, "Show detailed output")
+	addDoctorRunFlags(doctorCmd)
 	doctorCmd.Flags().StringVar(&doctorRig, "rig", "", "Check specific rig only")
-	doctorCmd.Flags().BoolVar(&doctorRestartSessions, "restart-sessions", false, "Restart patrol sessions when fixing stale settings (use with --fix)")
-	doctorCmd.Flags().StringVar(&doctorSlow, "slow", "", "Highlight slow checks (optional threshold, default 1s)")
-	// Allow --slow without a value (uses default 1s)
-	doctorCmd.Flags().Lookup("slow").NoOptDefVal = "1s"
 	rootCmd.AddCommand(doctorCmd)
 }
 
+// addDoctorRunFlags registers the flags shared by 'gt doctor' and
+// 'gt rig doctor'. Both bind the same variables so runDoctor sees them
+// unchanged whichever command ran.
+func addDoctorRunFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&doctorFix, "fix", false, "Attempt to automatically fix issues")
+	cmd.Flags().BoolVarP(&doctorVerbose, "verbose", "v", false, "Show detailed output")
+	cmd.Flags().BoolVar(&doctorRestartSessions, "restart-sessions", false, "Restart patrol sessions when fixing stale settings (use with --fix)")
+	cmd.Flags().BoolVar(&doctorDryRun, "dry-run", false, "Show what --fix would change without modifying anything")
+	cmd.Flags().BoolVar(&doctorRemoveBackups, "remove-backups", false, "Delete settings backups left by earlier fixes (use with --fix)")
+	cmd.Flags().BoolVar(&doctorJSON, "json", false, "Output results as JSON")
+	cmd.Flags().StringVar(&doctorOutput, "output", "", "Also write the JSON report to this file")
+	cmd.Flags().DurationVar(&doctorWatch, "watch", 0, "Re-run checks every interval until interrupted (e.g. 30s)")
+	cmd.Flags().StringVar(&doctorSlow, "slow", "", "Highlight slow checks (optional threshold, default 1s)")
+	// Allow --slow without a value (uses default 1s)
+	cmd.Flags().Lookup("slow").NoOptDefVal = "1s"
+}
+
 func runDoctor(cmd *cobra.Command, args []string) error {
 	// Find town root
 	townRoot, err := workspace.FindFromCwdOrError()
@@ -123,117 +167,29 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a Gas Town workspace: %w", err)
 	}
 
+	if doctorDryRun && !doctorFix {
+		return fmt.Errorf("--dry-run requires --fix")
+	}
+	if doctorWatch != 0 && (doctorFix || doctorJSON || doctorOutput != "") {
+		return fmt.Errorf("--watch cannot be used with --fix, --json or --output")
+	}
+
 	// Create check context
 	ctx := &doctor.CheckContext{
 		TownRoot:        townRoot,
 		RigName:         doctorRig,
 		Verbose:         doctorVerbose,
 		RestartSessions: doctorRestartSessions,
+		DryRun:          doctorDryRun,
+		RemoveBackups:   doctorRemoveBackups,
+	}
+	if doctorVerbose {
+		// Check diagnostics go to stderr so --json output stays parseable
+		ctx.Logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
 	}
 
 	// Create doctor and register checks
-	d := doctor.NewDoctor()
-
-	// Register workspace-level checks first (fundamental)
-	d.RegisterAll(doctor.WorkspaceChecks()...)
-
-	d.Register(doctor.NewGlobalStateCheck())
-
-	// Register built-in checks
-	d.Register(doctor.NewStaleBinaryCheck())
-	d.Register(doctor.NewBeadsBinaryCheck())
-	// All database queries go through bd CLI
-	d.Register(doctor.NewTownGitCheck())
-	d.Register(doctor.NewTownRootBranchCheck())
-	d.Register(doctor.NewPreCheckoutHookCheck())
-	d.Register(doctor.NewDaemonCheck())
-	d.Register(doctor.NewBootHealthCheck())
-	d.Register(doctor.NewTownBeadsConfigCheck())
-	d.Register(doctor.NewCustomTypesCheck())
-	d.Register(doctor.NewRoleLabelCheck())
-	d.Register(doctor.NewFormulaCheck())
-	d.Register(doctor.NewPrefixConflictCheck())
-	d.Register(doctor.NewRigNameMismatchCheck())
-	d.Register(doctor.NewPrefixMismatchCheck())
-	d.Register(doctor.NewDatabasePrefixCheck())
-	d.Register(doctor.NewRoutesCheck())
-	d.Register(doctor.NewRigRoutesJSONLCheck())
-	d.Register(doctor.NewRoutingModeCheck())
-	d.Register(doctor.NewMalformedSessionNameCheck())
-	d.Register(doctor.NewOrphanSessionCheck())
-	d.Register(doctor.NewZombieSessionCheck())
-	d.Register(doctor.NewOrphanProcessCheck())
-	d.Register(doctor.NewWispGCCheck())
-	d.Register(doctor.NewCheckMisclassifiedWisps())
-	d.Register(doctor.NewStaleBeadsRedirectCheck())
-	d.Register(doctor.NewBeadsRedirectTargetCheck())
-	d.Register(doctor.NewBranchCheck())
-	d.Register(doctor.NewCloneDivergenceCheck())
-	d.Register(doctor.NewDefaultBranchAllRigsCheck())
-	d.Register(doctor.NewIdentityCollisionCheck())
-	d.Register(doctor.NewLinkedPaneCheck())
-	d.Register(doctor.NewThemeCheck())
-	d.Register(doctor.NewCrashReportCheck())
-	d.Register(doctor.NewEnvVarsCheck())
-
-	// Patrol system checks
-	d.Register(doctor.NewPatrolMoleculesExistCheck())
-	d.Register(doctor.NewPatrolHooksWiredCheck())
-	d.Register(doctor.NewPatrolNotStuckCheck())
-	d.Register(doctor.NewPatrolPluginsAccessibleCheck())
-	d.Register(doctor.NewAgentBeadsCheck())
-	d.Register(doctor.NewStaleAgentBeadsCheck())
-	d.Register(doctor.NewRigBeadsCheck())
-	d.Register(doctor.NewRoleBeadsCheck())
-
-	// NOTE: StaleAttachmentsCheck removed - staleness detection belongs in Deacon molecule
-
-	// Config architecture checks
-	d.Register(doctor.NewSettingsCheck())
-	d.Register(doctor.NewSessionHookCheck())
-	d.Register(doctor.NewRuntimeGitignoreCheck())
-	d.Register(doctor.NewLegacyGastownCheck())
-	d.Register(doctor.NewClaudeSettingsCheck())
-	d.Register(doctor.NewDeprecatedMergeQueueKeysCheck())
-	d.Register(doctor.NewLandWorktreeGitignoreCheck())
-	d.Register(doctor.NewHooksPathAllRigsCheck())
-
-	// Sparse checkout migration (runs across all rigs, not just --rig mode)
-	d.Register(doctor.NewSparseCheckoutCheck())
-
-	// Priming subsystem check
-	d.Register(doctor.NewPrimingCheck())
-
-	// Crew workspace checks
-	d.Register(doctor.NewCrewStateCheck())
-	d.Register(doctor.NewCrewWorktreeCheck())
-	d.Register(doctor.NewCommandsCheck())
-
-	// Lifecycle hygiene checks
-	d.Register(doctor.NewLifecycleHygieneCheck())
-
-	// Hook attachment checks
-	d.Register(doctor.NewHookAttachmentValidCheck())
-	d.Register(doctor.NewHookSingletonCheck())
-	d.Register(doctor.NewOrphanedAttachmentsCheck())
-
-	// Hooks sync check
-	d.Register(doctor.NewStaleTaskDispatchCheck())
-	d.Register(doctor.NewHooksSyncCheck())
-
-	// Dolt health checks
-	d.Register(doctor.NewDoltBinaryCheck())
-	d.Register(doctor.NewDoltMetadataCheck())
-	d.Register(doctor.NewDoltServerReachableCheck())
-	d.Register(doctor.NewDoltOrphanedDatabaseCheck())
-
-	// Worktree gitdir validity (runs across all rigs, or specific rig with --rig)
-	d.Register(doctor.NewWorktreeGitdirCheck())
-
-	// Rig-specific checks (only when --rig is specified)
-	if doctorRig != "" {
-		d.RegisterAll(doctor.RigChecks()...)
-	}
+	d := newTownDoctor(doctorRig != "")
 
 	// Parse slow threshold (0 = disabled)
 	var slowThreshold time.Duration
@@ -245,6 +201,24 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if doctorWatch != 0 {
+		return runDoctorWatch(d, ctx, doctorWatch, slowThreshold)
+	}
+
+	if doctorJSON {
+		report, err := runDoctorJSON(d, ctx, doctorFix)
+		if err != nil {
+			return err
+		}
+		if err := writeDoctorOutput(report); err != nil {
+			return err
+		}
+		if report.HasErrors() {
+			return NewSilentExit(1)
+		}
+		return nil
+	}
+
 	// Run checks with streaming output
 	fmt.Println() // Initial blank line
 	var report *doctor.Report
@@ -257,6 +231,10 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	// Print summary (checks were already printed during streaming)
 	report.PrintSummaryOnly(os.Stdout, doctorVerbose, slowThreshold)
 
+	if err := writeDoctorOutput(report); err != nil {
+		return err
+	}
+
 	// Exit with error code if there are errors
 	if report.HasErrors() {
 		return fmt.Errorf("doctor found %d error(s)", report.Summary.Errors)
@@ -264,3 +242,58 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+// runDoctorJSON runs the checks, fixing them if fix is set, and writes the
+// report to stdout as JSON. What checks print for the user (e.g. Fix
+// progress) goes to stderr through ctx.Out, so it can't corrupt the JSON.
+func runDoctorJSON(d *doctor.Doctor, ctx *doctor.CheckContext, fix bool) (*doctor.Report, error) {
+	ctx.Out = os.Stderr
+	var report *doctor.Report
+	if fix {
+		report = d.Fix(ctx)
+	} else {
+		report = d.Run(ctx)
+	}
+	if err := report.WriteJSON(os.Stdout); err != nil {
+		return nil, fmt.Errorf("writing JSON: %w", err)
+	}
+	return report, nil
+}
+
+// newTownDoctor returns a doctor with every gt doctor check registered.
+// Rig checks are included only when withRigChecks is set (i.e. with --rig).
+func newTownDoctor(withRigChecks bool) *doctor.Doctor {
+	d := doctor.NewDoctor()
+	d.RegisterAll(doctor.TownChecks()...)
+
+	// Rig-specific checks (only when --rig is specified)
+	if withRigChecks {
+		d.RegisterAll(doctor.RigChecks()...)
+	}
+	return d
+}
+
+// writeDoctorOutput writes the JSON report to the --output file, if set.
+// Notes go to stderr so they never mix with --json output on stdout.
+func writeDoctorOutput(report *doctor.Report) error {
+	if doctorOutput == "" {
+		return nil
+	}
+	if _, err := os.Stat(doctorOutput); err == nil {
+		fmt.Fprintf(os.Stderr, "%s overwriting existing %s\n", style.Warning.Render("⚠ Warning:"), doctorOutput)
+	}
+
+	f, err := os.Create(doctorOutput)
+	if err != nil {
+		return fmt.Errorf("creating --output file: %w", err)
+	}
+	if err := report.WriteJSON(f); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("writing %s: %w", doctorOutput, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("writing %s: %w", doctorOutput, err)
+	}
+	fmt.Fprintf(os.Stderr, "Report written to %s\n", doctorOutput)
+	return nil
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/doctor"
+)
+
+var doctorListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the available checks without running them",
+	Long: `List every check gt doctor can run, in the order it runs them.
+
+FIX shows whether 'gt doctor --fix' can repair problems the check finds.
+Rig checks are listed separately; they only run with --rig.
+
+Examples:
+  gt doctor list`,
+	Args: cobra.NoArgs,
+	RunE: runDoctorList,
+}
+
+func init() {
+	doctorCmd.AddCommand(doctorListCmd)
+}
+
+func runDoctorList(cmd *cobra.Command, args []string) error {
+	writeDoctorCheckList(os.Stdout, newTownDoctor(false).Checks())
+	fmt.Println("\nRig checks (with --rig):")
+	writeDoctorCheckList(os.Stdout, doctor.RigChecks())
+	return nil
+}
+
+// writeDoctorCheckList prints a NAME/FIX/DESCRIPTION table of checks in run
+// (priority) order.
+func writeDoctorCheckList(w io.Writer, checks []doctor.Check) {
+	sorted := make([]doctor.Check, len(checks))
+	copy(sorted, checks)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority() < sorted[j].Priority()
+	})
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tFIX\tDESCRIPTION")
+	for _, c := range sorted {
+		fixable := "-"
+		if c.CanFix() {
+			fixable = "yes"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", c.Name(), fixable, c.Description())
+	}
+	_ = tw.Flush()
+}
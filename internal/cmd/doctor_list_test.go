@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/doctor"
+)
+
+func TestWriteDoctorCheckList(t *testing.T) {
+	settings := doctor.NewClaudeSettingsCheck()
+	tmuxVersion := doctor.NewTmuxVersionCheck()
+
+	var buf bytes.Buffer
+	writeDoctorCheckList(&buf, []doctor.Check{settings, tmuxVersion})
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "NAME") {
+		t.Fatalf("output =\n%s\nwant a header and 2 rows", buf.String())
+	}
+
+	// tmux-version has a higher priority, so it is listed first
+	if fields := strings.Fields(lines[1]); fields[0] != tmuxVersion.Name() || fields[1] != "-" {
+		t.Errorf("row 1 = %q, want %s, not fixable", lines[1], tmuxVersion.Name())
+	}
+	if fields := strings.Fields(lines[2]); fields[0] != settings.Name() || fields[1] != "yes" {
+		t.Errorf("row 2 = %q, want %s, fixable", lines[2], settings.Name())
+	}
+	if !strings.HasSuffix(lines[2], settings.Description()) {
+		t.Errorf("row 2 = %q, want it to end with the description %q", lines[2], settings.Description())
+	}
+}
+
+func TestNewTownDoctorChecksHaveDescriptions(t *testing.T) {
+	for _, c := range append(newTownDoctor(false).Checks(), doctor.RigChecks()...) {
+		if c.Description() == "" {
+			t.Errorf("check %s has no description", c.Name())
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/doctor"
+)
+
+// printingFixCheck is a failing check whose Fix prints its progress, like
+// most real Fix implementations.
+type printingFixCheck struct {
+	doctor.FixableCheck
+	fixed bool
+}
+
+func (c *printingFixCheck) Run(ctx *doctor.CheckContext) *doctor.CheckResult {
+	if c.fixed {
+		return &doctor.CheckResult{Name: c.Name(), Status: doctor.StatusOK, Message: "ok"}
+	}
+	return &doctor.CheckResult{Name: c.Name(), Status: doctor.StatusError, Message: "broken"}
+}
+
+func (c *printingFixCheck) Fix(ctx *doctor.CheckContext) error {
+	fmt.Fprintf(ctx.Output(), "  Deleted stale: %s\n", "settings.json")
+	c.fixed = true
+	return nil
+}
+
+func TestRunDoctorJSONFixKeepsStdoutJSON(t *testing.T) {
+	d := doctor.NewDoctor()
+	d.Register(&printingFixCheck{FixableCheck: doctor.FixableCheck{BaseCheck: doctor.BaseCheck{CheckName: "printing-fix"}}})
+
+	stdoutR, stdoutW, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stderrR, stderrW, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	origStdout, origStderr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = stdoutW, stderrW
+	report, runErr := runDoctorJSON(d, &doctor.CheckContext{TownRoot: t.TempDir()}, true)
+	os.Stdout, os.Stderr = origStdout, origStderr
+	stdoutW.Close()
+	stderrW.Close()
+	stdout, _ := io.ReadAll(stdoutR)
+	stderr, _ := io.ReadAll(stderrR)
+
+	if runErr != nil {
+		t.Fatalf("runDoctorJSON: %v", runErr)
+	}
+	var results []map[string]any
+	if err := json.Unmarshal(stdout, &results); err != nil {
+		t.Fatalf("stdout is not JSON: %v\n%s", err, stdout)
+	}
+	if len(results) != 1 || !report.Checks[0].Fixed {
+		t.Errorf("results = %v, want the one check, fixed", results)
+	}
+	if want := "Deleted stale: settings.json"; !strings.Contains(string(stderr), want) {
+		t.Errorf("stderr = %q, want the fix progress %q", stderr, want)
+	}
+}
+
+func TestRigDoctorHasDoctorFlags(t *testing.T) {
+	// runDoctor reads the shared flag vars, so a flag missing from
+	// 'gt rig doctor' silently keeps its default there.
+	for _, name := range []string{"fix", "verbose", "restart-sessions", "dry-run", "remove-backups", "json", "output", "watch", "slow"} {
+		if doctorCmd.Flags().Lookup(name) == nil {
+			t.Errorf("gt doctor is missing --%s", name)
+		}
+		if rigDoctorCmd.Flags().Lookup(name) == nil {
+			t.Errorf("gt rig doctor is missing --%s", name)
+		}
+	}
+}
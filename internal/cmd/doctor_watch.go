@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"runtime"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/style"
+	"golang.org/x/term"
+)
+
+// runDoctorWatch re-runs the registered checks every interval until
+// interrupted, redrawing the results each time. A desktop notification is
+// sent whenever a check flips between OK and Error.
+func runDoctorWatch(d *doctor.Doctor, ctx *doctor.CheckContext, interval, slowThreshold time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("--watch interval must be positive, got %s", interval)
+	}
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigChan)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	isTTY := term.IsTerminal(int(os.Stdout.Fd()))
+
+	var prev *doctor.Report
+	for {
+		var buf bytes.Buffer
+
+		if isTTY {
+			buf.WriteString("\033[H\033[2J") // ANSI: cursor home + clear screen
+		}
+
+		header := fmt.Sprintf("[%s] gt doctor --watch (every %s, Ctrl+C to stop)", time.Now().Format("15:04:05"), interval)
+		if isTTY {
+			header = style.Dim.Render(header)
+		}
+		fmt.Fprintf(&buf, "%s\n\n", header)
+
+		report := d.RunStreaming(ctx, &buf, slowThreshold)
+		report.PrintSummaryOnly(&buf, doctorVerbose, slowThreshold)
+
+		// Write the entire frame at once so the screen never shows blank
+		_, _ = os.Stdout.Write(buf.Bytes())
+
+		if changes := doctorStatusChanges(prev, report); len(changes) > 0 {
+			notifyDesktop("gt doctor", strings.Join(changes, "\n"))
+		}
+		prev = report
+
+		select {
+		case <-sigChan:
+			if isTTY {
+				fmt.Println("\nStopped.")
+			}
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// doctorStatusChanges describes each check that moved from OK to Error or
+// from Error back to OK between two runs. Warnings are not reported.
+func doctorStatusChanges(prev, cur *doctor.Report) []string {
+	if prev == nil || cur == nil {
+		return nil
+	}
+
+	before := make(map[string]doctor.CheckStatus, len(prev.Checks))
+	for _, c := range prev.Checks {
+		before[c.Name] = c.Status
+	}
+
+	var changes []string
+	for _, c := range cur.Checks {
+		was, ok := before[c.Name]
+		if !ok {
+			continue
+		}
+		if (was == doctor.StatusOK && c.Status == doctor.StatusError) ||
+			(was == doctor.StatusError && c.Status == doctor.StatusOK) {
+			changes = append(changes, fmt.Sprintf("%s: %s → %s", c.Name, was, c.Status))
+		}
+	}
+	return changes
+}
+
+// notifyDesktop shows a desktop notification using osascript on macOS or
+// notify-send on Linux. Failures are ignored; notifications are best-effort.
+func notifyDesktop(title, message string) {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", appleScriptQuote(message), appleScriptQuote(title))
+		cmd = exec.Command("osascript", "-e", script)
+	case "linux":
+		cmd = exec.Command("notify-send", title, message)
+	default:
+		return
+	}
+	_ = cmd.Run()
+}
+
+// appleScriptQuote returns s as an AppleScript string literal.
+func appleScriptQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/doctor"
+)
+
+func TestDoctorStatusChanges(t *testing.T) {
+	report := func(statuses map[string]doctor.CheckStatus) *doctor.Report {
+		r := doctor.NewReport()
+		for _, name := range []string{"daemon", "town-git", "wisp-gc", "orphan-sessions"} {
+			if s, ok := statuses[name]; ok {
+				r.Checks = append(r.Checks, &doctor.CheckResult{Name: name, Status: s})
+			}
+		}
+		return r
+	}
+
+	prev := report(map[string]doctor.CheckStatus{
+		"daemon":   doctor.StatusOK,
+		"town-git": doctor.StatusError,
+		"wisp-gc":  doctor.StatusOK,
+	})
+	cur := report(map[string]doctor.CheckStatus{
+		"daemon":          doctor.StatusError,
+		"town-git":        doctor.StatusOK,
+		"wisp-gc":         doctor.StatusWarning,
+		"orphan-sessions": doctor.StatusError,
+	})
+
+	got := doctorStatusChanges(prev, cur)
+	want := []string{"daemon: OK → Error", "town-git: Error → OK"}
+	if len(got) != len(want) {
+		t.Fatalf("doctorStatusChanges = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("change[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	if got := doctorStatusChanges(nil, cur); got != nil {
+		t.Errorf("first run should report no changes, got %v", got)
+	}
+}
+
+func TestAppleScriptQuote(t *testing.T) {
+	if got := appleScriptQuote(`say "hi" \ bye`); got != `"say \"hi\" \\ bye"` {
+		t.Errorf("appleScriptQuote = %s", got)
+	}
+}
@@ -80,23 +80,40 @@ func isInTmuxSession(targetSession string) bool {
 // control, and passes -u for UTF-8 support regardless of locale settings.
 // See: https://github.com/steveyegge/gastown/issues/1219
 func attachToTmuxSession(sessionID string) error {
+	return attachToTmuxSessionWithOptions(sessionID, false)
+}
+
+// attachToTmuxSessionWithOptions is attachToTmuxSession with optional
+// read-only attach. Read-only is refused inside tmux, where switch-client -r
+// would toggle the whole client's read-only mode rather than apply it once.
+func attachToTmuxSessionWithOptions(sessionID string, readOnly bool) error {
 	tmuxPath, err := exec.LookPath("tmux")
 	if err != nil {
 		return fmt.Errorf("tmux not found: %w", err)
 	}
 
-	// Build args with -u for UTF-8 support
-	var args []string
-	if os.Getenv("TMUX") != "" {
-		// Inside tmux: switch to the target session
-		args = []string{"tmux", "-u", "switch-client", "-t", sessionID}
-	} else {
-		// Outside tmux: attach to the session
-		args = []string{"tmux", "-u", "attach-session", "-t", sessionID}
+	insideTmux := os.Getenv("TMUX") != ""
+	if readOnly && insideTmux {
+		return fmt.Errorf("read-only attach is not supported from inside tmux; run from a plain terminal")
 	}
 
 	// Replace the Go process with tmux for direct terminal control
-	return syscall.Exec(tmuxPath, args, os.Environ())
+	return syscall.Exec(tmuxPath, tmuxAttachArgs(sessionID, readOnly, insideTmux), os.Environ())
+}
+
+// tmuxAttachArgs builds the tmux argv for attaching to a session,
+// with -u for UTF-8 support.
+func tmuxAttachArgs(sessionID string, readOnly, insideTmux bool) []string {
+	if insideTmux {
+		// Inside tmux: switch to the target session
+		return []string{"tmux", "-u", "switch-client", "-t", sessionID}
+	}
+	// Outside tmux: attach to the session
+	args := []string{"tmux", "-u", "attach-session"}
+	if readOnly {
+		args = append(args, "-r")
+	}
+	return append(args, "-t", sessionID)
 }
 
 // isShellCommand checks if the command is a shell (meaning the runtime has exited).
@@ -397,7 +397,7 @@ func runMqIntegrationCreate(cmd *cobra.Command, args []string) error {
 	fmt.Printf("Pushing to origin...\n")
 	if err := g.Push("origin", branchName, false); err != nil {
 		// Clean up local branch on push failure (best-effort cleanup)
-		_ = g.DeleteBranch(branchName, true)
+		_ = g.DeleteBranch(branchName, git.BranchDeleteOptions{Force: true})
 		return fmt.Errorf("pushing to origin: %w", err)
 	}
 
@@ -713,7 +713,7 @@ func cleanupIntegrationBranch(g *git.Git, bd *beads.Beads, epicID, branchName, t
 		fmt.Printf("  %s Deleted from origin\n", style.Bold.Render("✓"))
 	}
 	// Delete local
-	if err := g.DeleteBranch(branchName, true); err != nil {
+	if err := g.DeleteBranch(branchName, git.BranchDeleteOptions{Force: true}); err != nil {
 		warning := fmt.Sprintf("could not delete local branch: %v", err)
 		warnings = append(warnings, warning)
 		fmt.Printf("  %s\n", style.Dim.Render(fmt.Sprintf("(%s)", warning)))
@@ -1,10 +1,14 @@
 package cmd
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,12 +24,23 @@ import (
 )
 
 var (
-	nudgeMessageFlag  string
-	nudgeForceFlag    bool
-	nudgeStdinFlag    bool
-	nudgeIfFreshFlag  bool
-	nudgeModeFlag     string
-	nudgePriorityFlag string
+	nudgeMessageFlag   string
+	nudgeForceFlag     bool
+	nudgeStdinFlag     bool
+	nudgeFileFlag      string
+	nudgeIfFreshFlag   bool
+	nudgeModeFlag      string
+	nudgePriorityFlag  string
+	nudgeDebounceFlag  time.Duration
+	nudgeWaitReplyFlag time.Duration
+	nudgeTemplateFlag  string
+	nudgeLogLevelFlag  string
+	nudgeDryRunFlag    bool
+	nudgeRetryFlag     int
+	nudgeRetryDelay    time.Duration
+	nudgeScheduleFlag  string
+	nudgeSenderFlag    string
+	nudgeFromFlag      string
 )
 
 // Nudge delivery modes.
@@ -46,9 +61,22 @@ func init() {
 	nudgeCmd.Flags().StringVarP(&nudgeMessageFlag, "message", "m", "", "Message to send")
 	nudgeCmd.Flags().BoolVarP(&nudgeForceFlag, "force", "f", false, "Send even if target has DND enabled")
 	nudgeCmd.Flags().BoolVar(&nudgeStdinFlag, "stdin", false, "Read message from stdin (avoids shell quoting issues)")
+	nudgeCmd.Flags().StringVar(&nudgeFileFlag, "file", "", "Read message from a file (for long or structured messages; no -f shorthand, which is --force)")
+	nudgeCmd.Flags().StringVar(&nudgeTemplateFlag, "template", "", "Send a named message template from <town>/settings/nudge-templates/")
 	nudgeCmd.Flags().BoolVar(&nudgeIfFreshFlag, "if-fresh", false, "Only send if caller's tmux session is <60s old (suppresses compaction nudges)")
 	nudgeCmd.Flags().StringVar(&nudgeModeFlag, "mode", NudgeModeImmediate, "Delivery mode: immediate (default), queue, or wait-idle")
 	nudgeCmd.Flags().StringVar(&nudgePriorityFlag, "priority", nudge.PriorityNormal, "Queue priority: normal (default) or urgent")
+	nudgeCmd.Flags().DurationVar(&nudgeWaitReplyFlag, "wait-reply", 0, "After sending, block up to this long for the target to answer with 'gt nudge reply' (e.g. 5m)")
+	nudgeCmd.Flags().DurationVar(&nudgeDebounceFlag, "debounce", 0, "Drop this nudge if the same message was sent to the same target within this window (e.g. 30s)")
+	nudgeCmd.Flags().StringVar(&nudgeLogLevelFlag, "log-level", "info", "Log verbosity on stderr: debug, info (default), warn, or error")
+	nudgeCmd.Flags().BoolVar(&nudgeDryRunFlag, "dry-run", false, "Show the resolved targets and message without sending")
+	nudgeCmd.Flags().IntVar(&nudgeRetryFlag, "retry", 0, "Retry a failed send up to this many times, with exponential backoff")
+	nudgeCmd.Flags().DurationVar(&nudgeRetryDelay, "retry-delay", 500*time.Millisecond, "Delay before the first retry; doubles after each failure")
+	nudgeCmd.Flags().StringVar(&nudgeScheduleFlag, "schedule", "", "Deliver later instead of now: an RFC 3339 time or a delay (e.g. 2h, 1d)")
+	nudgeCmd.Flags().StringVar(&nudgeFromFlag, "from", "", "Append \"from=<address>\" to the message, naming the session it is about")
+	// --sender lets the daemon deliver a scheduled nudge as its original sender
+	nudgeCmd.Flags().StringVar(&nudgeSenderFlag, "sender", "", "Override the sender shown in the nudge")
+	_ = nudgeCmd.Flags().MarkHidden("sender")
 }
 
 var nudgeCmd = &cobra.Command{
@@ -87,7 +115,67 @@ Role shortcuts (expand to session names):
 Channel syntax:
   channel:<name>  Nudges all members of a named channel defined in
                   ~/gt/config/messaging.json under "nudge_channels".
-                  Patterns like "gastown/polecats/*" are expanded.
+                  Patterns like "gastown/polecats/*" are expanded, and
+                  "gastown/polecats/0" or "gastown/polecats/0-3" select
+                  polecats by position (sorted by name).
+
+Debouncing (--debounce):
+  When many agents send the same nudge at once (e.g. polecats starting and
+  each running "gt nudge deacon session-started"), --debounce drops repeats
+  of the same message to the same target within the window. State is shared
+  across gt processes via <town>/.runtime/nudge-debounce/.
+
+Payload address (--from):
+  Appends " from=<address>" to the message, so a receiver handling many
+  identical notifications can tell them apart, e.g.
+  "gt nudge deacon session-started --from $GT_ROLE" sends
+  "session-started from=gastown/polecats/Toast". The address is part of the
+  message, so --debounce only drops repeats from the same address.
+
+Waiting for a reply (--wait-reply):
+  Blocks after sending until the target runs "gt nudge reply <you> <message>"
+  or the timeout fires, then prints the reply on stdout. The nudge includes
+  the reply command. Replies are passed via <town>/.runtime/nudge-replies/.
+  Not supported for channel: targets.
+  "gt nudge ping <address> --wait-reply 2m" checks an agent is responsive
+  and prints the round-trip time.
+
+Templates (--template):
+  Sends <town>/settings/nudge-templates/<name>.txt as the message, expanding
+  {{.RigName}}, {{.AgentName}} and {{.Timestamp}} for the target. List the
+  available templates with "gt nudge template list".
+
+Broadcasting:
+  "gt nudge broadcast <message>" nudges every running agent (use --rig to
+  scope it to one rig). Same as "gt broadcast --all".
+
+Logging (--log-level):
+  Nudge operations are logged to stderr. "info" (the default) logs each
+  successful send, "warn" only failures, "error" only errors, and "debug"
+  every step: pattern resolution, session lookup and the tmux send.
+
+Retrying (--retry, --retry-delay):
+  A send that fails (e.g. the tmux session is briefly unavailable) is retried
+  up to --retry times, waiting --retry-delay (default 500ms) before the first
+  retry and doubling the wait each time. Retries are logged at debug level.
+
+Scheduling (--schedule):
+  Stores the nudge in <town>/.runtime/scheduled-nudges/ for the daemon to
+  deliver at the given time: RFC 3339 (2026-03-05T09:00:00-08:00) or a
+  delay from now (90m, 2h, 1d). --mode, --priority and --force are kept; DND
+  is checked at delivery. See "gt nudge scheduled list" and
+  "gt nudge scheduled cancel <id>". Requires the daemon to be running.
+
+Dry run (--dry-run):
+  Resolves the target (or channel patterns) and prints the matching session
+  names, their addresses and the message, without sending anything. Use it
+  to check what a broad pattern like "*/polecats/*" will reach.
+
+History:
+  Every nudge is appended to <town>/.runtime/nudge-log/<address>.jsonl,
+  including ones suppressed by --if-fresh. Show the most recent entries with
+  "gt nudge history <address>", or follow new ones with
+  "gt nudge tail <address>".
 
 DND (Do Not Disturb):
   If the target has DND enabled (gt dnd on), the nudge is skipped.
@@ -99,22 +187,59 @@ Examples:
   gt nudge mayor "Status update requested"
   gt nudge witness "Check polecat health"
   gt nudge deacon session-started
+  gt nudge deacon session-started --debounce 30s
+  gt nudge deacon session-started --from "$GT_ROLE"
+  gt nudge gastown/alpha "Rebase onto main" --retry 3
   gt nudge channel:workers "New priority work available"
+  gt nudge channel:workers "New priority work available" --dry-run
+  gt nudge gastown/alpha "Is the build green?" --wait-reply 5m
+  gt nudge gastown/alpha --template review
+  gt nudge gastown/alpha "Morning: pick up the next bead" --schedule 2026-03-05T09:00:00-08:00
+  gt nudge mayor "Check the convoy" --schedule 2h
 
   # Use --stdin for messages with special characters or formatting:
   gt nudge gastown/alpha --stdin <<'EOF'
   Status update:
   - Task 1: complete
   - Task 2: in progress
-  EOF`,
+  EOF
+
+  # Use --file for long or structured messages (JSON payloads, instructions):
+  gt nudge gastown/alpha --file instructions.md`,
 	Args: cobra.RangeArgs(1, 2),
 	RunE: runNudge,
 }
 
+// readNudgeFile reads a nudge message body from path.
+// Trailing newlines are trimmed, matching --stdin. An empty file is an error.
+func readNudgeFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading message file: %w", err)
+	}
+	msg := strings.TrimRight(string(data), "\n")
+	if strings.TrimSpace(msg) == "" {
+		return "", fmt.Errorf("message file %s is empty", path)
+	}
+	return msg, nil
+}
+
+// withNudgeFrom appends the "from=<address>" payload to a nudge message.
+func withNudgeFrom(message, address string) string {
+	return message + " from=" + address
+}
+
 // ifFreshMaxAge is the maximum session age for --if-fresh to allow a nudge.
 // Sessions older than this are considered compaction/clear restarts, not new sessions.
 const ifFreshMaxAge = 60 * time.Second
 
+// isFreshSession reports whether --if-fresh should let a nudge through for
+// sess. Sessions with an unknown creation time have Age 0, so they are
+// treated as fresh.
+func isFreshSession(sess *AgentSession, now time.Time) bool {
+	return sess.Age(now) <= ifFreshMaxAge
+}
+
 // waitIdleTimeout is how long --mode=wait-idle will poll before falling back to queue.
 // This is a var (not const) so tests can override it to avoid 15s waits.
 var waitIdleTimeout = 15 * time.Second
@@ -123,7 +248,23 @@ var waitIdleTimeout = 15 * time.Second
 // For "immediate" mode: sends directly via tmux (current behavior).
 // For "queue" mode: writes to the nudge queue for cooperative delivery.
 // For "wait-idle" mode: waits for idle, then delivers or falls back to queue.
-func deliverNudge(t *tmux.Tmux, sessionName, message, sender string) error {
+// Failed sends are retried per --retry. Each send is logged through the
+// logger carried by ctx.
+func deliverNudge(ctx context.Context, t *tmux.Tmux, sessionName, message, sender string) error {
+	logger := nudgeLogger(ctx).With("session", sessionName, "mode", nudgeModeFlag)
+	err := withNudgeRetries(logger, nudgeRetryFlag, nudgeRetryDelay, func() error {
+		return deliverNudgeMode(logger, t, sessionName, message, sender)
+	})
+	if err != nil {
+		logger.Warn("nudge failed", "error", err)
+		return err
+	}
+	logger.Info("nudge sent")
+	return nil
+}
+
+// deliverNudgeMode delivers a nudge using the --mode delivery mode.
+func deliverNudgeMode(logger *slog.Logger, t *tmux.Tmux, sessionName, message, sender string) error {
 	townRoot, _ := workspace.FindFromCwd()
 
 	// For direct tmux delivery, prefix with sender attribution.
@@ -136,6 +277,7 @@ func deliverNudge(t *tmux.Tmux, sessionName, message, sender string) error {
 		if townRoot == "" {
 			return fmt.Errorf("--mode=queue requires a Gas Town workspace")
 		}
+		logger.Debug("enqueueing nudge", "town", townRoot)
 		return nudge.Enqueue(townRoot, sessionName, nudge.QueuedNudge{
 			Sender:   sender,
 			Message:  message,
@@ -149,9 +291,11 @@ func deliverNudge(t *tmux.Tmux, sessionName, message, sender string) error {
 			return fmt.Errorf("--mode=wait-idle requires a Gas Town workspace")
 		}
 		// Try to wait for idle
+		logger.Debug("waiting for idle", "timeout", waitIdleTimeout)
 		err := t.WaitForIdle(sessionName, waitIdleTimeout)
 		if err == nil {
 			// Agent is idle — safe to deliver directly
+			logger.Debug("tmux send-keys")
 			return t.NudgeSession(sessionName, prefixedMessage)
 		}
 		// Terminal errors (session gone, no server) — propagate, don't queue.
@@ -160,6 +304,7 @@ func deliverNudge(t *tmux.Tmux, sessionName, message, sender string) error {
 			return fmt.Errorf("wait-idle: %w", err)
 		}
 		// Timeout (agent busy) — queue instead
+		logger.Debug("agent busy, enqueueing nudge", "wait_error", err)
 		if qErr := nudge.Enqueue(townRoot, sessionName, nudge.QueuedNudge{
 			Sender:   sender,
 			Message:  message,
@@ -173,10 +318,30 @@ func deliverNudge(t *tmux.Tmux, sessionName, message, sender string) error {
 		return nil
 
 	default: // NudgeModeImmediate
+		logger.Debug("tmux send-keys")
 		return t.NudgeSession(sessionName, prefixedMessage)
 	}
 }
 
+// nudgeDebounced reports whether a nudge should be dropped because the same
+// message was already sent to sessionName within the --debounce window.
+// Debounce failures are non-fatal: the nudge is sent rather than lost.
+func nudgeDebounced(sessionName, message string) bool {
+	if nudgeDebounceFlag <= 0 {
+		return false
+	}
+	townRoot, _ := workspace.FindFromCwd()
+	if townRoot == "" {
+		return false
+	}
+	dropped, err := nudge.Debounce(townRoot, sessionName, message, nudgeDebounceFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: nudge debounce failed (%v), sending anyway\n", err)
+		return false
+	}
+	return dropped
+}
+
 // validNudgeModes is the set of allowed --mode values.
 var validNudgeModes = map[string]bool{
 	NudgeModeImmediate: true,
@@ -198,25 +363,89 @@ func runNudge(cmd *cobra.Command, args []string) error {
 	if !validNudgePriorities[nudgePriorityFlag] {
 		return fmt.Errorf("invalid --priority %q: must be one of normal, urgent", nudgePriorityFlag)
 	}
+	if nudgeRetryFlag < 0 {
+		return fmt.Errorf("invalid --retry %d: must not be negative", nudgeRetryFlag)
+	}
+	if nudgeDryRunFlag && nudgeWaitReplyFlag > 0 {
+		return fmt.Errorf("cannot use --dry-run with --wait-reply")
+	}
+	if strings.ContainsAny(nudgeFromFlag, " \t\n") {
+		return fmt.Errorf("invalid --from %q: address cannot contain whitespace", nudgeFromFlag)
+	}
+	var scheduleAt time.Time
+	if nudgeScheduleFlag != "" {
+		if nudgeDryRunFlag || nudgeWaitReplyFlag > 0 {
+			return fmt.Errorf("cannot use --schedule with --dry-run or --wait-reply")
+		}
+		at, err := parseNudgeSchedule(nudgeScheduleFlag, time.Now())
+		if err != nil {
+			return err
+		}
+		scheduleAt = at
+	}
+	level, err := parseNudgeLogLevel(nudgeLogLevelFlag)
+	if err != nil {
+		return err
+	}
+	logger := newNudgeLogger(level)
+	ctx := withNudgeLogger(cmd.Context(), logger)
+
+	target := args[0]
 
 	// --if-fresh: skip nudge if the caller's tmux session is older than 60s.
 	// This prevents compaction/clear SessionStart hooks from spamming the deacon.
+	var ifFreshOutcome string
 	if nudgeIfFreshFlag {
-		sessionName := tmux.CurrentSessionName()
-		if sessionName != "" {
-			t := tmux.NewTmux()
-			created, err := t.GetSessionCreatedUnix(sessionName)
-			if err == nil && created > 0 {
-				age := time.Since(time.Unix(created, 0))
-				if age > ifFreshMaxAge {
-					// Session is old — this is a compaction/clear, not a new session
-					return nil
+		ifFreshOutcome = nudge.IfFreshSent
+		if sessionName := tmux.CurrentSessionName(); sessionName != "" {
+			sess := &AgentSession{Name: sessionName, CreatedAt: sessionCreatedAt(tmux.NewTmux(), sessionName)}
+			if !isFreshSession(sess, time.Now()) {
+				// Session is old — this is a compaction/clear, not a new session
+				if townRoot, _ := workspace.FindFromCwd(); townRoot != "" {
+					skipped := nudgeMessageFlag
+					if skipped == "" && len(args) >= 2 {
+						skipped = args[1]
+					}
+					recordNudgeHistory(townRoot, target, skipped, nudge.IfFreshSkipped)
 				}
+				return nil
 			}
 		}
 	}
 
-	target := args[0]
+	// Handle --template: expand a named template from the town's settings
+	if nudgeTemplateFlag != "" {
+		if nudgeMessageFlag != "" || nudgeFileFlag != "" || nudgeStdinFlag || len(args) >= 2 {
+			return fmt.Errorf("cannot use --template with a message, --file, or --stdin")
+		}
+		townRoot, err := workspace.FindFromCwdOrError()
+		if err != nil {
+			return fmt.Errorf("not in a Gas Town workspace: %w", err)
+		}
+		msg, err := nudge.RenderTemplate(townRoot, nudgeTemplateFlag, nudgeTemplateData(target, time.Now()))
+		if err != nil {
+			return err
+		}
+		nudgeMessageFlag = msg
+	}
+
+	// Handle --file: read message from a file
+	if nudgeFileFlag != "" {
+		if nudgeMessageFlag != "" {
+			return fmt.Errorf("cannot use --file with --message/-m")
+		}
+		if nudgeStdinFlag {
+			return fmt.Errorf("cannot use --file with --stdin")
+		}
+		if len(args) >= 2 {
+			return fmt.Errorf("cannot use --file with a message argument")
+		}
+		msg, err := readNudgeFile(nudgeFileFlag)
+		if err != nil {
+			return err
+		}
+		nudgeMessageFlag = msg
+	}
 
 	// Handle --stdin: read message from stdin (avoids shell quoting issues)
 	if nudgeStdinFlag {
@@ -239,36 +468,53 @@ func runNudge(cmd *cobra.Command, args []string) error {
 	} else {
 		return fmt.Errorf("message required: use -m flag or provide as second argument")
 	}
+	if nudgeFromFlag != "" {
+		message = withNudgeFrom(message, nudgeFromFlag)
+	}
 
 	// Identify sender for message prefix (needed before channel check)
-	sender := "unknown"
-	if roleInfo, err := GetRole(); err == nil {
-		switch roleInfo.Role {
-		case RoleMayor:
-			sender = "mayor"
-		case RoleCrew:
-			sender = fmt.Sprintf("%s/crew/%s", roleInfo.Rig, roleInfo.Polecat)
-		case RolePolecat:
-			sender = fmt.Sprintf("%s/%s", roleInfo.Rig, roleInfo.Polecat)
-		case RoleWitness:
-			sender = fmt.Sprintf("%s/witness", roleInfo.Rig)
-		case RoleRefinery:
-			sender = fmt.Sprintf("%s/refinery", roleInfo.Rig)
-		case RoleDeacon:
-			sender = "deacon"
-		default:
-			sender = string(roleInfo.Role)
-		}
+	sender := nudgeRoleSender()
+	if nudgeSenderFlag != "" {
+		sender = nudgeSenderFlag
+	}
+
+	// --schedule: store the nudge for the daemon instead of sending it now
+	if !scheduleAt.IsZero() {
+		return scheduleNudge(target, message, sender, scheduleAt)
 	}
 
 	// Handle channel syntax: channel:<name>
 	if strings.HasPrefix(target, "channel:") {
+		if nudgeWaitReplyFlag > 0 {
+			return fmt.Errorf("--wait-reply is not supported for channel targets")
+		}
 		channelName := strings.TrimPrefix(target, "channel:")
-		return runNudgeChannel(channelName, message, sender)
+		if nudgeDryRunFlag {
+			return dryRunNudgeChannel(channelName, message)
+		}
+		if err := runNudgeChannel(ctx, channelName, message, sender); err != nil {
+			return err
+		}
+		if townRoot, _ := workspace.FindFromCwd(); townRoot != "" {
+			recordNudgeHistory(townRoot, target, message, ifFreshOutcome)
+		}
+		return nil
 	}
 
 	// Check DND status for target (unless force flag or channel target)
 	townRoot, _ := workspace.FindFromCwd()
+	historyAddress := target
+
+	// --wait-reply: clear any stale reply before sending and tell the target how to answer
+	if nudgeWaitReplyFlag > 0 {
+		if townRoot == "" {
+			return fmt.Errorf("--wait-reply requires a Gas Town workspace")
+		}
+		if err := nudge.ExpectReply(townRoot, sender); err != nil {
+			return err
+		}
+		message += nudgeReplyInstructions(sender)
+	}
 	if townRoot != "" && !nudgeForceFlag {
 		shouldSend, level, _ := shouldNudgeTarget(townRoot, target, nudgeForceFlag)
 		if !shouldSend {
@@ -282,6 +528,7 @@ func runNudge(cmd *cobra.Command, args []string) error {
 
 	// Expand role shortcuts to session names
 	// These shortcuts let users type "mayor" instead of "gt-mayor"
+	logger.Debug("resolving target", "target", target)
 	switch target {
 	case "mayor":
 		target = session.MayorSessionName()
@@ -305,6 +552,10 @@ func runNudge(cmd *cobra.Command, args []string) error {
 	// Special case: "deacon" target maps to the Deacon session
 	if target == "deacon" {
 		deaconSession := session.DeaconSessionName()
+		if nudgeDryRunFlag {
+			printNudgeDryRun(historyAddress, []string{deaconSession}, message)
+			return nil
+		}
 		// Check if Deacon session exists
 		exists, err := t.HasSession(deaconSession)
 		if err != nil {
@@ -312,11 +563,17 @@ func runNudge(cmd *cobra.Command, args []string) error {
 		}
 		if !exists {
 			// Deacon not running - this is not an error, just log and return
+			logger.Warn("deacon not running, nudge skipped", "session", deaconSession)
 			fmt.Printf("%s Deacon not running, nudge skipped\n", style.Dim.Render("○"))
 			return nil
 		}
 
-		if err := deliverNudge(t, deaconSession, message, sender); err != nil {
+		if nudgeDebounced(deaconSession, message) {
+			fmt.Printf("%s Nudge to deacon debounced (already sent within %s)\n", style.Dim.Render("○"), nudgeDebounceFlag)
+			return nil
+		}
+
+		if err := deliverNudge(ctx, t, deaconSession, message, sender); err != nil {
 			return fmt.Errorf("nudging deacon: %w", err)
 		}
 
@@ -325,9 +582,10 @@ func runNudge(cmd *cobra.Command, args []string) error {
 		// Log nudge event
 		if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
 			_ = LogNudge(townRoot, "deacon", message)
+			recordNudgeHistory(townRoot, historyAddress, message, ifFreshOutcome)
 		}
 		_ = events.LogFeed(events.TypeNudge, sender, events.NudgePayload("", "deacon", message))
-		return nil
+		return waitForNudgeReply(townRoot, sender)
 	}
 
 	// Check if target is rig/polecat format or raw session name
@@ -338,27 +596,14 @@ func runNudge(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
-		var sessionName string
-
-		// Check if this is a crew address (polecatName starts with "crew/")
-		if strings.HasPrefix(polecatName, "crew/") {
-			// Extract crew name and use crew session naming
-			crewName := strings.TrimPrefix(polecatName, "crew/")
-			sessionName = crewSessionName(rigName, crewName)
-		} else {
-			// Short address (e.g., "gastown/holden") - could be crew or polecat.
-			// Try crew first (matches mail system's addressToSessionIDs pattern),
-			// then fall back to polecat.
-			crewSession := crewSessionName(rigName, polecatName)
-			if exists, _ := t.HasSession(crewSession); exists {
-				sessionName = crewSession
-			} else {
-				mgr, _, err := getSessionManager(rigName)
-				if err != nil {
-					return err
-				}
-				sessionName = mgr.SessionName(polecatName)
-			}
+		sessionName, err := nudgeAddressSession(t, rigName, polecatName)
+		if err != nil {
+			return err
+		}
+		logger.Debug("resolved session", "address", target, "session", sessionName)
+		if nudgeDryRunFlag {
+			printNudgeDryRun(historyAddress, []string{sessionName}, message)
+			return nil
 		}
 
 		// For queue/wait-idle modes, verify session exists before enqueuing.
@@ -374,8 +619,13 @@ func runNudge(cmd *cobra.Command, args []string) error {
 			}
 		}
 
+		if nudgeDebounced(sessionName, message) {
+			fmt.Printf("%s Nudge to %s/%s debounced (already sent within %s)\n", style.Dim.Render("○"), rigName, polecatName, nudgeDebounceFlag)
+			return nil
+		}
+
 		// Send nudge using the configured delivery mode
-		if err := deliverNudge(t, sessionName, message, sender); err != nil {
+		if err := deliverNudge(ctx, t, sessionName, message, sender); err != nil {
 			return fmt.Errorf("nudging session: %w", err)
 		}
 
@@ -384,19 +634,30 @@ func runNudge(cmd *cobra.Command, args []string) error {
 		// Log nudge event
 		if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
 			_ = LogNudge(townRoot, target, message)
+			recordNudgeHistory(townRoot, historyAddress, message, ifFreshOutcome)
 		}
 		_ = events.LogFeed(events.TypeNudge, sender, events.NudgePayload(rigName, target, message))
 	} else {
 		// Raw session name (legacy)
+		if nudgeDryRunFlag {
+			printNudgeDryRun(historyAddress, []string{target}, message)
+			return nil
+		}
 		exists, err := t.HasSession(target)
 		if err != nil {
 			return fmt.Errorf("checking session: %w", err)
 		}
+		logger.Debug("session lookup", "session", target, "exists", exists)
 		if !exists {
 			return fmt.Errorf("session %q not found", target)
 		}
 
-		if err := deliverNudge(t, target, message, sender); err != nil {
+		if nudgeDebounced(target, message) {
+			fmt.Printf("%s Nudge to %s debounced (already sent within %s)\n", style.Dim.Render("○"), target, nudgeDebounceFlag)
+			return nil
+		}
+
+		if err := deliverNudge(ctx, t, target, message, sender); err != nil {
 			return fmt.Errorf("nudging session: %w", err)
 		}
 
@@ -405,67 +666,96 @@ func runNudge(cmd *cobra.Command, args []string) error {
 		// Log nudge event
 		if townRoot, err := workspace.FindFromCwd(); err == nil && townRoot != "" {
 			_ = LogNudge(townRoot, target, message)
+			recordNudgeHistory(townRoot, historyAddress, message, ifFreshOutcome)
 		}
 		_ = events.LogFeed(events.TypeNudge, sender, events.NudgePayload("", target, message))
 	}
 
-	return nil
+	return waitForNudgeReply(townRoot, sender)
 }
 
-// runNudgeChannel nudges all members of a named channel.
-// Routes each target through deliverNudge so --mode is respected.
-func runNudgeChannel(channelName, message, sender string) error {
-	// Find town root
-	townRoot, err := workspace.FindFromCwdOrError()
+// nudgeRoleSender returns the sender address for nudges from the current
+// role, or "unknown" outside an agent context.
+func nudgeRoleSender() string {
+	roleInfo, err := GetRole()
 	if err != nil {
-		return fmt.Errorf("cannot find town root: %w", err)
+		return "unknown"
+	}
+	switch roleInfo.Role {
+	case RoleMayor:
+		return "mayor"
+	case RoleCrew:
+		return fmt.Sprintf("%s/crew/%s", roleInfo.Rig, roleInfo.Polecat)
+	case RolePolecat:
+		return fmt.Sprintf("%s/%s", roleInfo.Rig, roleInfo.Polecat)
+	case RoleWitness:
+		return fmt.Sprintf("%s/witness", roleInfo.Rig)
+	case RoleRefinery:
+		return fmt.Sprintf("%s/refinery", roleInfo.Rig)
+	case RoleDeacon:
+		return "deacon"
+	default:
+		return string(roleInfo.Role)
 	}
+}
 
-	// Load messaging config
-	msgConfigPath := config.MessagingConfigPath(townRoot)
-	msgConfig, err := config.LoadMessagingConfig(msgConfigPath)
+// nudgeAddressSession returns the tmux session for a rig/<name> address.
+func nudgeAddressSession(t *tmux.Tmux, rigName, polecatName string) (string, error) {
+	// Check if this is a crew address (polecatName starts with "crew/")
+	if strings.HasPrefix(polecatName, "crew/") {
+		// Extract crew name and use crew session naming
+		crewName := strings.TrimPrefix(polecatName, "crew/")
+		return crewSessionName(rigName, crewName), nil
+	}
+	// Short address (e.g., "gastown/holden") - could be crew or polecat.
+	// Try crew first (matches mail system's addressToSessionIDs pattern),
+	// then fall back to polecat.
+	crewSession := crewSessionName(rigName, polecatName)
+	if exists, _ := t.HasSession(crewSession); exists {
+		return crewSession, nil
+	}
+	mgr, _, err := getSessionManager(rigName)
 	if err != nil {
-		return fmt.Errorf("loading messaging config: %w", err)
+		return "", err
 	}
+	return mgr.SessionName(polecatName), nil
+}
 
-	// Look up channel
-	patterns, ok := msgConfig.NudgeChannels[channelName]
-	if !ok {
-		return fmt.Errorf("nudge channel %q not found in messaging config", channelName)
-	}
+// runNudgeChannel nudges all members of a named channel.
+// Routes each target through deliverNudge so --mode is respected.
+func runNudgeChannel(ctx context.Context, channelName, message, sender string) error {
+	logger := nudgeLogger(ctx)
 
-	if len(patterns) == 0 {
-		return fmt.Errorf("nudge channel %q has no members", channelName)
+	// Find town root
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("cannot find town root: %w", err)
 	}
 
-	// Get all running sessions for pattern matching
-	agents, err := getAgentSessions(true)
+	patterns, err := nudgeChannelPatterns(townRoot, channelName)
 	if err != nil {
-		return fmt.Errorf("listing sessions: %w", err)
+		return err
 	}
 
 	// Resolve patterns to session names
-	var targets []string
-	seenTargets := make(map[string]bool)
-
-	for _, pattern := range patterns {
-		resolved := resolveNudgePattern(pattern, agents)
-		for _, sessionName := range resolved {
-			if !seenTargets[sessionName] {
-				seenTargets[sessionName] = true
-				targets = append(targets, sessionName)
-			}
-		}
+	targets, err := resolveNudgeChannelTargets(patterns, nudgeSessionLister)
+	if err != nil {
+		return err
 	}
+	logger.Debug("resolved channel patterns", "channel", channelName, "patterns", patterns, "targets", targets)
 
+	// Nothing running to match is not an error. Like an --if-fresh skip,
+	// it is silent with --if-fresh so session hooks don't print noise.
 	if len(targets) == 0 {
-		fmt.Printf("%s No sessions match channel %q patterns\n", style.WarningPrefix, channelName)
+		if !nudgeIfFreshFlag {
+			fmt.Fprintf(os.Stderr, "%s No sessions match channel %q patterns\n", style.WarningPrefix, channelName)
+		}
 		return nil
 	}
 
 	// Send nudges via deliverNudge (respects --mode flag)
 	t := tmux.NewTmux()
-	var succeeded, failed, skipped int
+	var succeeded, failed, skipped, debounced int
 	var failures []string
 
 	fmt.Printf("Nudging channel %q (%d target(s), mode=%s)...\n\n", channelName, len(targets), nudgeModeFlag)
@@ -482,7 +772,13 @@ func runNudgeChannel(channelName, message, sender string) error {
 			}
 		}
 
-		if err := deliverNudge(t, sessionName, message, sender); err != nil {
+		if nudgeDebounced(sessionName, message) {
+			debounced++
+			fmt.Printf("  %s %s (debounced)\n", style.Dim.Render("○"), sessionName)
+			continue
+		}
+
+		if err := deliverNudge(ctx, t, sessionName, message, sender); err != nil {
 			failed++
 			failures = append(failures, fmt.Sprintf("%s: %v", sessionName, err))
 			fmt.Printf("  %s %s\n", style.ErrorPrefix, sessionName)
@@ -507,6 +803,9 @@ func runNudgeChannel(channelName, message, sender string) error {
 		if skipped > 0 {
 			summary += fmt.Sprintf(", %d skipped (DND)", skipped)
 		}
+		if debounced > 0 {
+			summary += fmt.Sprintf(", %d debounced", debounced)
+		}
 		fmt.Printf("%s %s\n", style.WarningPrefix, summary)
 		for _, f := range failures {
 			fmt.Printf("  %s\n", style.Dim.Render(f))
@@ -518,37 +817,94 @@ func runNudgeChannel(channelName, message, sender string) error {
 	if skipped > 0 {
 		summary += fmt.Sprintf(", %d skipped (DND)", skipped)
 	}
+	if debounced > 0 {
+		summary += fmt.Sprintf(", %d debounced", debounced)
+	}
 	fmt.Printf("%s %s\n", style.SuccessPrefix, summary)
 	return nil
 }
 
+// nudgeChannelPatterns returns the member patterns of a nudge channel from
+// the town's messaging config.
+func nudgeChannelPatterns(townRoot, channelName string) ([]string, error) {
+	msgConfig, err := config.LoadMessagingConfig(config.MessagingConfigPath(townRoot))
+	if err != nil {
+		return nil, fmt.Errorf("loading messaging config: %w", err)
+	}
+
+	patterns, ok := msgConfig.NudgeChannels[channelName]
+	if !ok {
+		return nil, fmt.Errorf("nudge channel %q not found in messaging config", channelName)
+	}
+	if len(patterns) == 0 {
+		return nil, fmt.Errorf("nudge channel %q has no members", channelName)
+	}
+	return patterns, nil
+}
+
+// resolveNudgeChannelTargets resolves channel member patterns against the
+// running sessions, returning each matching session name once in pattern order.
+func resolveNudgeChannelTargets(patterns []string, lister SessionLister) ([]string, error) {
+	agents, err := lister.ListSessions()
+	if err != nil {
+		return nil, fmt.Errorf("listing sessions: %w", err)
+	}
+
+	var targets []string
+	seenTargets := make(map[string]bool)
+	for _, pattern := range patterns {
+		matches, err := resolveNudgePattern(pattern, agents)
+		if err != nil {
+			return nil, err
+		}
+		for _, sessionName := range matches {
+			if !seenTargets[sessionName] {
+				seenTargets[sessionName] = true
+				targets = append(targets, sessionName)
+			}
+		}
+	}
+	return targets, nil
+}
+
 // resolveNudgePattern resolves a nudge channel pattern to session names.
 // Patterns can be:
 //   - Literal: "gastown/witness" → gt-gastown-witness
 //   - Wildcard: "gastown/polecats/*" → all polecat sessions in gastown
+//   - Index: "gastown/polecats/0" → first polecat (by name) in gastown;
+//     "gastown/polecats/0-3" → the first four
 //   - Role: "*/witness" → all witness sessions
 //   - Special: "mayor", "deacon" → gt-{town}-mayor, gt-{town}-deacon
-// townName is used to generate the correct session names for mayor/deacon.
-func resolveNudgePattern(pattern string, agents []*AgentSession) []string {
+//
+// A malformed pattern returns an error; a valid pattern that matches no
+// running session returns nil, nil.
+func resolveNudgePattern(pattern string, agents []*AgentSession) ([]string, error) {
 	var results []string
 
 	// Handle special cases
 	switch pattern {
 	case "mayor":
-		return []string{session.MayorSessionName()}
+		return []string{session.MayorSessionName()}, nil
 	case "deacon":
-		return []string{session.DeaconSessionName()}
+		return []string{session.DeaconSessionName()}, nil
 	}
 
-	// Parse pattern
-	if !strings.Contains(pattern, "/") {
-		// Unknown pattern format
-		return nil
+	rigPattern, targetPattern, ok := strings.Cut(pattern, "/")
+	if !ok || rigPattern == "" || targetPattern == "" {
+		return nil, fmt.Errorf("invalid nudge pattern %q: want mayor, deacon, or <rig>/<target>", pattern)
+	}
+	if err := validateNudgeTargetPattern(targetPattern); err != nil {
+		return nil, fmt.Errorf("invalid nudge pattern %q: %w", pattern, err)
 	}
 
-	parts := strings.SplitN(pattern, "/", 2)
-	rigPattern := parts[0]
-	targetPattern := parts[1]
+	// Numeric index patterns address polecats by position within their rig
+	var polecatIndex map[*AgentSession]int
+	indexStart, indexEnd, isIndex := parseIndexRange(strings.TrimPrefix(targetPattern, "polecats/"))
+	if isIndex && strings.HasPrefix(targetPattern, "polecats/") {
+		polecatIndex = polecatIndexes(agents)
+	} else {
+		isIndex = false
+	}
 
 	for _, agent := range agents {
 		// Match rig pattern
@@ -563,7 +919,11 @@ func resolveNudgePattern(pattern string, agents []*AgentSession) []string {
 				continue
 			}
 			suffix := strings.TrimPrefix(targetPattern, "polecats/")
-			if suffix != "*" && suffix != agent.AgentName {
+			if isIndex {
+				if idx := polecatIndex[agent]; idx < indexStart || idx > indexEnd {
+					continue
+				}
+			} else if suffix != "*" && suffix != agent.AgentName {
 				continue
 			}
 		} else if strings.HasPrefix(targetPattern, "crew/") {
@@ -593,7 +953,89 @@ func resolveNudgePattern(pattern string, agents []*AgentSession) []string {
 		results = append(results, agent.Name)
 	}
 
-	return results
+	return results, nil
+}
+
+// validateNudgeTargetPattern checks the part of a pattern after "<rig>/":
+// polecats/<name|*|N|N-M>, crew/<name|*>, witness, refinery, or a bare
+// polecat name.
+func validateNudgeTargetPattern(target string) error {
+	kind, name, nested := strings.Cut(target, "/")
+	if !nested {
+		return nil
+	}
+	switch kind {
+	case "polecats", "crew":
+	default:
+		return fmt.Errorf("unknown target %q (want polecats/, crew/, witness, or refinery)", kind+"/")
+	}
+	if name == "" || strings.Contains(name, "/") {
+		return fmt.Errorf("%s/ needs a name or *", kind)
+	}
+	if kind == "polecats" {
+		if lo, hi, isRange := strings.Cut(name, "-"); isRange && isDigits(lo) && isDigits(hi) {
+			if _, _, ok := parseIndexRange(name); !ok {
+				return fmt.Errorf("index range %q ends before it starts", name)
+			}
+		}
+	}
+	return nil
+}
+
+// parseIndexRange parses a polecat index pattern: a single index ("2") or an
+// inclusive range ("0-3"). Returns ok=false for anything else.
+func parseIndexRange(s string) (start, end int, ok bool) {
+	lo, hi, isRange := strings.Cut(s, "-")
+	if !isRange {
+		hi = lo
+	}
+	if !isDigits(lo) || !isDigits(hi) {
+		return 0, 0, false
+	}
+	start, err := strconv.Atoi(lo)
+	if err != nil {
+		return 0, 0, false
+	}
+	end, err = strconv.Atoi(hi)
+	if err != nil || end < start {
+		return 0, 0, false
+	}
+	return start, end, true
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// polecatIndexes returns each polecat's position among its rig's polecats,
+// sorted by name, so index patterns are stable across runs.
+func polecatIndexes(agents []*AgentSession) map[*AgentSession]int {
+	byRig := make(map[string][]*AgentSession)
+	for _, agent := range agents {
+		if agent.Type == AgentPolecat {
+			byRig[agent.Rig] = append(byRig[agent.Rig], agent)
+		}
+	}
+
+	indexes := make(map[*AgentSession]int)
+	for _, polecats := range byRig {
+		sort.Slice(polecats, func(i, j int) bool {
+			return polecats[i].AgentName < polecats[j].AgentName
+		})
+		for i, p := range polecats {
+			indexes[p] = i
+		}
+	}
+	return indexes
 }
 
 // shouldNudgeTarget checks if a nudge should be sent based on the target's notification level.
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var nudgeBroadcastRig string
+
+var nudgeBroadcastCmd = &cobra.Command{
+	Use:   "broadcast <message>",
+	Short: "Nudge every running agent",
+	Long: `Nudge every running agent: polecats, crew, witnesses, refineries,
+the mayor and the deacon. Your own session is skipped, as are agents with
+DND enabled.
+
+Use --rig to only nudge agents in one rig. This is the same as
+'gt broadcast --all'; use 'gt broadcast' without --all to nudge only
+workers (polecats and crew).
+
+Examples:
+  gt nudge broadcast "please commit your work"
+  gt nudge broadcast --rig greenplace "Rebasing main in 5 minutes"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runNudgeBroadcast,
+}
+
+func init() {
+	nudgeBroadcastCmd.Flags().StringVar(&nudgeBroadcastRig, "rig", "", "Only nudge agents in this rig")
+	nudgeCmd.AddCommand(nudgeBroadcastCmd)
+}
+
+func runNudgeBroadcast(cmd *cobra.Command, args []string) error {
+	return broadcastNudge(args[0], nudgeBroadcastRig, true, false)
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// nudgeDryRunMessageLen is how much of the message --dry-run shows.
+const nudgeDryRunMessageLen = 80
+
+// dryRunNudgeChannel resolves a channel's patterns and prints the sessions
+// a nudge to it would reach.
+func dryRunNudgeChannel(channelName, message string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("cannot find town root: %w", err)
+	}
+	patterns, err := nudgeChannelPatterns(townRoot, channelName)
+	if err != nil {
+		return err
+	}
+	targets, err := resolveNudgeChannelTargets(patterns, nudgeSessionLister)
+	if err != nil {
+		return err
+	}
+	printNudgeDryRun(fmt.Sprintf("channel:%s (%s)", channelName, strings.Join(patterns, ", ")), targets, message)
+	return nil
+}
+
+// printNudgeDryRun prints what --dry-run would have sent.
+func printNudgeDryRun(pattern string, targets []string, message string) {
+	fmt.Print(formatNudgeDryRun(pattern, targets, message))
+}
+
+// formatNudgeDryRun renders the --dry-run report: the resolved pattern, each
+// target session with its address, and the (truncated) message.
+func formatNudgeDryRun(pattern string, targets []string, message string) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s Dry run, nothing sent (mode=%s)\n", style.Dim.Render("○"), nudgeModeFlag)
+	fmt.Fprintf(&sb, "  Pattern: %s\n", pattern)
+	fmt.Fprintf(&sb, "  Targets: %d\n", len(targets))
+	for _, sessionName := range targets {
+		addr := sessionNameToAddress(sessionName)
+		if addr == "" {
+			addr = "-"
+		}
+		fmt.Fprintf(&sb, "    %s %s\n", sessionName, style.Dim.Render(addr))
+	}
+	msg := strings.ReplaceAll(message, "\n", " ")
+	fmt.Fprintf(&sb, "  Message: %s\n", truncateWithEllipsis(msg, nudgeDryRunMessageLen))
+	return sb.String()
+}
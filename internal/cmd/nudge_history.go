@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/nudge"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var nudgeHistoryCount int
+
+var nudgeHistoryCmd = &cobra.Command{
+	Use:   "history <address>",
+	Short: "Show recent nudges sent to an agent",
+	Long: `Show the most recent nudges sent to an address, oldest first.
+
+The address is the target exactly as it was passed to 'gt nudge'
+(e.g. gastown/alpha, gastown/crew/max, mayor, channel:workers). Each entry
+shows when it was sent, the sending host and pid, and the --if-fresh outcome
+when that flag was used.
+
+Examples:
+  gt nudge history gastown/alpha
+  gt nudge history deacon --n 50`,
+	Args: cobra.ExactArgs(1),
+	RunE: runNudgeHistory,
+}
+
+func init() {
+	nudgeHistoryCmd.Flags().IntVarP(&nudgeHistoryCount, "n", "n", 20, "Number of entries to show")
+	nudgeCmd.AddCommand(nudgeHistoryCmd)
+}
+
+func runNudgeHistory(cmd *cobra.Command, args []string) error {
+	if nudgeHistoryCount < 1 {
+		return fmt.Errorf("--n must be at least 1")
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	address := args[0]
+	entries, err := nudge.ReadHistory(townRoot, address, nudgeHistoryCount)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		fmt.Printf("No nudges recorded for %s\n", address)
+		return nil
+	}
+
+	for _, e := range entries {
+		fmt.Println(formatNudgeHistoryEntry(e))
+	}
+	return nil
+}
+
+// formatNudgeHistoryEntry renders one history entry as a header line followed
+// by the indented message.
+func formatNudgeHistoryEntry(e nudge.HistoryEntry) string {
+	header := fmt.Sprintf("%s  %s", e.Timestamp.Local().Format(time.DateTime), e.Sender)
+	if e.IfFresh != "" {
+		header += "  if-fresh: " + e.IfFresh
+	}
+	var b strings.Builder
+	b.WriteString(style.Dim.Render(header))
+	for _, line := range strings.Split(e.Message, "\n") {
+		b.WriteString("\n  " + line)
+	}
+	return b.String()
+}
+
+// recordNudgeHistory appends a nudge to the target's history log.
+// Failures are reported but never block the nudge itself.
+func recordNudgeHistory(townRoot, address, message, ifFresh string) {
+	err := nudge.AppendHistory(townRoot, address, nudge.HistoryEntry{
+		Timestamp: time.Now().UTC(),
+		Message:   message,
+		Sender:    nudge.HistorySender(),
+		IfFresh:   ifFresh,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: recording nudge history failed: %v\n", err)
+	}
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// nudgeLoggerKey is the context key for the nudge operation logger.
+type nudgeLoggerKey struct{}
+
+// parseNudgeLogLevel parses the --log-level flag.
+func parseNudgeLogLevel(s string) (slog.Level, error) {
+	switch strings.ToLower(s) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return 0, fmt.Errorf("invalid --log-level %q: must be one of debug, info, warn, error", s)
+}
+
+// newNudgeLogger returns the logger for nudge operations. It writes to stderr
+// so stdout stays the user-facing report.
+func newNudgeLogger(level slog.Level) *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+}
+
+// withNudgeLogger returns a copy of ctx carrying logger.
+func withNudgeLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, nudgeLoggerKey{}, logger)
+}
+
+// nudgeLogger returns the logger carried by ctx, or one that discards
+// everything if there is none (e.g. nudges sent on behalf of other commands).
+func nudgeLogger(ctx context.Context) *slog.Logger {
+	if ctx != nil {
+		if logger, ok := ctx.Value(nudgeLoggerKey{}).(*slog.Logger); ok {
+			return logger
+		}
+	}
+	return slog.New(slog.DiscardHandler)
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/nudge"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var nudgePingWaitReply time.Duration
+
+var nudgePingCmd = &cobra.Command{
+	Use:   "ping <address>",
+	Short: "Check that an agent's session is alive and responsive",
+	Long: `Send a short "ping" nudge to an agent, e.g. before sending it a long message.
+
+Without --wait-reply, this reports whether the ping was typed into the
+agent's tmux session. That shows the session exists, not that the agent is
+responding.
+
+With --wait-reply, the ping asks the agent to answer with
+'gt nudge reply <you> pong', and this blocks until it does (or the timeout
+passes) and prints the round-trip time. Any reply counts; a reply other
+than "pong" is printed.
+
+The address is mayor, deacon, <rig>/<name>, <rig>/crew/<name>,
+<rig>/witness, <rig>/refinery, or a raw tmux session name. Pings ignore
+DND and --mode, and aren't debounced or recorded in nudge history.
+
+Examples:
+  gt nudge ping gastown/Toast
+  gt nudge ping mayor --wait-reply 2m`,
+	Args: cobra.ExactArgs(1),
+	RunE: runNudgePing,
+}
+
+func init() {
+	nudgePingCmd.Flags().DurationVar(&nudgePingWaitReply, "wait-reply", 0, "Block up to this long for the agent to answer pong (e.g. 2m)")
+	nudgeCmd.AddCommand(nudgePingCmd)
+}
+
+func runNudgePing(cmd *cobra.Command, args []string) error {
+	address := args[0]
+	t := tmux.NewTmux()
+
+	sessionName, err := pingSession(t, address)
+	if err != nil {
+		return err
+	}
+	exists, err := t.HasSession(sessionName)
+	if err != nil {
+		return fmt.Errorf("checking session: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("%s is not running (no session %q)", address, sessionName)
+	}
+
+	sender := nudgeRoleSender()
+	var townRoot string
+	if nudgePingWaitReply > 0 {
+		townRoot, err = workspace.FindFromCwdOrError()
+		if err != nil {
+			return fmt.Errorf("--wait-reply requires a Gas Town workspace: %w", err)
+		}
+		if err := nudge.ExpectReply(townRoot, sender); err != nil {
+			return err
+		}
+	}
+
+	start := time.Now()
+	if err := t.NudgeSession(sessionName, nudgePingMessage(sender, nudgePingWaitReply > 0)); err != nil {
+		return fmt.Errorf("pinging %s: %w", address, err)
+	}
+	if nudgePingWaitReply <= 0 {
+		fmt.Printf("%s Pinged %s (session %s)\n", style.Bold.Render("✓"), address, sessionName)
+		return nil
+	}
+
+	reply, err := nudge.WaitReply(townRoot, sender, nudgePingWaitReply)
+	if errors.Is(err, nudge.ErrReplyTimeout) {
+		return fmt.Errorf("no reply from %s within %s", address, nudgePingWaitReply)
+	}
+	if err != nil {
+		return err
+	}
+	rtt := time.Since(start).Round(time.Millisecond)
+	if strings.TrimSpace(reply) == "pong" {
+		fmt.Printf("%s pong from %s in %s\n", style.Bold.Render("✓"), address, rtt)
+	} else {
+		fmt.Printf("%s Reply from %s in %s: %s\n", style.Bold.Render("✓"), address, rtt, reply)
+	}
+	return nil
+}
+
+// pingSession returns the tmux session to ping for an address.
+func pingSession(t *tmux.Tmux, address string) (string, error) {
+	switch address {
+	case "mayor":
+		return session.MayorSessionName(), nil
+	case "deacon":
+		return session.DeaconSessionName(), nil
+	}
+	if !strings.Contains(address, "/") {
+		return address, nil // Raw session name
+	}
+	rigName, name, err := parseAddress(address)
+	if err != nil {
+		return "", err
+	}
+	return nudgeAddressSession(t, rigName, name)
+}
+
+// nudgePingMessage is the nudge gt nudge ping sends. When waiting for a
+// reply it tells the agent how to answer.
+func nudgePingMessage(sender string, waitReply bool) string {
+	if !waitReply {
+		return "ping"
+	}
+	return fmt.Sprintf("ping (reply with: gt nudge reply %s pong)", sender)
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/nudge"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var nudgeReplyCmd = &cobra.Command{
+	Use:   "reply <address> <message>",
+	Short: "Reply to a nudge sent with --wait-reply",
+	Long: `Reply to an agent that is blocked in 'gt nudge --wait-reply'.
+
+The address is the sender shown in the nudge ("[from <address>]"). Nudges
+sent with --wait-reply include the exact reply command to run.
+
+Examples:
+  gt nudge reply mayor "Tests pass, ready to merge"
+  gt nudge reply gastown/crew/max "Blocked on gt-123"`,
+	Args: cobra.ExactArgs(2),
+	RunE: runNudgeReply,
+}
+
+func init() {
+	nudgeCmd.AddCommand(nudgeReplyCmd)
+}
+
+func runNudgeReply(cmd *cobra.Command, args []string) error {
+	address, message := args[0], args[1]
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	if err := nudge.WriteReply(townRoot, address, message); err != nil {
+		return err
+	}
+	fmt.Printf("%s Replied to %s\n", style.Bold.Render("✓"), address)
+	return nil
+}
+
+// nudgeReplyInstructions is appended to a --wait-reply nudge so the target
+// knows how to answer.
+func nudgeReplyInstructions(sender string) string {
+	return fmt.Sprintf("\n\n(Reply with: gt nudge reply %s \"<your reply>\")", sender)
+}
+
+// waitForNudgeReply blocks for a reply to sender when --wait-reply is set and
+// prints it on stdout. It is a no-op otherwise.
+func waitForNudgeReply(townRoot, sender string) error {
+	if nudgeWaitReplyFlag <= 0 {
+		return nil
+	}
+	reply, err := nudge.WaitReply(townRoot, sender, nudgeWaitReplyFlag)
+	if errors.Is(err, nudge.ErrReplyTimeout) {
+		return fmt.Errorf("no reply within %s", nudgeWaitReplyFlag)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Println(reply)
+	return nil
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// nudgeRetrySleep waits between retries; tests replace it to avoid real delays.
+var nudgeRetrySleep = time.Sleep
+
+// withNudgeRetries runs send, retrying up to retries more times if it fails.
+// The wait doubles after each failure, starting at baseDelay. If every
+// attempt fails, the last error is returned with the number of attempts made.
+func withNudgeRetries(logger *slog.Logger, retries int, baseDelay time.Duration, send func() error) error {
+	delay := baseDelay
+	for attempt := 1; ; attempt++ {
+		err := send()
+		if err == nil {
+			return nil
+		}
+		if attempt > retries {
+			if attempt == 1 {
+				return err
+			}
+			return fmt.Errorf("failed after %d attempts: %w", attempt, err)
+		}
+		logger.Debug("nudge failed, retrying", "attempt", attempt, "delay", delay, "error", err)
+		nudgeRetrySleep(delay)
+		delay *= 2
+	}
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/nudge"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var nudgeScheduledCmd = &cobra.Command{
+	Use:   "scheduled",
+	Short: "Manage nudges scheduled with --schedule",
+	Long: `Manage nudges scheduled for later with 'gt nudge --schedule'.
+
+Scheduled nudges are stored in <town>/.runtime/scheduled-nudges/ and
+delivered by the daemon ('gt daemon'), which checks every 30 seconds. They
+are not delivered while the daemon is stopped; overdue nudges go out when
+it next starts.`,
+	RunE: requireSubcommand,
+}
+
+var nudgeScheduledListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List pending scheduled nudges",
+	Args:  cobra.NoArgs,
+	RunE:  runNudgeScheduledList,
+}
+
+var nudgeScheduledCancelCmd = &cobra.Command{
+	Use:   "cancel <id>",
+	Short: "Cancel a pending scheduled nudge",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runNudgeScheduledCancel,
+}
+
+func init() {
+	nudgeScheduledCmd.AddCommand(nudgeScheduledListCmd)
+	nudgeScheduledCmd.AddCommand(nudgeScheduledCancelCmd)
+	nudgeCmd.AddCommand(nudgeScheduledCmd)
+}
+
+// parseNudgeSchedule parses a --schedule value: an RFC 3339 time, or a delay
+// from now such as 90m, +2h or 1d. The result must be in the future.
+func parseNudgeSchedule(value string, now time.Time) (time.Time, error) {
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		delay, durErr := parseDuration(strings.TrimPrefix(value, "+"))
+		if durErr != nil {
+			return time.Time{}, fmt.Errorf("invalid --schedule %q: want an RFC 3339 time (2026-03-05T09:00:00-08:00) or a delay (90m, 2h, 1d)", value)
+		}
+		at = now.Add(delay)
+	}
+	if !at.After(now) {
+		return time.Time{}, fmt.Errorf("invalid --schedule %q: time is not in the future", value)
+	}
+	return at, nil
+}
+
+// scheduleNudge stores a nudge for the daemon to deliver at deliverAt.
+// The witness and refinery shortcuts depend on the caller's rig, so they are
+// resolved to session names now rather than at delivery.
+func scheduleNudge(target, message, sender string, deliverAt time.Time) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	if target == "witness" || target == "refinery" {
+		roleInfo, err := GetRole()
+		if err != nil || roleInfo.Rig == "" {
+			return fmt.Errorf("cannot determine rig for %s shortcut (not in a rig context)", target)
+		}
+		if target == "witness" {
+			target = session.WitnessSessionName(session.PrefixFor(roleInfo.Rig))
+		} else {
+			target = session.RefinerySessionName(session.PrefixFor(roleInfo.Rig))
+		}
+	}
+
+	n, err := nudge.Schedule(townRoot, nudge.ScheduledNudge{
+		Target:    target,
+		Message:   message,
+		Sender:    sender,
+		Mode:      nudgeModeFlag,
+		Priority:  nudgePriorityFlag,
+		Force:     nudgeForceFlag,
+		DeliverAt: deliverAt,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s Scheduled nudge %s to %s for %s\n", style.Bold.Render("✓"), n.ID, target,
+		deliverAt.Local().Format(time.DateTime))
+	fmt.Printf("  %s\n", style.Dim.Render("Cancel with: gt nudge scheduled cancel "+n.ID))
+	return nil
+}
+
+func runNudgeScheduledList(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	nudges, err := nudge.ListScheduled(townRoot)
+	if err != nil {
+		return err
+	}
+	if len(nudges) == 0 {
+		fmt.Println("No scheduled nudges.")
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tDELIVER AT\tTARGET\tMESSAGE")
+	for _, n := range nudges {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", n.ID, n.DeliverAt.Local().Format(time.DateTime), n.Target,
+			truncateWithEllipsis(strings.ReplaceAll(n.Message, "\n", " "), 60))
+	}
+	return tw.Flush()
+}
+
+func runNudgeScheduledCancel(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	id := args[0]
+	if err := nudge.CancelScheduled(townRoot, id); err != nil {
+		if errors.Is(err, nudge.ErrScheduledNotFound) {
+			return fmt.Errorf("no scheduled nudge %q (see 'gt nudge scheduled list')", id)
+		}
+		return err
+	}
+	fmt.Printf("%s Cancelled scheduled nudge %s\n", style.Bold.Render("✓"), id)
+	return nil
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/nudge"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// Tail command flags
+var (
+	nudgeTailCount    int
+	nudgeTailInterval time.Duration
+)
+
+var nudgeTailCmd = &cobra.Command{
+	Use:   "tail <address>",
+	Short: "Stream nudges sent to an agent as they arrive",
+	Long: `Follow the nudge log for an address, like tail -f.
+
+Prints the last --n entries from <town>/.runtime/nudge-log/ (the log
+behind 'gt nudge history'), then each new nudge as it is recorded, with the
+timestamp, sender and message in different colors. The address is the
+target exactly as it was passed to 'gt nudge'.
+
+Press Ctrl+C to stop.
+
+Examples:
+  gt nudge tail gastown/alpha
+  gt nudge tail deacon --n 0`,
+	Args: cobra.ExactArgs(1),
+	RunE: runNudgeTail,
+}
+
+func init() {
+	nudgeTailCmd.Flags().IntVarP(&nudgeTailCount, "n", "n", 10, "Number of earlier entries to show first")
+	nudgeTailCmd.Flags().DurationVar(&nudgeTailInterval, "interval", 500*time.Millisecond, "How often to check for new nudges")
+	nudgeCmd.AddCommand(nudgeTailCmd)
+}
+
+func runNudgeTail(cmd *cobra.Command, args []string) error {
+	if nudgeTailCount < 0 {
+		return fmt.Errorf("--n must not be negative")
+	}
+	if nudgeTailInterval <= 0 {
+		return fmt.Errorf("--interval must be positive, got %s", nudgeTailInterval)
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	address := args[0]
+
+	// Position the follower first so nothing logged in between is missed
+	follower, err := nudge.FollowHistory(townRoot, address)
+	if err != nil {
+		return err
+	}
+	if nudgeTailCount > 0 {
+		entries, err := nudge.ReadHistory(townRoot, address, nudgeTailCount)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			fmt.Println(formatNudgeTailEntry(e))
+		}
+	}
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigChan)
+
+	ticker := time.NewTicker(nudgeTailInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-sigChan:
+			return nil
+		case <-ticker.C:
+		}
+
+		entries, err := follower.Next()
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			fmt.Println(formatNudgeTailEntry(e))
+		}
+	}
+}
+
+// formatNudgeTailEntry renders a history entry on one line per message
+// line: the timestamp dimmed, the sender highlighted, then the message.
+func formatNudgeTailEntry(e nudge.HistoryEntry) string {
+	prefix := style.Dim.Render(e.Timestamp.Local().Format(time.DateTime)) + " " +
+		style.Info.Render(e.Sender)
+	if e.IfFresh != "" {
+		prefix += " " + style.Warning.Render("if-fresh: "+e.IfFresh)
+	}
+	lines := strings.Split(e.Message, "\n")
+	var b strings.Builder
+	b.WriteString(prefix + " " + style.Bold.Render(lines[0]))
+	for _, line := range lines[1:] {
+		b.WriteString("\n  " + style.Bold.Render(line))
+	}
+	return b.String()
+}
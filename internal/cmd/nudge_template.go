@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/nudge"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var nudgeTemplateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "Manage nudge message templates",
+	Long: `Manage reusable nudge message templates.
+
+Templates are plain text files in <town>/settings/nudge-templates/<name>.txt
+and are sent with 'gt nudge <target> --template <name>'. They are expanded
+as Go templates with these fields:
+
+  {{.RigName}}    Target's rig (empty for town-level agents)
+  {{.AgentName}}  Target's name (e.g. toast, witness, mayor)
+  {{.Timestamp}}  Send time (RFC 3339)`,
+	RunE: requireSubcommand,
+}
+
+var nudgeTemplateListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List available nudge templates",
+	Args:  cobra.NoArgs,
+	RunE:  runNudgeTemplateList,
+}
+
+func init() {
+	nudgeTemplateCmd.AddCommand(nudgeTemplateListCmd)
+	nudgeCmd.AddCommand(nudgeTemplateCmd)
+}
+
+func runNudgeTemplateList(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	names, err := nudge.ListTemplates(townRoot)
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		fmt.Printf("No nudge templates. Add one at %s\n",
+			style.Dim.Render(nudge.TemplateDir(townRoot)+"/<name>.txt"))
+		return nil
+	}
+	for _, name := range names {
+		fmt.Println(name)
+	}
+	return nil
+}
+
+// nudgeTemplateData builds template fields for a nudge target address.
+// "rig/name" and "rig/crew/name" give both fields; a bare role such as
+// "mayor" or a channel: target only sets AgentName.
+func nudgeTemplateData(target string, now time.Time) nudge.TemplateData {
+	data := nudge.TemplateData{
+		AgentName: target,
+		Timestamp: now.UTC().Format(time.RFC3339),
+	}
+	if parts := strings.Split(target, "/"); len(parts) > 1 && !strings.HasPrefix(target, "channel:") {
+		data.RigName = parts[0]
+		data.AgentName = parts[len(parts)-1]
+	}
+	return data
+}
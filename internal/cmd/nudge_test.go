@@ -1,6 +1,12 @@
 package cmd
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -41,6 +47,86 @@ func TestNudgeStdinConflict(t *testing.T) {
 	}
 }
 
+func TestNudgeFileConflict(t *testing.T) {
+	origMessage := nudgeMessageFlag
+	origStdin := nudgeStdinFlag
+	origFile := nudgeFileFlag
+	defer func() {
+		nudgeMessageFlag = origMessage
+		nudgeStdinFlag = origStdin
+		nudgeFileFlag = origFile
+	}()
+
+	tests := []struct {
+		name    string
+		message string
+		stdin   bool
+		args    []string
+		wantErr string
+	}{
+		{"file and message", "some message", false, nil, "cannot use --file with --message/-m"},
+		{"file and stdin", "", true, nil, "cannot use --file with --stdin"},
+		{"file and message argument", "", false, []string{"some message"}, "cannot use --file with a message argument"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nudgeFileFlag = "msg.txt"
+			nudgeMessageFlag = tt.message
+			nudgeStdinFlag = tt.stdin
+
+			err := runNudge(nudgeCmd, append([]string{"gastown/alpha"}, tt.args...))
+			if err == nil {
+				t.Fatal("expected error for conflicting message sources")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("got error %q, want to contain %q", err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNudgeFrom(t *testing.T) {
+	if got, want := withNudgeFrom("session-started", "gastown/polecats/Toast"), "session-started from=gastown/polecats/Toast"; got != want {
+		t.Errorf("withNudgeFrom = %q, want %q", got, want)
+	}
+
+	origFrom := nudgeFromFlag
+	defer func() { nudgeFromFlag = origFrom }()
+	nudgeFromFlag = "gastown/polecats/Toast Nux"
+	err := runNudge(nudgeCmd, []string{"deacon", "session-started"})
+	if err == nil || !strings.Contains(err.Error(), "invalid --from") {
+		t.Errorf("runNudge with a spaced --from = %v, want invalid --from error", err)
+	}
+}
+
+func TestReadNudgeFile(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "msg.json")
+	if err := os.WriteFile(path, []byte("{\n  \"task\": \"review\"\n}\n\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	msg, err := readNudgeFile(path)
+	if err != nil {
+		t.Fatalf("readNudgeFile: %v", err)
+	}
+	if msg != "{\n  \"task\": \"review\"\n}" {
+		t.Errorf("readNudgeFile = %q", msg)
+	}
+
+	empty := filepath.Join(dir, "empty.txt")
+	if err := os.WriteFile(empty, []byte("\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readNudgeFile(empty); err == nil || !strings.Contains(err.Error(), "is empty") {
+		t.Errorf("expected empty file error, got %v", err)
+	}
+
+	if _, err := readNudgeFile(filepath.Join(dir, "missing.txt")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
 func TestResolveNudgePattern(t *testing.T) {
 	setupNudgeTestRegistry(t)
 	// Create test agent sessions (using rig prefixes)
@@ -61,6 +147,7 @@ func TestResolveNudgePattern(t *testing.T) {
 		name     string
 		pattern  string
 		expected []string
+		wantErr  bool
 	}{
 		{
 			name:     "mayor special case",
@@ -97,6 +184,26 @@ func TestResolveNudgePattern(t *testing.T) {
 			pattern:  "gastown/polecats/alpha",
 			expected: []string{"gt-alpha"},
 		},
+		{
+			name:     "polecat by index",
+			pattern:  "gastown/polecats/1",
+			expected: []string{"gt-beta"},
+		},
+		{
+			name:     "polecats by index range",
+			pattern:  "gastown/polecats/0-3",
+			expected: []string{"gt-alpha", "gt-beta"},
+		},
+		{
+			name:     "polecat index per rig",
+			pattern:  "*/polecats/0",
+			expected: []string{"gt-alpha", "bd-gamma"},
+		},
+		{
+			name:     "polecat index out of range",
+			pattern:  "gastown/polecats/5",
+			expected: nil,
+		},
 		{
 			name:     "all crew in rig",
 			pattern:  "gastown/crew/*",
@@ -118,15 +225,44 @@ func TestResolveNudgePattern(t *testing.T) {
 			expected: nil,
 		},
 		{
-			name:     "invalid pattern",
-			pattern:  "invalid",
-			expected: nil,
+			name:    "invalid pattern",
+			pattern: "invalid",
+			wantErr: true,
+		},
+		{
+			name:    "empty rig",
+			pattern: "/witness",
+			wantErr: true,
+		},
+		{
+			name:    "unknown nested target",
+			pattern: "gastown/dogs/*",
+			wantErr: true,
+		},
+		{
+			name:    "polecats without name",
+			pattern: "gastown/polecats/",
+			wantErr: true,
+		},
+		{
+			name:    "inverted index range",
+			pattern: "gastown/polecats/3-1",
+			wantErr: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := resolveNudgePattern(tt.pattern, agents)
+			got, err := resolveNudgePattern(tt.pattern, agents)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("resolveNudgePattern(%q) = %v, want error", tt.pattern, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveNudgePattern(%q): %v", tt.pattern, err)
+			}
 
 			if len(got) != len(tt.expected) {
 				t.Errorf("resolveNudgePattern(%q) returned %d results, want %d: got %v, want %v",
@@ -149,6 +285,44 @@ func TestResolveNudgePattern(t *testing.T) {
 	}
 }
 
+// failingSessionLister is a SessionLister whose listing always fails.
+type failingSessionLister struct{}
+
+func (failingSessionLister) ListSessions() ([]*AgentSession, error) {
+	return nil, errors.New("no server running")
+}
+
+func TestResolveNudgeChannelTargets(t *testing.T) {
+	setupNudgeTestRegistry(t)
+	lister := staticSessionLister{
+		{Name: "hq-mayor", Type: AgentMayor},
+		{Name: "gt-witness", Type: AgentWitness, Rig: "gastown"},
+		{Name: "gt-alpha", Type: AgentPolecat, Rig: "gastown", AgentName: "alpha"},
+		{Name: "gt-beta", Type: AgentPolecat, Rig: "gastown", AgentName: "beta"},
+	}
+
+	// Overlapping patterns yield each session once, in pattern order
+	got, err := resolveNudgeChannelTargets([]string{"gastown/polecats/alpha", "gastown/polecats/*", "mayor"}, lister)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"gt-alpha", "gt-beta", "hq-mayor"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("resolveNudgeChannelTargets = %v, want %v", got, want)
+	}
+
+	if _, err := resolveNudgeChannelTargets([]string{"mayor", "gastown/nope/x"}, lister); err == nil || !strings.Contains(err.Error(), "invalid nudge pattern") {
+		t.Errorf("expected invalid pattern error, got %v", err)
+	}
+	if got, err := resolveNudgeChannelTargets([]string{"nonexistent/polecats/*"}, lister); err != nil || got != nil {
+		t.Errorf("no matches = %v, %v; want nil, nil", got, err)
+	}
+
+	if _, err := resolveNudgeChannelTargets([]string{"mayor"}, failingSessionLister{}); err == nil || !strings.Contains(err.Error(), "listing sessions") {
+		t.Errorf("expected listing error, got %v", err)
+	}
+}
+
 func TestSessionNameToAddress(t *testing.T) {
 	setupNudgeTestRegistry(t)
 	tests := []struct {
@@ -364,15 +538,31 @@ func TestIfFreshSessionAgeCheck(t *testing.T) {
 		},
 	}
 
+	newSession := func(t *testing.T, createdAt time.Time) *AgentSession {
+		t.Helper()
+		sess, err := NewAgentSession("hq-deacon", AgentDeacon, "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		sess.CreatedAt = createdAt
+		return sess
+	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			age := time.Since(tt.createdAt)
-			shouldNudge := age <= ifFreshMaxAge
-			if shouldNudge != tt.shouldNudge {
-				t.Errorf("age=%v: shouldNudge=%v, want %v", age, shouldNudge, tt.shouldNudge)
+			sess := newSession(t, tt.createdAt)
+			if got := isFreshSession(sess, now); got != tt.shouldNudge {
+				t.Errorf("age=%v: isFreshSession=%v, want %v", sess.Age(now), got, tt.shouldNudge)
 			}
 		})
 	}
+
+	t.Run("unknown creation time", func(t *testing.T) {
+		sess := newSession(t, time.Time{})
+		if sess.Age(now) != 0 || !isFreshSession(sess, now) {
+			t.Error("session with unknown CreatedAt should be treated as fresh")
+		}
+	})
 }
 
 func TestValidModeMapsMatchConstants(t *testing.T) {
@@ -390,3 +580,193 @@ func TestValidModeMapsMatchConstants(t *testing.T) {
 		}
 	}
 }
+
+func TestParseIndexRange(t *testing.T) {
+	tests := []struct {
+		in         string
+		start, end int
+		ok         bool
+	}{
+		{"0", 0, 0, true},
+		{"12", 12, 12, true},
+		{"0-3", 0, 3, true},
+		{"2-2", 2, 2, true},
+		{"3-1", 0, 0, false},
+		{"", 0, 0, false},
+		{"*", 0, 0, false},
+		{"alpha", 0, 0, false},
+		{"-1", 0, 0, false},
+		{"1-", 0, 0, false},
+		{"+1", 0, 0, false},
+		{"1-2-3", 0, 0, false},
+	}
+	for _, tt := range tests {
+		start, end, ok := parseIndexRange(tt.in)
+		if start != tt.start || end != tt.end || ok != tt.ok {
+			t.Errorf("parseIndexRange(%q) = (%d, %d, %v), want (%d, %d, %v)",
+				tt.in, start, end, ok, tt.start, tt.end, tt.ok)
+		}
+	}
+}
+
+func TestNudgeReplyInstructions(t *testing.T) {
+	got := nudgeReplyInstructions("gastown/crew/max")
+	if !strings.Contains(got, `gt nudge reply gastown/crew/max "<your reply>"`) {
+		t.Errorf("nudgeReplyInstructions = %q, want reply command for sender", got)
+	}
+}
+
+func TestNudgePingMessage(t *testing.T) {
+	if got := nudgePingMessage("mayor", false); got != "ping" {
+		t.Errorf("nudgePingMessage without reply = %q, want %q", got, "ping")
+	}
+	got := nudgePingMessage("gastown/crew/max", true)
+	if !strings.HasPrefix(got, "ping") || !strings.Contains(got, "gt nudge reply gastown/crew/max pong") {
+		t.Errorf("nudgePingMessage with reply = %q, want ping with reply command", got)
+	}
+}
+
+func TestNudgeTemplateData(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		target    string
+		rig, name string
+	}{
+		{"gastown/toast", "gastown", "toast"},
+		{"gastown/crew/max", "gastown", "max"},
+		{"mayor", "", "mayor"},
+		{"channel:workers", "", "channel:workers"},
+	}
+	for _, tt := range tests {
+		data := nudgeTemplateData(tt.target, now)
+		if data.RigName != tt.rig || data.AgentName != tt.name {
+			t.Errorf("nudgeTemplateData(%q) = %+v, want rig %q agent %q", tt.target, data, tt.rig, tt.name)
+		}
+		if data.Timestamp != "2026-01-02T03:04:05Z" {
+			t.Errorf("Timestamp = %q", data.Timestamp)
+		}
+	}
+}
+
+func TestNudgeLogger(t *testing.T) {
+	for _, s := range []string{"debug", "info", "WARN", "error"} {
+		if _, err := parseNudgeLogLevel(s); err != nil {
+			t.Errorf("parseNudgeLogLevel(%q) error = %v", s, err)
+		}
+	}
+	if _, err := parseNudgeLogLevel("verbose"); err == nil {
+		t.Error("parseNudgeLogLevel(verbose) should fail")
+	}
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	ctx := withNudgeLogger(context.Background(), logger)
+	nudgeLogger(ctx).Info("nudge sent")
+	nudgeLogger(ctx).Warn("nudge failed")
+	if out := buf.String(); strings.Contains(out, "nudge sent") || !strings.Contains(out, "nudge failed") {
+		t.Errorf("warn-level log output = %q, want only the failure", out)
+	}
+
+	// Without a logger in the context, logging is a no-op
+	nudgeLogger(context.Background()).Warn("dropped")
+}
+
+func TestFormatNudgeDryRun(t *testing.T) {
+	setupNudgeTestRegistry(t)
+
+	long := strings.Repeat("x", 100)
+	out := formatNudgeDryRun("gastown/polecats/*", []string{"gt-alpha", "gt-beta"}, "line one\n"+long)
+	for _, want := range []string{
+		"Pattern: gastown/polecats/*",
+		"Targets: 2",
+		"gt-alpha", "gastown/alpha",
+		"gt-beta", "gastown/beta",
+		"Message: line one xxx",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("dry run output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, long) || !strings.Contains(out, "...") {
+		t.Errorf("dry run message should be truncated:\n%s", out)
+	}
+}
+
+func TestWithNudgeRetries(t *testing.T) {
+	var delays []time.Duration
+	oldSleep := nudgeRetrySleep
+	nudgeRetrySleep = func(d time.Duration) { delays = append(delays, d) }
+	t.Cleanup(func() { nudgeRetrySleep = oldSleep })
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	errTmux := errors.New("session temporarily unavailable")
+
+	// Succeeds on the third attempt, backing off 500ms then 1s
+	calls := 0
+	err := withNudgeRetries(logger, 3, 500*time.Millisecond, func() error {
+		calls++
+		if calls < 3 {
+			return errTmux
+		}
+		return nil
+	})
+	if err != nil || calls != 3 {
+		t.Fatalf("err = %v after %d calls, want success on call 3", err, calls)
+	}
+	if len(delays) != 2 || delays[0] != 500*time.Millisecond || delays[1] != time.Second {
+		t.Errorf("delays = %v, want [500ms 1s]", delays)
+	}
+	if strings.Count(logs.String(), "retrying") != 2 {
+		t.Errorf("want 2 debug retry logs, got:\n%s", logs.String())
+	}
+
+	// Exhausted retries report the attempt count and wrap the last error
+	calls = 0
+	err = withNudgeRetries(logger, 2, time.Millisecond, func() error { calls++; return errTmux })
+	if calls != 3 || !errors.Is(err, errTmux) || !strings.Contains(err.Error(), "after 3 attempts") {
+		t.Errorf("err = %v after %d calls, want wrapped error after 3 attempts", err, calls)
+	}
+
+	// No retries: the error is returned as is
+	delays = nil
+	if err := withNudgeRetries(logger, 0, time.Millisecond, func() error { return errTmux }); err != errTmux || len(delays) != 0 {
+		t.Errorf("no retries: err = %v, delays = %v", err, delays)
+	}
+}
+
+func TestParseNudgeSchedule(t *testing.T) {
+	now := time.Date(2026, 3, 4, 17, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"2026-03-05T09:00:00-08:00", time.Date(2026, 3, 5, 17, 0, 0, 0, time.UTC)},
+		{"90m", now.Add(90 * time.Minute)},
+		{"+2h", now.Add(2 * time.Hour)},
+		{"1d", now.Add(24 * time.Hour)},
+	}
+	for _, tt := range tests {
+		got, err := parseNudgeSchedule(tt.value, now)
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("parseNudgeSchedule(%q) = %v, %v; want %v", tt.value, got, err, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"tomorrow", "2026-03-04T16:00:00Z", "0s", "-1h"} {
+		if _, err := parseNudgeSchedule(bad, now); err == nil {
+			t.Errorf("parseNudgeSchedule(%q) should fail", bad)
+		}
+	}
+}
+
+func TestNudgeForceShorthand(t *testing.T) {
+	// -f has meant --force since before --file existed; scripts rely on it
+	if f := nudgeCmd.Flags().ShorthandLookup("f"); f == nil || f.Name != "force" {
+		t.Errorf("-f = %v, want --force", f)
+	}
+	if f := nudgeCmd.Flags().Lookup("file"); f == nil || f.Shorthand != "" {
+		t.Errorf("--file = %v, want no shorthand", f)
+	}
+}
@@ -1,9 +1,11 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -19,14 +21,18 @@ import (
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
 	"github.com/steveyegge/gastown/internal/util"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 // Polecat command flags
 var (
-	polecatListJSON  bool
-	polecatListAll   bool
-	polecatForce     bool
-	polecatRemoveAll bool
+	polecatListJSON       bool
+	polecatListAll        bool
+	polecatListState      string
+	polecatListLabel      string
+	polecatListAssignedTo string
+	polecatForce          bool
+	polecatRemoveAll      bool
 )
 
 var polecatCmd = &cobra.Command{
@@ -70,12 +76,22 @@ all polecats with their states:
   - working: Actively working on an issue
   - done: Completed work, waiting for cleanup
   - stuck: Needs assistance
+  - zombie: tmux session with no worktree
+
+The table shows each polecat's branch, worktree path (relative to the town
+root), and the age of its last commit. Use --state to show only polecats
+in one state, --label to show only polecats with a label, and --assigned-to
+to show only polecats a crew member is responsible for.
 
 Examples:
   gt polecat list greenplace
+  gt polecat list greenplace --state working
+  gt polecat list greenplace --label frontend
+  gt polecat list greenplace --assigned-to dave
   gt polecat list --all
   gt polecat list greenplace --json`,
-	RunE: runPolecatList,
+	ValidArgsFunction: completeRigNames,
+	RunE:              runPolecatList,
 }
 
 var polecatAddCmd = &cobra.Command{
@@ -137,6 +153,8 @@ var (
 	polecatStatusJSON        bool
 	polecatGitStateJSON      bool
 	polecatGCDryRun          bool
+	polecatGCOlderThan       string
+	polecatGCForce           bool
 	polecatNukeAll           bool
 	polecatNukeDryRun        bool
 	polecatNukeForce         bool
@@ -156,34 +174,43 @@ This command removes orphaned branches:
   - Branches for polecats that no longer exist
   - Old timestamped branches (keeps only the current one per polecat)
 
+With --older-than, it instead nukes done polecats whose last commit is
+older than the given duration (e.g. 7d, 48h). Polecats that fail the nuke
+safety checks are skipped and don't count toward the limit: nuking more
+than 5 polecats at once requires --force.
+
 Examples:
   gt polecat gc greenplace
-  gt polecat gc greenplace --dry-run`,
-	Args: cobra.ExactArgs(1),
-	RunE: runPolecatGC,
+  gt polecat gc greenplace --dry-run
+  gt polecat gc greenplace --older-than 7d --dry-run`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeRigNames,
+	RunE:              runPolecatGC,
 }
 
 var polecatNukeCmd = &cobra.Command{
-	Use:   "nuke <rig>/<polecat>... | <rig> --all",
+	Use:   "nuke <rig>/<polecat>... | <rig> <polecat> | <rig> --all",
 	Short: "Completely destroy a polecat (session, worktree, branch, agent bead)",
 	Long: `Completely destroy a polecat and all its artifacts.
 
 This is the nuclear option for post-merge cleanup. It:
   1. Kills the Claude session (if running)
   2. Deletes the git worktree (bypassing all safety checks)
-  3. Deletes the polecat branch
-  4. Closes the agent bead (if exists)
+  3. Deletes the polecat branch (local and on origin)
+  4. Closes the agent bead with reason "nuked" (if exists)
 
 SAFETY CHECKS: The command refuses to nuke a polecat if:
   - Worktree has unpushed/uncommitted changes
   - Polecat has an open merge request (MR bead)
   - Polecat has work on its hook
+  - Polecat is working and its session is still running
 
 Use --force to bypass safety checks (LOSES WORK).
 Use --dry-run to see what would happen and safety check status.
 
 Examples:
   gt polecat nuke greenplace/Toast
+  gt polecat nuke greenplace Toast
   gt polecat nuke greenplace/Toast greenplace/Furiosa
   gt polecat nuke greenplace --all
   gt polecat nuke greenplace --all --dry-run
@@ -232,12 +259,20 @@ Examples:
 }
 
 var (
-	polecatStaleJSON      bool
-	polecatStaleThreshold int
-	polecatStaleCleanup   bool
-	polecatStaleDryRun    bool
-	polecatPruneDryRun    bool
-	polecatPruneRemote    bool
+	polecatStaleJSON         bool
+	polecatStaleThreshold    int
+	polecatStaleCleanup      bool
+	polecatStaleDryRun       bool
+	polecatPruneDryRun       bool
+	polecatPruneRemote       bool
+	polecatPruneHistory      bool
+	polecatPruneLimit        int
+	polecatPruneVerbose      bool
+	polecatPruneSkipPRs      bool
+	polecatPruneGHTimeout    time.Duration
+	polecatPruneFetchTimeout time.Duration
+	polecatPruneJSON         bool
+	polecatPruneOlderThan    string
 )
 
 var polecatStaleCmd = &cobra.Command{
@@ -261,8 +296,9 @@ Examples:
   gt polecat stale greenplace --json
   gt polecat stale greenplace --cleanup
   gt polecat stale greenplace --cleanup --dry-run`,
-	Args: cobra.ExactArgs(1),
-	RunE: runPolecatStale,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeRigNames,
+	RunE:              runPolecatStale,
 }
 
 var polecatPruneCmd = &cobra.Command{
@@ -275,24 +311,65 @@ Finds and deletes polecat branches that are no longer needed:
   - Branches whose remote tracking branch was deleted (post-merge cleanup)
   - Branches for polecats that no longer exist (orphaned)
 
-Uses safe deletion (git branch -d) — only removes fully merged branches.
+Uses safe deletion (git branch -d) — only removes fully merged branches
+(except with --older-than, below).
 Also cleans up remote polecat branches that are fully merged.
 
+A local branch is kept, with a warning, if origin/<branch> has commits the
+local branch lacks: something is still pushing to it.
+
 Use --dry-run to preview what would be pruned.
 Use --remote to also prune remote polecat branches on origin.
+Use --verbose to log each git command and keep/prune decision to stderr.
+Use --skip-prs to keep branches that have an open GitHub pull request
+(checked with 'gh pr list --head <branch>'). If gh is missing, fails, or
+exceeds --gh-timeout, a warning is printed and the branch is pruned as usual.
+
+The initial 'git fetch --prune' gives up after --fetch-timeout (default 30s)
+so an unreachable remote can't hang the prune.
+
+Branches matching a glob in <town>/settings/prune-ignore (one pattern per
+line, e.g. polecat/release-*; # starts a comment) are never deleted and are
+listed as "protected". Patterns match like 'git branch --list', so * also
+matches /.
+
+The summary counts pruned branches by what became of their polecat: none
+exists (or it has moved on to a new branch), it is done, or it was nuked.
+Use --json to print the pruned and kept branches and these counts as JSON;
+progress and warnings then go to stderr.
+
+If any branch that should have been pruned fails to delete, it is listed
+under "Keeping" with the error and the command exits non-zero once the
+rest are done.
+
+With --older-than, branches whose last commit is older than the given age
+are pruned too, merged or not, unless their polecat still exists and isn't
+done. This cleans up after polecats whose state was lost. It applies to
+remote branches as well with --remote.
+
+Every deleted branch is recorded in <town>/.runtime/prune-history.jsonl.
+Use --history to show recent deletions instead of pruning.
 
 Examples:
   gt polecat prune greenplace
   gt polecat prune greenplace --dry-run
-  gt polecat prune greenplace --remote`,
-	Args: cobra.ExactArgs(1),
-	RunE: runPolecatPrune,
+  gt polecat prune greenplace --remote
+  gt polecat prune greenplace --remote --skip-prs
+  gt polecat prune greenplace --dry-run --json
+  gt polecat prune greenplace --older-than 14d --dry-run
+  gt polecat prune greenplace --history --limit 50`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeRigNames,
+	RunE:              runPolecatPrune,
 }
 
 func init() {
 	// List flags
 	polecatListCmd.Flags().BoolVar(&polecatListJSON, "json", false, "Output as JSON")
 	polecatListCmd.Flags().BoolVar(&polecatListAll, "all", false, "List polecats in all rigs")
+	polecatListCmd.Flags().StringVar(&polecatListState, "state", "", "Only show polecats in this state (working, done, stuck, zombie, conflict, paused)")
+	polecatListCmd.Flags().StringVar(&polecatListLabel, "label", "", "Only show polecats with this label (see 'gt polecat tag')")
+	polecatListCmd.Flags().StringVar(&polecatListAssignedTo, "assigned-to", "", "Only show polecats assigned to this crew member (see 'gt polecat assign')")
 
 	// Remove flags
 	polecatRemoveCmd.Flags().BoolVarP(&polecatForce, "force", "f", false, "Force removal, bypassing checks")
@@ -306,6 +383,8 @@ func init() {
 
 	// GC flags
 	polecatGCCmd.Flags().BoolVar(&polecatGCDryRun, "dry-run", false, "Show what would be deleted without deleting")
+	polecatGCCmd.Flags().StringVar(&polecatGCOlderThan, "older-than", "", "Nuke done polecats whose last commit is older than this (e.g. 7d, 48h)")
+	polecatGCCmd.Flags().BoolVar(&polecatGCForce, "force", false, "With --older-than, allow nuking more than 5 polecats")
 
 	// Nuke flags
 	polecatNukeCmd.Flags().BoolVar(&polecatNukeAll, "all", false, "Nuke all polecats in the rig")
@@ -324,6 +403,14 @@ func init() {
 	// Prune flags
 	polecatPruneCmd.Flags().BoolVar(&polecatPruneDryRun, "dry-run", false, "Show what would be pruned without doing it")
 	polecatPruneCmd.Flags().BoolVar(&polecatPruneRemote, "remote", false, "Also prune remote polecat branches on origin")
+	polecatPruneCmd.Flags().BoolVar(&polecatPruneHistory, "history", false, "Show recent prune history instead of pruning")
+	polecatPruneCmd.Flags().IntVar(&polecatPruneLimit, "limit", 20, "Number of history entries to show (with --history)")
+	polecatPruneCmd.Flags().BoolVarP(&polecatPruneVerbose, "verbose", "v", false, "Log branch evaluation and git commands to stderr")
+	polecatPruneCmd.Flags().BoolVar(&polecatPruneSkipPRs, "skip-prs", false, "Keep branches that have an open GitHub PR (requires gh)")
+	polecatPruneCmd.Flags().DurationVar(&polecatPruneGHTimeout, "gh-timeout", 10*time.Second, "Timeout for each gh PR lookup (with --skip-prs)")
+	polecatPruneCmd.Flags().DurationVar(&polecatPruneFetchTimeout, "fetch-timeout", git.DefaultFetchTimeout, "Timeout for the initial 'git fetch --prune'")
+	polecatPruneCmd.Flags().BoolVar(&polecatPruneJSON, "json", false, "Output the result as JSON")
+	polecatPruneCmd.Flags().StringVar(&polecatPruneOlderThan, "older-than", "", "Also prune branches of inactive polecats whose last commit is older than this (e.g. 14d, 72h)")
 
 	// Add subcommands
 	polecatCmd.AddCommand(polecatListCmd)
@@ -349,11 +436,79 @@ type PolecatListItem struct {
 	SessionRunning bool          `json:"session_running"`
 	Zombie         bool          `json:"zombie,omitempty"`
 	SessionName    string        `json:"session_name,omitempty"`
+	Branch         string        `json:"branch,omitempty"`
+	ClonePath      string        `json:"clone_path,omitempty"`
+	LastCommit     *time.Time    `json:"last_commit,omitempty"`
+	Labels         []string      `json:"labels,omitempty"`
+	AssignedTo     string        `json:"assigned_to,omitempty"`
+}
+
+// displayState reconciles the stored state with tmux session liveness.
+// Per gt-zecmc design: tmux is ground truth for observable states.
+// If session is running but beads says done, the polecat is still alive.
+// If session is dead but beads says working, the polecat is actually done.
+func (p PolecatListItem) displayState() polecat.State {
+	if p.SessionRunning && p.State == polecat.StateDone {
+		return polecat.StateWorking
+	}
+	if !p.SessionRunning && !p.Zombie && p.State.IsActive() {
+		return polecat.StateDone
+	}
+	return p.State
+}
+
+// filterPolecatsByState keeps the polecats whose displayed state is state.
+func filterPolecatsByState(items []PolecatListItem, state polecat.State) []PolecatListItem {
+	filtered := make([]PolecatListItem, 0, len(items))
+	for _, p := range items {
+		if p.displayState() == state {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered
+}
+
+// filterPolecatsByLabel keeps the polecats that have label.
+func filterPolecatsByLabel(items []PolecatListItem, label string) []PolecatListItem {
+	filtered := make([]PolecatListItem, 0, len(items))
+	for _, p := range items {
+		if hasLabel(p.Labels, label) {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered
+}
+
+// filterPolecatsByAssignee keeps the polecats assigned to crew member.
+func filterPolecatsByAssignee(items []PolecatListItem, member string) []PolecatListItem {
+	filtered := make([]PolecatListItem, 0, len(items))
+	for _, p := range items {
+		if p.AssignedTo == member {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered
+}
+
+// formatCommitAge formats the time since a commit compactly for table output.
+func formatCommitAge(t *time.Time, now time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	d := now.Sub(*t)
+	switch {
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
 }
 
 // getPolecatManager creates a polecat manager for the given rig.
 func getPolecatManager(rigName string) (*polecat.Manager, *rig.Rig, error) {
-	_, r, err := getRig(rigName)
+	_, r, err := getValidRig(rigName)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -366,6 +521,13 @@ func getPolecatManager(rigName string) (*polecat.Manager, *rig.Rig, error) {
 }
 
 func runPolecatList(cmd *cobra.Command, args []string) error {
+	stateFilter := polecat.State(polecatListState)
+	switch stateFilter {
+	case "", polecat.StateWorking, polecat.StateDone, polecat.StateStuck, polecat.StateZombie, polecat.StateConflict, polecat.StatePaused:
+	default:
+		return fmt.Errorf("invalid --state %q: must be working, done, stuck, zombie, conflict, or paused", polecatListState)
+	}
+
 	var rigs []*rig.Rig
 
 	if polecatListAll {
@@ -406,13 +568,21 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 		knownNames := make(map[string]bool)
 		for _, p := range polecats {
 			running, _ := polecatMgr.IsRunning(p.Name)
-			allPolecats = append(allPolecats, PolecatListItem{
+			item := PolecatListItem{
 				Rig:            r.Name,
 				Name:           p.Name,
 				State:          p.State,
 				Issue:          p.Issue,
 				SessionRunning: running,
-			})
+				Branch:         p.Branch,
+				ClonePath:      p.ClonePath,
+				Labels:         p.Labels,
+				AssignedTo:     p.AssignedTo,
+			}
+			if last, err := git.NewGit(p.ClonePath).LastCommitTime("HEAD"); err == nil {
+				item.LastCommit = &last
+			}
+			allPolecats = append(allPolecats, item)
 			knownNames[p.Name] = true
 		}
 
@@ -438,6 +608,16 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if stateFilter != "" {
+		allPolecats = filterPolecatsByState(allPolecats, stateFilter)
+	}
+	if polecatListLabel != "" {
+		allPolecats = filterPolecatsByLabel(allPolecats, polecatListLabel)
+	}
+	if polecatListAssignedTo != "" {
+		allPolecats = filterPolecatsByAssignee(allPolecats, polecatListAssignedTo)
+	}
+
 	// Output
 	if polecatListJSON {
 		enc := json.NewEncoder(os.Stdout)
@@ -451,6 +631,17 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 	}
 
 	fmt.Printf("%s\n\n", style.Bold.Render("Polecats"))
+	table := style.NewTable(
+		style.Column{Name: "", Width: 1},
+		style.Column{Name: "POLECAT", Width: 24},
+		style.Column{Name: "STATE", Width: 8},
+		style.Column{Name: "ISSUE", Width: 12},
+		style.Column{Name: "BRANCH", Width: 32},
+		style.Column{Name: "COMMIT", Width: 6, Align: style.AlignRight},
+		style.Column{Name: "WORKTREE", Width: 40},
+	)
+	now := time.Now()
+	townRoot, _ := workspace.FindFromCwd()
 	for _, p := range allPolecats {
 		// Session indicator
 		sessionStatus := style.Dim.Render("○")
@@ -458,44 +649,41 @@ func runPolecatList(cmd *cobra.Command, args []string) error {
 			sessionStatus = style.Success.Render("●")
 		}
 
-		// Display actual state, reconciled with tmux session liveness.
-		// Per gt-zecmc design: tmux is ground truth for observable states.
-		// If session is running but beads says done, the polecat is still alive.
-		// If session is dead but beads says working, the polecat is actually done.
-		displayState := p.State
-		if p.SessionRunning && displayState == polecat.StateDone {
-			displayState = polecat.StateWorking
-		} else if !p.SessionRunning && !p.Zombie && displayState.IsActive() {
-			displayState = polecat.StateDone
-		}
-
-		// State color
-		stateStr := string(displayState)
-		switch displayState {
-		case polecat.StateWorking:
-			stateStr = style.Info.Render(stateStr)
-		case polecat.StateStuck:
-			stateStr = style.Warning.Render(stateStr)
-		case polecat.StateDone:
-			stateStr = style.Success.Render(stateStr)
-		case polecat.StateZombie:
-			stateStr = style.Error.Render(stateStr)
-		default:
-			stateStr = style.Dim.Render(stateStr)
-		}
-
-		fmt.Printf("  %s %s/%s  %s\n", sessionStatus, p.Rig, p.Name, stateStr)
-		if p.Issue != "" {
-			fmt.Printf("    %s\n", style.Dim.Render(p.Issue))
-		}
+		stateStr := renderPolecatState(p.displayState())
+
+		worktree := p.ClonePath
 		if p.Zombie && p.SessionName != "" {
-			fmt.Printf("    %s\n", style.Dim.Render("session: "+p.SessionName+" (no worktree)"))
+			worktree = style.Dim.Render("session: " + p.SessionName + " (no worktree)")
+		} else if townRoot != "" {
+			if rel, err := filepath.Rel(townRoot, p.ClonePath); err == nil && !strings.HasPrefix(rel, "..") {
+				worktree = rel
+			}
 		}
+
+		table.AddRow(sessionStatus, p.Rig+"/"+p.Name, stateStr, p.Issue, p.Branch,
+			formatCommitAge(p.LastCommit, now), worktree)
 	}
+	fmt.Print(table.Render())
 
 	return nil
 }
 
+// renderPolecatState colors a polecat state for table output.
+func renderPolecatState(state polecat.State) string {
+	switch state {
+	case polecat.StateWorking:
+		return style.Info.Render(string(state))
+	case polecat.StateStuck, polecat.StateConflict:
+		return style.Warning.Render(string(state))
+	case polecat.StateDone:
+		return style.Success.Render(string(state))
+	case polecat.StateZombie:
+		return style.Error.Render(string(state))
+	default:
+		return style.Dim.Render(string(state))
+	}
+}
+
 func runPolecatAdd(cmd *cobra.Command, args []string) error {
 	// Emit deprecation warning
 	fmt.Fprintf(os.Stderr, "%s 'gt polecat add' is deprecated. Use 'gt polecat identity add' instead.\n",
@@ -663,7 +851,7 @@ func runPolecatStatus(cmd *cobra.Command, args []string) error {
 	switch p.State {
 	case polecat.StateWorking:
 		stateStr = style.Info.Render(stateStr)
-	case polecat.StateStuck:
+	case polecat.StateStuck, polecat.StateConflict:
 		stateStr = style.Warning.Render(stateStr)
 	case polecat.StateDone:
 		stateStr = style.Success.Render(stateStr)
@@ -671,6 +859,9 @@ func runPolecatStatus(cmd *cobra.Command, args []string) error {
 		stateStr = style.Dim.Render(stateStr)
 	}
 	fmt.Printf("  State:         %s\n", stateStr)
+	if p.PauseReason != "" {
+		fmt.Printf("  Paused for:    %s\n", p.PauseReason)
+	}
 
 	// Issue
 	if p.Issue != "" {
@@ -1016,6 +1207,10 @@ func runPolecatGC(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if polecatGCOlderThan != "" {
+		return runPolecatGCOlderThan(mgr, r)
+	}
+
 	fmt.Printf("Garbage collecting stale polecat branches in %s...\n\n", r.Name)
 
 	if polecatGCDryRun {
@@ -1086,7 +1281,7 @@ func splitLines(s string) []string {
 }
 
 func runPolecatNuke(cmd *cobra.Command, args []string) error {
-	targets, err := resolvePolecatTargets(args, polecatNukeAll)
+	targets, err := resolvePolecatTargets(splitRigPolecatArgs(args, polecatNukeAll), polecatNukeAll)
 	if err != nil {
 		return err
 	}
@@ -1236,7 +1431,7 @@ func nukePolecatFull(polecatName, rigName string, mgr *polecat.Manager, r *rig.R
 		} else {
 			repoGit = git.NewGit(filepath.Join(r.Path, "mayor", "rig"))
 		}
-		if err := repoGit.DeleteBranch(branchToDelete, true); err != nil {
+		if err := repoGit.DeleteBranch(branchToDelete, git.BranchDeleteOptions{Force: true}); err != nil {
 			fmt.Printf("  %s branch delete: %v\n", style.Dim.Render("○"), err)
 		} else {
 			fmt.Printf("  %s deleted local branch %s\n", style.Success.Render("✓"), branchToDelete)
@@ -1417,91 +1612,262 @@ func runPolecatStale(cmd *cobra.Command, args []string) error {
 func runPolecatPrune(cmd *cobra.Command, args []string) error {
 	rigName := args[0]
 
-	_, r, err := getPolecatManager(rigName)
+	townRoot, r, err := getValidRig(rigName)
 	if err != nil {
 		return err
 	}
 
+	if polecatPruneHistory {
+		return printPruneHistory(townRoot, r.Name, polecatPruneLimit)
+	}
+
+	var ageCutoff time.Time
+	if polecatPruneOlderThan != "" {
+		age, err := parseDuration(polecatPruneOlderThan)
+		if err != nil || age <= 0 {
+			return fmt.Errorf("invalid --older-than %q: want a positive duration such as 14d or 72h", polecatPruneOlderThan)
+		}
+		ageCutoff = time.Now().Add(-age)
+	}
+
 	// Use the mayor/rig clone (or bare repo) for branch operations
 	var repoGit *git.Git
-	bareRepoPath := filepath.Join(r.Path, ".repo.git")
-	if info, statErr := os.Stat(bareRepoPath); statErr == nil && info.IsDir() {
-		repoGit = git.NewGitWithDir(bareRepoPath, "")
+	repoDir := filepath.Join(r.Path, ".repo.git")
+	if info, statErr := os.Stat(repoDir); statErr == nil && info.IsDir() {
+		repoGit = git.NewGitWithDir(repoDir, "")
 	} else {
-		repoGit = git.NewGit(filepath.Join(r.Path, "mayor", "rig"))
+		repoDir = filepath.Join(r.Path, "mayor", "rig")
+		repoGit = git.NewGit(repoDir)
+	}
+	logger := newPruneLogger(polecatPruneVerbose)
+	repoGit.SetLogger(logger)
+
+	// With --json, stdout carries only the result
+	var out io.Writer = os.Stdout
+	if polecatPruneJSON {
+		out = os.Stderr
 	}
+	originOf := rigPruneOrigins(r)
+	result := PruneResult{Rig: r.Name, DryRun: polecatPruneDryRun}
 
-	fmt.Printf("Pruning stale polecat branches in %s...\n", r.Name)
+	fmt.Fprintf(out, "Pruning stale polecat branches in %s...\n", r.Name)
 
 	// First, prune stale remote-tracking refs so we detect deleted remote branches
-	if err := repoGit.FetchPrune("origin"); err != nil {
-		fmt.Printf("  %s fetch --prune: %v (continuing anyway)\n", style.Warning.Render("⚠"), err)
+	fetchCtx, cancel := context.WithTimeout(context.Background(), polecatPruneFetchTimeout)
+	err = repoGit.FetchPrune(fetchCtx, "origin")
+	cancel()
+	if errors.Is(err, context.DeadlineExceeded) {
+		fmt.Fprintf(out, "  %s fetch --prune: timed out after %s (continuing anyway)\n", style.Warning.Render("⚠"), polecatPruneFetchTimeout)
+	} else if err != nil {
+		fmt.Fprintf(out, "  %s fetch --prune: %v (continuing anyway)\n", style.Warning.Render("⚠"), err)
+	}
+
+	var hasOpenPR prChecker
+	if polecatPruneSkipPRs {
+		if _, err := exec.LookPath("gh"); err != nil {
+			fmt.Fprintf(out, "  %s --skip-prs: gh not found in PATH (not skipping PR branches)\n", style.Warning.Render("⚠"))
+		} else {
+			hasOpenPR = ghOpenPRChecker(repoDir, polecatPruneGHTimeout)
+		}
+	}
+
+	protected, err := loadPruneIgnore(townRoot)
+	if err != nil {
+		return err
 	}
 
-	// Prune local branches that are merged or have no remote
-	pruned, err := repoGit.PruneStaleBranches("polecat/*", polecatPruneDryRun)
+	// Prune local branches that are merged or have no remote. Candidates are
+	// collected first so protected, diverged and open-PR branches can be kept.
+	pruned, err := repoGit.PruneStaleBranches("polecat/*", true)
 	if err != nil {
 		return fmt.Errorf("pruning local branches: %w", err)
 	}
+	var localReport pruneReport
+	var activeBranch func(string) bool
+	if !ageCutoff.IsZero() {
+		activeBranch = rigActivePolecatBranch(r)
+		aged, agedProtected, err := agedBranchCandidates(repoGit, "polecat/*", protected, pruned, ageCutoff, activeBranch)
+		if err != nil {
+			return fmt.Errorf("pruning local branches: %w", err)
+		}
+		pruned = append(pruned, aged...)
+		for _, branch := range agedProtected {
+			localReport.keep(branch, "protected", false)
+		}
+	}
+	pruned = pruneFilteredBranches(out, repoGit, pruned, protected, hasOpenPR, polecatPruneDryRun, &localReport)
+	for _, b := range pruned {
+		localReport.prune(b.Name, b.Reason, originOf(b.Name))
+		if !polecatPruneDryRun {
+			recordPrune(out, townRoot, r.Name, b.Name, pruneTypeLocal)
+		}
+	}
+	localReport.write(out)
+	result.Local = localReport.result()
+	failed := localReport.failed
 
+	verb := "Pruned"
+	if polecatPruneDryRun {
+		verb = "Would prune"
+	}
 	if len(pruned) == 0 {
-		fmt.Println("No stale local polecat branches found.")
+		fmt.Fprintln(out, "No stale local polecat branches found.")
 	} else {
-		verb := "Pruned"
-		if polecatPruneDryRun {
-			verb = "Would prune"
-		}
-		for _, b := range pruned {
-			fmt.Printf("  %s %s (%s)\n", style.Success.Render("✓"), b.Name, b.Reason)
-		}
-		fmt.Printf("\n%s %d local branch(es).\n", verb, len(pruned))
+		fmt.Fprintf(out, "\n%s\n", result.Local.line(verb, "local"))
 	}
 
 	// Optionally prune remote polecat branches
 	if polecatPruneRemote {
-		fmt.Println()
-		fmt.Println("Pruning remote polecat branches...")
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, "Pruning remote polecat branches...")
 
 		defaultBranch := repoGit.RemoteDefaultBranch()
-		remoteRefs, lsErr := repoGit.ListRemoteRefs("origin", "refs/heads/polecat/")
+		remoteBranches, lsErr := repoGit.ListRemoteBranches("origin", "polecat/")
 		if lsErr != nil {
-			return fmt.Errorf("listing remote refs: %w", lsErr)
+			return fmt.Errorf("listing remote branches: %w", lsErr)
+		}
+
+		var openPRs map[string]bool
+		if hasOpenPR != nil {
+			var branches []string
+			for _, branch := range remoteBranches {
+				if !pruneProtected(branch, protected) {
+					branches = append(branches, branch)
+				}
+			}
+			openPRs = branchesWithOpenPRs(out, branches, hasOpenPR)
 		}
 
+		var remoteReport pruneReport
 		remotePruned := 0
-		for _, ref := range remoteRefs {
-			branch := strings.TrimPrefix(ref, "refs/heads/")
+		for _, branch := range remoteBranches {
+			if pruneProtected(branch, protected) {
+				logger.Debug("keep remote branch", "branch", branch, "reason", "protected")
+				remoteReport.keep(branch, "protected", false)
+				continue
+			}
+			if openPRs[branch] {
+				logger.Debug("keep remote branch", "branch", branch, "reason", "open PR")
+				remoteReport.keep(branch, "open PR", false)
+				continue
+			}
 			// Check if merged to main
 			merged, mergeErr := repoGit.IsAncestor(branch, "origin/"+defaultBranch)
 			if mergeErr != nil {
+				logger.Debug("keep remote branch", "branch", branch, "reason", "merge status unknown", "err", mergeErr)
 				continue
 			}
+			reason := "merged"
 			if !merged {
-				continue
+				if ageCutoff.IsZero() || !branchOlderThan(repoGit, "origin/"+branch, ageCutoff) || activeBranch(branch) {
+					logger.Debug("keep remote branch", "branch", branch, "reason", "not merged")
+					continue
+				}
+				reason = pruneReasonAge
 			}
+			logger.Debug("prune remote branch", "branch", branch, "reason", reason, "dry_run", polecatPruneDryRun)
 
-			if polecatPruneDryRun {
-				fmt.Printf("  Would delete remote: %s\n", style.Dim.Render(branch))
-			} else {
+			if !polecatPruneDryRun {
 				if delErr := repoGit.DeleteRemoteBranch("origin", branch); delErr != nil {
-					fmt.Printf("  %s remote %s: %v\n", style.Warning.Render("⚠"), branch, delErr)
-				} else {
-					fmt.Printf("  %s deleted remote %s\n", style.Success.Render("✓"), branch)
+					remoteReport.deleteFailed(branch, delErr)
+					continue
 				}
+				recordPrune(out, townRoot, r.Name, branch, pruneTypeRemote)
 			}
+			remoteReport.prune(branch, reason, originOf(branch))
 			remotePruned++
 		}
+		remoteReport.write(out)
+		remoteResult := remoteReport.result()
+		result.Remote = &remoteResult
 
 		if remotePruned == 0 {
-			fmt.Println("No stale remote polecat branches found.")
+			fmt.Fprintln(out, "No stale remote polecat branches found.")
 		} else {
-			verb := "Pruned"
-			if polecatPruneDryRun {
-				verb = "Would prune"
-			}
-			fmt.Printf("\n%s %d remote branch(es).\n", verb, remotePruned)
+			fmt.Fprintf(out, "\n%s\n", remoteResult.line(verb, "remote"))
 		}
+		failed += remoteReport.failed
 	}
 
+	if polecatPruneJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(result); err != nil {
+			return err
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%w: %d branch(es) failed to delete", ErrPruneFailed, failed)
+	}
 	return nil
 }
+
+// remoteOnlyCommits counts the commits on origin/<branch> that the local
+// branch lacks. A polecat may still be pushing to a branch like that, so it
+// is kept whatever the reason it was selected, including --older-than, which
+// force-deletes. Branches with no origin/<branch> have nothing to compare and
+// return 0.
+func remoteOnlyCommits(repoGit *git.Git, branch string) (int, error) {
+	exists, err := repoGit.RemoteTrackingBranchExists("origin", branch)
+	if err != nil || !exists {
+		return 0, err
+	}
+	return repoGit.CommitsAhead(branch, "origin/"+branch)
+}
+
+// pruneFilteredBranches deletes the candidate branches that match no protected
+// pattern, have not diverged from their remote branch and, if hasOpenPR is
+// set, have no open PR. It returns those that were (or, with dryRun, would
+// be) pruned, and records the branches it keeps in report. PR lookup
+// warnings go to w.
+// Candidates come from a dry-run PruneStaleBranches, so nothing is deleted yet.
+func pruneFilteredBranches(w io.Writer, repoGit *git.Git, candidates []git.PrunedBranch, protected []string, hasOpenPR prChecker, dryRun bool, report *pruneReport) []git.PrunedBranch {
+	var unprotected []git.PrunedBranch
+	for _, b := range candidates {
+		if pruneProtected(b.Name, protected) {
+			report.keep(b.Name, "protected", false)
+			continue
+		}
+		if behind, err := remoteOnlyCommits(repoGit, b.Name); err != nil || behind > 0 {
+			reason := fmt.Sprintf("diverged: %d commit(s) on origin/%s not in the local branch", behind, b.Name)
+			if err != nil {
+				reason = fmt.Sprintf("can't compare with origin/%s: %v", b.Name, err)
+			}
+			report.keep(b.Name, reason, true)
+			continue
+		}
+		unprotected = append(unprotected, b)
+	}
+
+	var openPRs map[string]bool
+	if hasOpenPR != nil {
+		names := make([]string, len(unprotected))
+		for i, b := range unprotected {
+			names[i] = b.Name
+		}
+		openPRs = branchesWithOpenPRs(w, names, hasOpenPR)
+	}
+
+	var pruned []git.PrunedBranch
+	for _, b := range unprotected {
+		if openPRs[b.Name] {
+			report.keep(b.Name, "open PR", false)
+			continue
+		}
+		if !dryRun {
+			// Same safe -d deletion PruneStaleBranches would have used, except
+			// that --older-than prunes unmerged branches by design
+			opts := git.BranchDeleteOptions{Force: b.Reason == pruneReasonAge}
+			if err := repoGit.DeleteBranch(b.Name, opts); errors.Is(err, git.ErrBranchNotFullyMerged) {
+				// Expected for unmerged no-remote branches: -d keeps them
+				report.keep(b.Name, "not fully merged", false)
+				continue
+			} else if err != nil {
+				report.deleteFailed(b.Name, err)
+				continue
+			}
+		}
+		pruned = append(pruned, b)
+	}
+	return pruned
+}
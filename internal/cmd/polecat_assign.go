@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/crew"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var polecatAssignCmd = &cobra.Command{
+	Use:   "assign <rig> <name> <crew-member>",
+	Short: "Make a crew member responsible for a polecat",
+	Long: `Record which crew member is responsible for reviewing or guiding a polecat.
+
+The crew member must exist in the rig's crew/ directory. The assignment is
+stored in the polecat's state file (polecats/<name>/state.json) and doesn't
+affect the polecat's work; it replaces any earlier assignment. Filter by it
+with 'gt polecat list --assigned-to'.
+
+Examples:
+  gt polecat assign greenplace Toast dave
+  gt polecat list greenplace --assigned-to dave`,
+	Args: cobra.ExactArgs(3),
+	RunE: runPolecatAssign,
+}
+
+var polecatUnassignCmd = &cobra.Command{
+	Use:   "unassign <rig> <name>",
+	Short: "Clear a polecat's crew member assignment",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runPolecatUnassign,
+}
+
+func init() {
+	polecatCmd.AddCommand(polecatAssignCmd)
+	polecatCmd.AddCommand(polecatUnassignCmd)
+}
+
+func runPolecatAssign(cmd *cobra.Command, args []string) error {
+	rigName, polecatName, member := args[0], args[1], args[2]
+
+	mgr, r, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	crewMgr := crew.NewManager(r, git.NewGit(r.Path))
+	if _, err := crewMgr.Get(member); err != nil {
+		if errors.Is(err, crew.ErrCrewNotFound) {
+			return fmt.Errorf("crew member '%s' not found in rig '%s' (see 'gt crew list --rig %s')", member, rigName, rigName)
+		}
+		return fmt.Errorf("checking crew member '%s': %w", member, err)
+	}
+
+	if err := mgr.SetAssignedTo(polecatName, member); err != nil {
+		if errors.Is(err, polecat.ErrPolecatNotFound) {
+			return fmt.Errorf("polecat '%s' not found in rig '%s'", polecatName, rigName)
+		}
+		return err
+	}
+	fmt.Printf("%s %s/%s assigned to %s\n", style.Success.Render("✓"), rigName, polecatName, member)
+	return nil
+}
+
+func runPolecatUnassign(cmd *cobra.Command, args []string) error {
+	rigName, polecatName := args[0], args[1]
+
+	mgr, _, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	if err := mgr.SetAssignedTo(polecatName, ""); err != nil {
+		if errors.Is(err, polecat.ErrPolecatNotFound) {
+			return fmt.Errorf("polecat '%s' not found in rig '%s'", polecatName, rigName)
+		}
+		return err
+	}
+	fmt.Printf("%s %s/%s unassigned\n", style.Success.Render("✓"), rigName, polecatName)
+	return nil
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Checkpoint command flags
+var polecatCheckpointMessage string
+
+var polecatCheckpointCmd = &cobra.Command{
+	Use:   "checkpoint <rig> <name>",
+	Short: "Commit all work in a polecat's worktree",
+	Long: `Commit all staged and unstaged changes in a polecat's worktree.
+
+Runs 'git add -A' and commits with an auto-generated "checkpoint: <timestamp>"
+message, so long-running work can be saved without choosing a message.
+Use --message to supply your own.
+
+The polecat must be in the working state. If there are no changes,
+prints "nothing to checkpoint" and exits successfully.
+
+Examples:
+  gt polecat checkpoint greenplace Toast
+  gt polecat checkpoint greenplace Toast -m "wip: parser rewrite"`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPolecatCheckpoint,
+}
+
+func init() {
+	polecatCheckpointCmd.Flags().StringVarP(&polecatCheckpointMessage, "message", "m", "", "Commit message (default: checkpoint: <timestamp>)")
+
+	polecatCmd.AddCommand(polecatCheckpointCmd)
+}
+
+func runPolecatCheckpoint(cmd *cobra.Command, args []string) error {
+	rigName, polecatName := args[0], args[1]
+
+	mgr, _, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	p, err := mgr.Get(polecatName)
+	if err != nil {
+		return fmt.Errorf("polecat '%s' not found in rig '%s'", polecatName, rigName)
+	}
+	if p.State != polecat.StateWorking {
+		return fmt.Errorf("polecat %s/%s is %s, not working; refusing to checkpoint", rigName, polecatName, p.State)
+	}
+
+	message := polecatCheckpointMessage
+	if message == "" {
+		message = checkpointMessage(time.Now())
+	}
+
+	committed, err := checkpointWorktree(git.NewGit(p.ClonePath), message)
+	if err != nil {
+		return fmt.Errorf("checkpointing %s/%s: %w", rigName, polecatName, err)
+	}
+	if !committed {
+		fmt.Println("nothing to checkpoint")
+		return nil
+	}
+
+	fmt.Printf("%s Checkpointed %s/%s: %s\n", style.Success.Render("✓"), rigName, polecatName, message)
+	return nil
+}
+
+// checkpointMessage returns the default checkpoint commit message.
+func checkpointMessage(now time.Time) string {
+	return "checkpoint: " + now.UTC().Format(time.RFC3339)
+}
+
+// checkpointWorktree stages everything and commits it. Returns false without
+// committing if the worktree has no changes.
+func checkpointWorktree(g *git.Git, message string) (bool, error) {
+	dirty, err := g.HasUncommittedChanges()
+	if err != nil {
+		return false, err
+	}
+	if !dirty {
+		return false, nil
+	}
+
+	if err := g.Add("-A"); err != nil {
+		return false, err
+	}
+	if err := g.Commit(message); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/git"
+)
+
+func TestCheckpointMessage(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	if got := checkpointMessage(now); got != "checkpoint: 2026-01-02T03:04:05Z" {
+		t.Errorf("checkpointMessage = %q", got)
+	}
+}
+
+func TestCheckpointWorktree(t *testing.T) {
+	for _, kv := range [][2]string{
+		{"GIT_AUTHOR_NAME", "test"}, {"GIT_AUTHOR_EMAIL", "test@test.com"},
+		{"GIT_COMMITTER_NAME", "test"}, {"GIT_COMMITTER_EMAIL", "test@test.com"},
+	} {
+		t.Setenv(kv[0], kv[1])
+	}
+
+	dir := t.TempDir()
+	run(t, dir, "git", "init")
+	writeFile(t, filepath.Join(dir, "README.md"), "# test\n")
+	run(t, dir, "git", "add", ".")
+	run(t, dir, "git", "commit", "-m", "initial commit")
+
+	g := git.NewGit(dir)
+
+	committed, err := checkpointWorktree(g, "checkpoint: clean")
+	if err != nil {
+		t.Fatalf("checkpointWorktree on clean tree: %v", err)
+	}
+	if committed {
+		t.Error("clean worktree should report nothing to checkpoint")
+	}
+
+	// Both a modified tracked file and an untracked file must be committed
+	writeFile(t, filepath.Join(dir, "README.md"), "# changed\n")
+	writeFile(t, filepath.Join(dir, "new.go"), "package x\n")
+
+	committed, err = checkpointWorktree(g, "checkpoint: wip")
+	if err != nil {
+		t.Fatalf("checkpointWorktree: %v", err)
+	}
+	if !committed {
+		t.Fatal("expected a checkpoint commit")
+	}
+
+	if dirty, _ := g.HasUncommittedChanges(); dirty {
+		t.Error("worktree still dirty after checkpoint")
+	}
+	out, err := exec.Command("git", "-C", dir, "log", "-1", "--format=%s").Output()
+	if err != nil {
+		t.Fatalf("git log: %v", err)
+	}
+	if msg := strings.TrimSpace(string(out)); msg != "checkpoint: wip" {
+		t.Errorf("HEAD message = %q", msg)
+	}
+}
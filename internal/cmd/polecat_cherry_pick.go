@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Cherry-pick command flags
+var (
+	polecatCherryPickAll  bool
+	polecatCherryPickLast int
+)
+
+var polecatCherryPickCmd = &cobra.Command{
+	Use:   "cherry-pick <rig> <name> <source-rig> <source-polecat>",
+	Short: "Cherry-pick commits from another polecat's branch",
+	Long: `Apply commits from one polecat's branch onto another polecat's worktree.
+
+The source commits are those on the source polecat's branch that are not on
+origin/<default-branch> of the source rig (as in 'gt polecat log'). Use
+--all to cherry-pick all of them, or --last N for the newest N; they are
+applied oldest first. Without either flag, the candidate commits are listed
+and nothing is applied.
+
+The target polecat must be working and its worktree clean. Commits from a
+polecat in another rig are fetched into the target's repository first.
+
+If a commit conflicts, the cherry-pick is left in progress in the target
+worktree: resolve the files and run 'git cherry-pick --continue' there, or
+'git cherry-pick --abort' to undo it.
+
+Examples:
+  gt polecat cherry-pick greenplace Toast greenplace Nux
+  gt polecat cherry-pick greenplace Toast greenplace Nux --last 1
+  gt polecat cherry-pick greenplace Toast otherrig Slit --all`,
+	Args: cobra.ExactArgs(4),
+	RunE: runPolecatCherryPick,
+}
+
+func init() {
+	polecatCherryPickCmd.Flags().BoolVar(&polecatCherryPickAll, "all", false, "Cherry-pick every commit on the source branch")
+	polecatCherryPickCmd.Flags().IntVar(&polecatCherryPickLast, "last", 0, "Cherry-pick the newest N commits on the source branch")
+
+	polecatCmd.AddCommand(polecatCherryPickCmd)
+}
+
+func runPolecatCherryPick(cmd *cobra.Command, args []string) error {
+	rigName, polecatName, srcRigName, srcPolecatName := args[0], args[1], args[2], args[3]
+
+	if polecatCherryPickAll && polecatCherryPickLast != 0 {
+		return fmt.Errorf("--all and --last are mutually exclusive")
+	}
+	if polecatCherryPickLast < 0 {
+		return fmt.Errorf("--last must be positive, got %d", polecatCherryPickLast)
+	}
+	if rigName == srcRigName && polecatName == srcPolecatName {
+		return fmt.Errorf("source and target are the same polecat")
+	}
+
+	srcMgr, srcRig, err := getPolecatManager(srcRigName)
+	if err != nil {
+		return err
+	}
+	src, err := srcMgr.Get(srcPolecatName)
+	if err != nil {
+		return fmt.Errorf("polecat '%s' not found in rig '%s'", srcPolecatName, srcRigName)
+	}
+
+	base := polecatBaseRef(srcRig, "")
+	entries, err := git.NewGit(src.ClonePath).Log(base+".."+src.Branch, polecatCherryPickLast)
+	if err != nil {
+		return fmt.Errorf("reading log of %s: %w", src.Branch, err)
+	}
+	if len(entries) == 0 {
+		fmt.Printf("%s No commits on %s beyond %s\n", style.Dim.Render("○"), src.Branch, base)
+		return nil
+	}
+
+	if !polecatCherryPickAll && polecatCherryPickLast == 0 {
+		fmt.Printf("Commits on %s/%s (%s), newest first:\n", srcRigName, srcPolecatName, src.Branch)
+		for _, e := range entries {
+			fmt.Printf("  %s\n", formatPolecatLogEntry(e, true))
+		}
+		fmt.Printf("\n%s\n", style.Dim.Render("Use --all or --last <n> to cherry-pick them."))
+		return nil
+	}
+
+	target, err := getWorkingPolecat(rigName, polecatName, "cherry-pick")
+	if err != nil {
+		return err
+	}
+	targetGit := git.NewGit(target.ClonePath)
+	if dirty, err := targetGit.HasUncommittedChanges(); err != nil {
+		return fmt.Errorf("checking %s/%s worktree: %w", rigName, polecatName, err)
+	} else if dirty {
+		return fmt.Errorf("%s/%s has uncommitted changes; commit or stash them first ('gt polecat stash')", rigName, polecatName)
+	}
+
+	// Polecats in one rig share a repository; another rig's commits have to
+	// be fetched first
+	if srcRigName != rigName {
+		if err := targetGit.FetchBranch(src.ClonePath, src.Branch); err != nil {
+			return fmt.Errorf("fetching %s from %s/%s: %w", src.Branch, srcRigName, srcPolecatName, err)
+		}
+	}
+
+	commits := cherryPickOrder(entries)
+	fmt.Printf("Cherry-picking %d commit(s) from %s/%s onto %s/%s...\n",
+		len(commits), srcRigName, srcPolecatName, rigName, polecatName)
+	if err := targetGit.CherryPick(commits...); err != nil {
+		var conflict *git.CherryPickConflictError
+		if errors.As(err, &conflict) {
+			return fmt.Errorf("%w\n  resolve in %s, then run 'git cherry-pick --continue' (or 'git cherry-pick --abort')",
+				conflict, target.ClonePath)
+		}
+		return fmt.Errorf("cherry-picking onto %s/%s: %w", rigName, polecatName, err)
+	}
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		fmt.Printf("  %s %s\n", style.Success.Render("✓"), formatPolecatLogEntry(entries[i], true))
+	}
+	fmt.Printf("%s Applied %d commit(s) to %s\n", style.Success.Render("✓"), len(commits), target.Branch)
+	return nil
+}
+
+// cherryPickOrder returns the hashes of Log entries (newest first) in the
+// order they must be applied: oldest first.
+func cherryPickOrder(entries []git.LogEntry) []string {
+	commits := make([]string, len(entries))
+	for i, e := range entries {
+		commits[len(entries)-1-i] = e.Hash
+	}
+	return commits
+}
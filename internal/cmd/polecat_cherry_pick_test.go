@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/git"
+)
+
+func TestCherryPickOrder(t *testing.T) {
+	entries := []git.LogEntry{{Hash: "ccc"}, {Hash: "bbb"}, {Hash: "aaa"}}
+	if got, want := cherryPickOrder(entries), []string{"aaa", "bbb", "ccc"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("cherryPickOrder = %v, want %v", got, want)
+	}
+	if got := cherryPickOrder(nil); len(got) != 0 {
+		t.Errorf("cherryPickOrder(nil) = %v, want empty", got)
+	}
+}
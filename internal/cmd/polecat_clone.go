@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var polecatCloneCmd = &cobra.Command{
+	Use:   "clone <source-rig>/<name> <target-rig>/<new-name>",
+	Short: "Copy a polecat's work into a new polecat, e.g. in another rig",
+	Long: `Create a new polecat whose branch carries another polecat's work.
+
+Usually the target is another rig with a copy of the same repository. The
+new polecat is created from the target rig's base (origin/<default-branch>),
+then every commit on the source polecat's branch that isn't on the source
+rig's origin/<default-branch> (as in 'gt polecat log') is cherry-picked
+onto it, oldest first. The new polecat is left working.
+
+If both rigs use the same git URL, the source branch is fetched from
+origin, so work that was already pushed isn't copied between the rigs'
+repositories. Otherwise, or if origin doesn't have every commit, it is
+fetched straight from the source polecat's worktree.
+
+If a commit conflicts, the cherry-pick is left in progress in the new
+polecat's worktree, with instructions for finishing or discarding it.
+
+Examples:
+  gt polecat clone greenplace/Toast otherrig/Toast
+  gt polecat clone greenplace/Toast greenplace/Nux`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPolecatClone,
+}
+
+func init() {
+	polecatCmd.AddCommand(polecatCloneCmd)
+}
+
+func runPolecatClone(cmd *cobra.Command, args []string) error {
+	srcRigName, srcName, err := parseAddress(args[0])
+	if err != nil {
+		return err
+	}
+	rigName, name, err := parseAddress(args[1])
+	if err != nil {
+		return err
+	}
+	if srcRigName == rigName && srcName == name {
+		return fmt.Errorf("source and target are the same polecat")
+	}
+
+	srcMgr, srcRig, err := getPolecatManager(srcRigName)
+	if err != nil {
+		return err
+	}
+	src, err := srcMgr.Get(srcName)
+	if err != nil {
+		return fmt.Errorf("polecat '%s' not found in rig '%s'", srcName, srcRigName)
+	}
+	base := polecatBaseRef(srcRig, "")
+	entries, err := git.NewGit(src.ClonePath).Log(base+".."+src.Branch, 0)
+	if err != nil {
+		return fmt.Errorf("reading log of %s: %w", src.Branch, err)
+	}
+	if len(entries) == 0 {
+		return fmt.Errorf("%s/%s has no commits on %s beyond %s to clone", srcRigName, srcName, src.Branch, base)
+	}
+
+	mgr, r, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Creating polecat %s/%s...\n", rigName, name)
+	p, err := mgr.AddWithOptions(name, polecat.AddOptions{})
+	if err != nil {
+		return fmt.Errorf("adding polecat: %w", err)
+	}
+
+	g := git.NewGit(p.ClonePath)
+	commits := cherryPickOrder(entries)
+	if srcRigName != rigName {
+		if err := fetchPolecatBranch(g, srcRig, r, src, commits[len(commits)-1]); err != nil {
+			fmt.Printf("%s Fetching %s from %s/%s: %v\n", style.Error.Render("✗"), src.Branch, srcRigName, srcName, err)
+			fmt.Printf("To discard the new polecat: gt polecat remove %s/%s --force\n", rigName, name)
+			return NewSilentExit(1)
+		}
+	}
+
+	fmt.Printf("Cherry-picking %d commit(s) from %s/%s...\n", len(commits), srcRigName, srcName)
+	if err := g.CherryPick(commits...); err != nil {
+		var conflict *git.CherryPickConflictError
+		if errors.As(err, &conflict) {
+			fmt.Printf("%s %v\n", style.Warning.Render("⚠"), conflict)
+			fmt.Printf("\nThe polecat's worktree is at %s\n", p.ClonePath)
+			fmt.Println("Resolve the conflicts, then run 'git cherry-pick --continue' there (or 'git cherry-pick --abort').")
+		} else {
+			fmt.Printf("%s Cherry-picking onto %s/%s: %v\n", style.Error.Render("✗"), rigName, name, err)
+		}
+		fmt.Printf("To discard the new polecat: gt polecat remove %s/%s --force\n", rigName, name)
+		return NewSilentExit(1)
+	}
+
+	if err := mgr.SetState(name, polecat.StateWorking); err != nil {
+		fmt.Printf("%s Could not set state: %v\n", style.Warning.Render("⚠"), err)
+	}
+
+	fmt.Printf("%s Cloned %s/%s into %s/%s (%d commit(s))\n",
+		style.Success.Render("✓"), srcRigName, srcName, rigName, name, len(commits))
+	fmt.Printf("  %s\n", style.Dim.Render(p.ClonePath))
+	fmt.Printf("  Branch: %s\n", style.Dim.Render(p.Branch))
+	return nil
+}
+
+// fetchPolecatBranch fetches src's branch from another rig into g, so the
+// commits up to tip can be cherry-picked. When both rigs use the same git
+// URL the branch is fetched from origin; if that fails or origin doesn't
+// have tip (unpushed work), it is fetched from src's worktree.
+func fetchPolecatBranch(g *git.Git, srcRig, r *rig.Rig, src *polecat.Polecat, tip string) error {
+	if sameGitURL(srcRig.GitURL, r.GitURL) {
+		if err := g.FetchBranch("origin", src.Branch); err == nil {
+			if _, err := g.Rev(tip + "^{commit}"); err == nil {
+				return nil
+			}
+		}
+	}
+	return g.FetchBranch(src.ClonePath, src.Branch)
+}
+
+// sameGitURL reports whether two rig git URLs name the same repository,
+// ignoring a trailing slash or .git suffix.
+func sameGitURL(a, b string) bool {
+	normalize := func(u string) string {
+		return strings.TrimSuffix(strings.TrimSuffix(strings.TrimSpace(u), "/"), ".git")
+	}
+	a, b = normalize(a), normalize(b)
+	return a != "" && a == b
+}
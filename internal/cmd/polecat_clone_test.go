@@ -0,0 +1,21 @@
+package cmd
+
+import "testing"
+
+func TestSameGitURL(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"https://github.com/org/repo.git", "https://github.com/org/repo", true},
+		{"git@github.com:org/repo.git", "git@github.com:org/repo.git", true},
+		{"https://github.com/org/repo/", "https://github.com/org/repo", true},
+		{"https://github.com/org/repo", "https://github.com/org/other", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		if got := sameGitURL(tt.a, tt.b); got != tt.want {
+			t.Errorf("sameGitURL(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
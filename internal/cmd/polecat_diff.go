@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/ui"
+)
+
+// Diff command flags
+var (
+	polecatDiffStat bool
+	polecatDiffBase string
+)
+
+var polecatDiffCmd = &cobra.Command{
+	Use:   "diff <rig> <name>",
+	Short: "Show a polecat's changes against the base branch",
+	Long: `Show the diff between the rig's base branch and a polecat's branch.
+
+Runs 'git diff <base>..<polecat-branch>' in the polecat's worktree and pages
+the output through $GT_PAGER, $PAGER, or less. The base defaults to
+origin/<default-branch> for the rig; use --base to compare against another
+branch (e.g. an integration branch).
+
+Examples:
+  gt polecat diff greenplace Toast
+  gt polecat diff greenplace Toast --stat
+  gt polecat diff greenplace Toast --base origin/integration/gt-epic`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPolecatDiff,
+}
+
+func init() {
+	polecatDiffCmd.Flags().BoolVar(&polecatDiffStat, "stat", false, "Show only the diffstat")
+	polecatDiffCmd.Flags().StringVar(&polecatDiffBase, "base", "", "Base branch to diff against (default: origin/<rig default branch>)")
+
+	polecatCmd.AddCommand(polecatDiffCmd)
+}
+
+func runPolecatDiff(cmd *cobra.Command, args []string) error {
+	rigName, polecatName := args[0], args[1]
+
+	mgr, r, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	p, err := mgr.Get(polecatName)
+	if err != nil {
+		return fmt.Errorf("polecat '%s' not found in rig '%s'", polecatName, rigName)
+	}
+
+	base := polecatBaseRef(r, polecatDiffBase)
+
+	diff, err := git.NewGit(p.ClonePath).Diff(base, p.Branch, polecatDiffStat)
+	if err != nil {
+		return fmt.Errorf("diffing %s against %s: %w", p.Branch, base, err)
+	}
+	if diff == "" {
+		fmt.Printf("No differences between %s and %s\n", base, p.Branch)
+		return nil
+	}
+
+	return ui.ToPager(diff+"\n", ui.PagerOptions{})
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// Done command flags
+var (
+	polecatDoneNudge bool
+	polecatDoneForce bool
+)
+
+// polecatDoneNudgeMessage is sent to the polecat with --nudge.
+const polecatDoneNudgeMessage = "please commit your final changes"
+
+// polecatDoneIdleTimeout is how long --nudge waits for the polecat to finish
+// responding before checking its worktree.
+const polecatDoneIdleTimeout = 2 * time.Minute
+
+var polecatDoneCmd = &cobra.Command{
+	Use:   "done <rig> <name>",
+	Short: "Mark a working polecat as done",
+	Long: `Mark a working polecat as done, as if it had run 'gt done' itself.
+
+The polecat must be working. Its session is stopped and its hook and issue
+assignment are cleared, so it shows as "done" and is ready for the merge
+queue and cleanup. The summary shows the polecat's branch and last commit.
+
+Polecats with uncommitted changes are refused unless --force is given. Use
+--nudge to first ask the polecat to commit its final changes: the nudge is
+sent and the command waits (up to 2 minutes) for the polecat to go idle
+before checking its worktree.
+
+Examples:
+  gt polecat done greenplace Toast
+  gt polecat done greenplace Toast --nudge
+  gt polecat done greenplace Toast --force`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPolecatDone,
+}
+
+func init() {
+	polecatDoneCmd.Flags().BoolVar(&polecatDoneNudge, "nudge", false, "Ask the polecat to commit its final changes first")
+	polecatDoneCmd.Flags().BoolVarP(&polecatDoneForce, "force", "f", false, "Mark done even with uncommitted changes")
+
+	polecatCmd.AddCommand(polecatDoneCmd)
+}
+
+func runPolecatDone(cmd *cobra.Command, args []string) error {
+	rigName, polecatName := args[0], args[1]
+
+	mgr, r, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+	p, err := getWorkingPolecat(rigName, polecatName, "mark it done")
+	if err != nil {
+		return err
+	}
+
+	t := tmux.NewTmux()
+	sessMgr := polecat.NewSessionManager(t, r)
+	sessionName := sessMgr.SessionName(polecatName)
+
+	if polecatDoneNudge {
+		running, _ := t.HasSession(sessionName)
+		if !running {
+			style.PrintWarning("no session for %s/%s, skipping nudge", rigName, polecatName)
+		} else {
+			if err := t.NudgeSession(sessionName, polecatDoneNudgeMessage); err != nil {
+				return fmt.Errorf("nudging %s: %w", sessionName, err)
+			}
+			fmt.Printf("Nudged %s/%s, waiting for it to go idle...\n", rigName, polecatName)
+			if err := t.WaitForIdle(sessionName, polecatDoneIdleTimeout); err != nil {
+				style.PrintWarning("polecat did not go idle: %v", err)
+			}
+		}
+	}
+
+	g := git.NewGit(p.ClonePath)
+	if dirty, err := g.HasUncommittedChanges(); err != nil {
+		return fmt.Errorf("checking worktree: %w", err)
+	} else if dirty && !polecatDoneForce {
+		return fmt.Errorf("polecat %s/%s has uncommitted changes (use --nudge to ask it to commit, or --force)", rigName, polecatName)
+	}
+
+	if err := sessMgr.Stop(polecatName, false); err != nil && !errors.Is(err, polecat.ErrSessionNotFound) {
+		return fmt.Errorf("stopping session: %w", err)
+	}
+	if err := mgr.SetState(polecatName, polecat.StateDone); err != nil {
+		return fmt.Errorf("marking done: %w", err)
+	}
+
+	fmt.Printf("%s Marked %s/%s done\n", style.Success.Render("✓"), rigName, polecatName)
+	fmt.Printf("  Branch:      %s\n", p.Branch)
+	if sha, err := g.Rev("HEAD"); err == nil {
+		subject, _ := g.GetBranchCommitMessage("HEAD")
+		subject, _, _ = strings.Cut(subject, "\n")
+		if len(sha) > 8 {
+			sha = sha[:8]
+		}
+		fmt.Printf("  Last commit: %s %s\n", sha, subject)
+	}
+	if p.Issue != "" {
+		fmt.Printf("  Issue:       %s\n", p.Issue)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+// Export command flags
+var (
+	polecatExportStdout bool
+	polecatExportBundle bool
+	polecatExportBase   string
+)
+
+var polecatExportCmd = &cobra.Command{
+	Use:   "export <rig> <name>",
+	Short: "Export a polecat's commits as patches or a bundle",
+	Long: `Export the commits on a polecat's branch for sharing without git access.
+
+By default runs 'git format-patch <base>..<polecat-branch>' and writes one
+patch per commit to <town>/.runtime/exports/<rig>-<name>-<timestamp>/.
+Apply them elsewhere with 'git am'.
+
+The base defaults to origin/<default-branch> for the rig; use --base to
+export against another branch.
+
+Options:
+  --stdout   Write the combined patch stream to stdout instead of files
+  --bundle   Write a single git bundle (fetchable with 'git fetch <file>')
+
+Examples:
+  gt polecat export greenplace Toast
+  gt polecat export greenplace Toast --stdout > toast.patch
+  gt polecat export greenplace Toast --bundle`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPolecatExport,
+}
+
+func init() {
+	polecatExportCmd.Flags().BoolVar(&polecatExportStdout, "stdout", false, "Write the combined patch to stdout")
+	polecatExportCmd.Flags().BoolVar(&polecatExportBundle, "bundle", false, "Create a git bundle instead of patch files")
+	polecatExportCmd.Flags().StringVar(&polecatExportBase, "base", "", "Base branch to export from (default: origin/<rig default branch>)")
+
+	polecatCmd.AddCommand(polecatExportCmd)
+}
+
+func runPolecatExport(cmd *cobra.Command, args []string) error {
+	rigName, polecatName := args[0], args[1]
+
+	if polecatExportStdout && polecatExportBundle {
+		return fmt.Errorf("--stdout and --bundle cannot be used together")
+	}
+
+	mgr, r, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	p, err := mgr.Get(polecatName)
+	if err != nil {
+		return fmt.Errorf("polecat '%s' not found in rig '%s'", polecatName, rigName)
+	}
+
+	g := git.NewGit(p.ClonePath)
+	base := polecatBaseRef(r, polecatExportBase)
+
+	if polecatExportStdout {
+		patch, err := g.FormatPatchStdout(base, p.Branch)
+		if err != nil {
+			return fmt.Errorf("exporting %s: %w", p.Branch, err)
+		}
+		if patch == "" {
+			return fmt.Errorf("no commits on %s since %s", p.Branch, base)
+		}
+		fmt.Println(patch)
+		return nil
+	}
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	outDir := polecatExportDir(townRoot, rigName, polecatName, time.Now())
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return fmt.Errorf("creating export directory: %w", err)
+	}
+
+	if polecatExportBundle {
+		bundlePath := filepath.Join(outDir, polecatName+".bundle")
+		if err := g.BundleCreate(bundlePath, base, p.Branch); err != nil {
+			_ = os.RemoveAll(outDir)
+			return fmt.Errorf("bundling %s: %w", p.Branch, err)
+		}
+		fmt.Printf("%s Exported %s/%s to %s\n", style.Success.Render("✓"), rigName, polecatName, bundlePath)
+		return nil
+	}
+
+	patches, err := g.FormatPatch(base, p.Branch, outDir)
+	if err != nil {
+		_ = os.RemoveAll(outDir)
+		return fmt.Errorf("exporting %s: %w", p.Branch, err)
+	}
+	if len(patches) == 0 {
+		_ = os.RemoveAll(outDir)
+		return fmt.Errorf("no commits on %s since %s", p.Branch, base)
+	}
+	fmt.Printf("%s Exported %d patch(es) from %s/%s to %s\n",
+		style.Success.Render("✓"), len(patches), rigName, polecatName, outDir)
+	return nil
+}
+
+// polecatExportDir returns the directory for one export of a polecat's work.
+// Path: <townRoot>/.runtime/exports/<rig>-<name>-<timestamp>/
+func polecatExportDir(townRoot, rigName, polecatName string, now time.Time) string {
+	name := fmt.Sprintf("%s-%s-%s", rigName, polecatName, now.UTC().Format("20060102-150405"))
+	return filepath.Join(townRoot, constants.DirRuntime, "exports", name)
+}
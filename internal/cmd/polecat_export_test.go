@@ -0,0 +1,16 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestPolecatExportDir(t *testing.T) {
+	now := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	got := polecatExportDir("/town", "gastown", "toast", now)
+	want := filepath.Join("/town", ".runtime", "exports", "gastown-toast-20260304-050607")
+	if got != want {
+		t.Errorf("polecatExportDir = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// gcNukeLimit is how many polecats gt polecat gc --older-than nukes in one
+// run without --force.
+const gcNukeLimit = 5
+
+// runPolecatGCOlderThan nukes the rig's done polecats whose last commit is
+// older than --older-than.
+func runPolecatGCOlderThan(mgr *polecat.Manager, r *rig.Rig) error {
+	age, err := parseDuration(polecatGCOlderThan)
+	if err != nil {
+		return fmt.Errorf("invalid --older-than: %w", err)
+	}
+	polecats, err := mgr.List()
+	if err != nil {
+		return fmt.Errorf("listing polecats: %w", err)
+	}
+
+	lastCommits := gcLastCommits(polecats)
+	stale := gcStalePolecats(polecats, lastCommits, time.Now().Add(-age))
+	if len(stale) == 0 {
+		fmt.Printf("No done polecats in %s with no commits in the last %s.\n", r.Name, polecatGCOlderThan)
+		return nil
+	}
+	// Safety checks run first so the nuke limit counts only polecats that
+	// would actually be nuked, not ones that will be skipped anyway.
+	labels := make(map[string]string, len(stale))
+	var toNuke []*polecat.Polecat
+	var skipped int
+	for _, p := range stale {
+		labels[p.Name] = fmt.Sprintf("%s/%s (last commit %s)", r.Name, p.Name, formatAge(lastCommits[p.Name]))
+		safety := checkPolecatSafety(polecatTarget{rigName: r.Name, polecatName: p.Name, mgr: mgr, r: r})
+		if safety.Blocked {
+			fmt.Printf("  %s Skipping %s: %s\n", style.Warning.Render("⚠"), labels[p.Name], strings.Join(safety.Reasons, "; "))
+			skipped++
+			continue
+		}
+		toNuke = append(toNuke, p)
+	}
+	if len(toNuke) > gcNukeLimit && !polecatGCForce && !polecatGCDryRun {
+		names := make([]string, len(toNuke))
+		for i, p := range toNuke {
+			names[i] = p.Name
+		}
+		return fmt.Errorf("%d polecats would be nuked (%s); use --force to nuke more than %d at once",
+			len(toNuke), strings.Join(names, ", "), gcNukeLimit)
+	}
+
+	var nuked int
+	var failures []string
+	for _, p := range toNuke {
+		label := labels[p.Name]
+		if polecatGCDryRun {
+			fmt.Printf("  %s Would nuke %s\n", style.Dim.Render("○"), label)
+			nuked++
+			continue
+		}
+		fmt.Printf("Nuking %s...\n", label)
+		if err := nukePolecatFull(p.Name, r.Name, mgr, r); err != nil {
+			fmt.Printf("  %s %v\n", style.Error.Render("✗"), err)
+			failures = append(failures, fmt.Sprintf("%s: %v", p.Name, err))
+			continue
+		}
+		nuked++
+	}
+
+	fmt.Println()
+	if polecatGCDryRun {
+		fmt.Printf("Would nuke %d polecat(s), skip %d\n", nuked, skipped)
+		return nil
+	}
+	fmt.Printf("%s Nuked %d polecat(s), skipped %d, failed %d\n", style.SuccessPrefix, nuked, skipped, len(failures))
+	if nuked > 0 {
+		cleanupOrphanedProcesses()
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("%d polecat(s) failed to nuke: %s", len(failures), strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// gcLastCommits returns the time of the last commit in each done polecat's
+// worktree, keyed by polecat name. Polecats whose worktree can't be read
+// are left out.
+func gcLastCommits(polecats []*polecat.Polecat) map[string]time.Time {
+	times := make(map[string]time.Time)
+	for _, p := range polecats {
+		if p.State != polecat.StateDone {
+			continue
+		}
+		if t, err := git.NewGit(p.ClonePath).LastCommitTime("HEAD"); err == nil {
+			times[p.Name] = t
+		}
+	}
+	return times
+}
+
+// gcStalePolecats returns the done polecats whose last commit is before
+// cutoff. Polecats with no known last commit are never stale.
+func gcStalePolecats(polecats []*polecat.Polecat, lastCommits map[string]time.Time, cutoff time.Time) []*polecat.Polecat {
+	var stale []*polecat.Polecat
+	for _, p := range polecats {
+		last, ok := lastCommits[p.Name]
+		if p.State == polecat.StateDone && ok && last.Before(cutoff) {
+			stale = append(stale, p)
+		}
+	}
+	return stale
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestGCStalePolecats(t *testing.T) {
+	now := time.Now()
+	polecats := []*polecat.Polecat{
+		{Name: "old", State: polecat.StateDone},
+		{Name: "recent", State: polecat.StateDone},
+		{Name: "working", State: polecat.StateWorking},
+		{Name: "unknown", State: polecat.StateDone},
+	}
+	lastCommits := map[string]time.Time{
+		"old":     now.Add(-10 * 24 * time.Hour),
+		"recent":  now.Add(-time.Hour),
+		"working": now.Add(-10 * 24 * time.Hour),
+	}
+
+	stale := gcStalePolecats(polecats, lastCommits, now.Add(-7*24*time.Hour))
+	if len(stale) != 1 || stale[0].Name != "old" {
+		names := make([]string, len(stale))
+		for i, p := range stale {
+			names[i] = p.Name
+		}
+		t.Errorf("gcStalePolecats = %v, want [old]", names)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
 )
 
 // polecatTarget represents a polecat to operate on.
@@ -19,6 +20,24 @@ type polecatTarget struct {
 	r           *rig.Rig
 }
 
+// polecatBaseRef returns the ref a polecat's work is compared against:
+// override if set, otherwise origin/<rig default branch>.
+func polecatBaseRef(r *rig.Rig, override string) string {
+	if override != "" {
+		return override
+	}
+	return "origin/" + r.DefaultBranch()
+}
+
+// splitRigPolecatArgs accepts "<rig> <polecat>" as shorthand for a single
+// "<rig>/<polecat>" address. Any other argument list is returned unchanged.
+func splitRigPolecatArgs(args []string, useAll bool) []string {
+	if useAll || len(args) != 2 || strings.Contains(args[0], "/") || strings.Contains(args[1], "/") {
+		return args
+	}
+	return []string{args[0] + "/" + args[1]}
+}
+
 // resolvePolecatTargets builds a list of polecats from command args.
 // If useAll is true, the first arg is treated as a rig name and all polecats in it are returned.
 // Otherwise, args are parsed as rig/polecat addresses.
@@ -164,6 +183,14 @@ func checkPolecatSafety(target polecatTarget) *SafetyCheckResult {
 		}
 	}
 
+	// Check 4: A working polecat with a live session is mid-task
+	if infoErr == nil && polecatInfo != nil && polecatInfo.State == polecat.StateWorking {
+		sessMgr := polecat.NewSessionManager(tmux.NewTmux(), target.r)
+		if running, _ := sessMgr.IsRunning(target.polecatName); running {
+			result.Reasons = append(result.Reasons, "is working (session running)")
+		}
+	}
+
 	// Check 2: Open MR beads for this branch
 	if infoErr == nil && polecatInfo != nil && polecatInfo.Branch != "" {
 		mr, mrErr := bd.FindMRForBranch(polecatInfo.Branch)
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitRigPolecatArgs(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		useAll bool
+		want   []string
+	}{
+		{"rig and name", []string{"gastown", "Toast"}, false, []string{"gastown/Toast"}},
+		{"single address", []string{"gastown/Toast"}, false, []string{"gastown/Toast"}},
+		{"two addresses", []string{"gastown/Toast", "gastown/Furiosa"}, false, []string{"gastown/Toast", "gastown/Furiosa"}},
+		{"mixed", []string{"gastown", "gastown/Toast"}, false, []string{"gastown", "gastown/Toast"}},
+		{"with --all", []string{"gastown", "Toast"}, true, []string{"gastown", "Toast"}},
+		{"three args", []string{"gastown", "Toast", "Furiosa"}, false, []string{"gastown", "Toast", "Furiosa"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitRigPolecatArgs(tt.args, tt.useAll); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitRigPolecatArgs(%v, %v) = %v, want %v", tt.args, tt.useAll, got, tt.want)
+			}
+		})
+	}
+}
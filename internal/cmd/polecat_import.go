@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Import command flags
+var (
+	polecatImportName string
+	polecatImportBase string
+)
+
+// Kinds of input gt polecat import accepts.
+const (
+	polecatImportMbox   = "mbox"   // git format-patch output, applied with git am
+	polecatImportDiff   = "diff"   // plain diff, applied with git apply
+	polecatImportBundle = "bundle" // git bundle, unbundled onto the branch
+)
+
+var polecatImportCmd = &cobra.Command{
+	Use:   "import <rig> <patch-file>",
+	Short: "Create a polecat from exported patches or a bundle",
+	Long: `Create a new polecat whose branch starts with imported work.
+
+The reverse of 'gt polecat export'. A new polecat is created from the
+current base (origin/<default-branch>, or --base), then the input is
+applied to its branch:
+
+  export directory   every *.patch file, in order, with 'git am'
+  format-patch file  'git am' (one commit per patch)
+  git bundle         'git bundle unbundle'; the branch is reset to the
+                     bundle's tip
+  plain diff         'git apply', left uncommitted
+
+If the patches don't apply cleanly the worktree is left with the conflicts
+and instructions for finishing (or abandoning) the import are printed.
+
+The polecat name is allocated from the rig's name pool unless --name is given.
+
+Examples:
+  gt polecat import greenplace .runtime/exports/greenplace-toast-20260304-050607
+  gt polecat import greenplace toast.patch --name Toast
+  gt polecat import greenplace toast.bundle`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPolecatImport,
+}
+
+func init() {
+	polecatImportCmd.Flags().StringVar(&polecatImportName, "name", "", "Name for the new polecat (default: next name from the pool)")
+	polecatImportCmd.Flags().StringVar(&polecatImportBase, "base", "", "Branch to start from (default: origin/<rig default branch>)")
+
+	polecatCmd.AddCommand(polecatImportCmd)
+}
+
+func runPolecatImport(cmd *cobra.Command, args []string) error {
+	rigName, source := args[0], args[1]
+
+	kind, files, err := polecatImportSource(source)
+	if err != nil {
+		return err
+	}
+
+	mgr, _, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+	name := polecatImportName
+	if name == "" {
+		if name, err = mgr.AllocateName(); err != nil {
+			return fmt.Errorf("allocating polecat name: %w", err)
+		}
+	}
+
+	fmt.Printf("Creating polecat %s/%s...\n", rigName, name)
+	p, err := mgr.AddWithOptions(name, polecat.AddOptions{BaseBranch: polecatImportBase})
+	if err != nil {
+		return fmt.Errorf("adding polecat: %w", err)
+	}
+
+	g := git.NewGit(p.ClonePath)
+	switch kind {
+	case polecatImportBundle:
+		var heads []string
+		if heads, err = g.BundleUnbundle(files[0]); err == nil {
+			if len(heads) == 0 {
+				err = fmt.Errorf("bundle has no refs")
+			} else {
+				err = g.ResetHard(heads[0])
+			}
+		}
+	case polecatImportMbox:
+		err = g.Am(files...)
+	default:
+		err = g.Apply(files[0])
+	}
+	if err != nil {
+		fmt.Printf("%s Import into %s/%s did not apply cleanly: %v\n", style.Warning.Render("⚠"), rigName, name, err)
+		fmt.Print(polecatImportRecovery(kind, p.ClonePath, rigName, name))
+		return NewSilentExit(1)
+	}
+
+	if err := mgr.SetState(name, polecat.StateWorking); err != nil {
+		fmt.Printf("%s Could not set state: %v\n", style.Warning.Render("⚠"), err)
+	}
+
+	fmt.Printf("%s Imported %s into %s/%s\n", style.Success.Render("✓"), source, rigName, name)
+	fmt.Printf("  %s\n", style.Dim.Render(p.ClonePath))
+	fmt.Printf("  Branch: %s\n", style.Dim.Render(p.Branch))
+	if kind == polecatImportDiff {
+		fmt.Printf("  %s\n", style.Dim.Render("The diff was applied without committing"))
+	}
+	return nil
+}
+
+// polecatImportSource works out what kind of input path is and the files to
+// apply. A directory (as written by 'gt polecat export') yields its *.patch
+// files in name order.
+func polecatImportSource(path string) (kind string, files []string, err error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", nil, err
+	}
+	if info.IsDir() {
+		files, err = filepath.Glob(filepath.Join(path, "*.patch"))
+		if err != nil {
+			return "", nil, err
+		}
+		if len(files) == 0 {
+			return "", nil, fmt.Errorf("no .patch files in %s", path)
+		}
+		sort.Strings(files)
+		return polecatImportMbox, files, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+	firstLine, _ := bufio.NewReader(f).ReadString('\n')
+
+	switch {
+	case strings.HasPrefix(firstLine, "# v2 git bundle"), strings.HasPrefix(firstLine, "# v3 git bundle"):
+		return polecatImportBundle, []string{path}, nil
+	case strings.HasPrefix(firstLine, "From "):
+		return polecatImportMbox, []string{path}, nil
+	}
+	return polecatImportDiff, []string{path}, nil
+}
+
+// polecatImportRecovery explains how to finish or abandon an import that
+// stopped with conflicts.
+func polecatImportRecovery(kind, clonePath, rigName, name string) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "\nThe polecat's worktree is at %s\n", clonePath)
+	switch kind {
+	case polecatImportMbox:
+		sb.WriteString("Resolve the conflicts, then run:\n")
+		sb.WriteString("  git add <files> && git am --continue\n")
+		sb.WriteString("Or skip this patch with 'git am --skip', or stop with 'git am --abort'.\n")
+	case polecatImportDiff:
+		sb.WriteString("Resolve the conflict markers in the affected files, then 'git add' them.\n")
+	default:
+		sb.WriteString("The bundle's prerequisite commits may be missing; try --base with the branch it was exported from.\n")
+	}
+	fmt.Fprintf(&sb, "To discard the polecat instead: gt polecat remove %s/%s --force\n", rigName, name)
+	return sb.String()
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestPolecatImportSource(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{write("toast.bundle", "# v2 git bundle\n0123 refs/heads/polecat/toast\n"), polecatImportBundle},
+		{write("toast.patch", "From 0123456789abcdef Mon Sep 17 00:00:00 2001\nFrom: Toast\n"), polecatImportMbox},
+		{write("toast.diff", "diff --git a/x b/x\n"), polecatImportDiff},
+	}
+	for _, tt := range tests {
+		kind, files, err := polecatImportSource(tt.path)
+		if err != nil || kind != tt.want || !reflect.DeepEqual(files, []string{tt.path}) {
+			t.Errorf("polecatImportSource(%s) = %q, %v, %v; want %q", filepath.Base(tt.path), kind, files, err, tt.want)
+		}
+	}
+
+	// An export directory yields its patches in order
+	exportDir := filepath.Join(dir, "export")
+	if err := os.MkdirAll(exportDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"0002-second.patch", "0001-first.patch", "notes.txt"} {
+		if err := os.WriteFile(filepath.Join(exportDir, name), []byte("From x\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	kind, files, err := polecatImportSource(exportDir)
+	want := []string{filepath.Join(exportDir, "0001-first.patch"), filepath.Join(exportDir, "0002-second.patch")}
+	if err != nil || kind != polecatImportMbox || !reflect.DeepEqual(files, want) {
+		t.Errorf("export dir = %q, %v, %v; want mbox %v", kind, files, err, want)
+	}
+
+	if _, _, err := polecatImportSource(dir + "/missing.patch"); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
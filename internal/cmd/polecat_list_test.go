@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestPolecatListItem_DisplayState(t *testing.T) {
+	tests := []struct {
+		name string
+		item PolecatListItem
+		want polecat.State
+	}{
+		{"working with session", PolecatListItem{State: polecat.StateWorking, SessionRunning: true}, polecat.StateWorking},
+		{"working without session", PolecatListItem{State: polecat.StateWorking}, polecat.StateDone},
+		{"done with session", PolecatListItem{State: polecat.StateDone, SessionRunning: true}, polecat.StateWorking},
+		{"stuck with session", PolecatListItem{State: polecat.StateStuck, SessionRunning: true}, polecat.StateStuck},
+		{"zombie", PolecatListItem{State: polecat.StateZombie, SessionRunning: true, Zombie: true}, polecat.StateZombie},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.item.displayState(); got != tt.want {
+				t.Errorf("displayState() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterPolecatsByState(t *testing.T) {
+	items := []PolecatListItem{
+		{Name: "alpha", State: polecat.StateWorking, SessionRunning: true},
+		{Name: "beta", State: polecat.StateDone},
+		{Name: "gamma", State: polecat.StateWorking}, // session gone: shown as done
+	}
+
+	got := filterPolecatsByState(items, polecat.StateDone)
+	if len(got) != 2 || got[0].Name != "beta" || got[1].Name != "gamma" {
+		t.Errorf("filter done = %v, want beta and gamma", got)
+	}
+
+	got = filterPolecatsByState(items, polecat.StateStuck)
+	if len(got) != 0 {
+		t.Errorf("filter stuck = %v, want none", got)
+	}
+}
+
+func TestFilterPolecatsByLabel(t *testing.T) {
+	items := []PolecatListItem{
+		{Name: "alpha", Labels: []string{"frontend", "urgent"}},
+		{Name: "beta"},
+		{Name: "gamma", Labels: []string{"frontend"}},
+	}
+
+	got := filterPolecatsByLabel(items, "frontend")
+	if len(got) != 2 || got[0].Name != "alpha" || got[1].Name != "gamma" {
+		t.Errorf("filter frontend = %v, want alpha and gamma", got)
+	}
+
+	got = filterPolecatsByLabel(items, "front")
+	if len(got) != 0 {
+		t.Errorf("filter front = %v, want none (labels match exactly)", got)
+	}
+}
+
+func TestFilterPolecatsByAssignee(t *testing.T) {
+	items := []PolecatListItem{
+		{Name: "alpha", AssignedTo: "dave"},
+		{Name: "beta"},
+		{Name: "gamma", AssignedTo: "emma"},
+	}
+
+	got := filterPolecatsByAssignee(items, "dave")
+	if len(got) != 1 || got[0].Name != "alpha" {
+		t.Errorf("filter dave = %v, want alpha", got)
+	}
+}
+
+func TestFormatCommitAge(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(-d)
+		return &t
+	}
+
+	tests := []struct {
+		in   *time.Time
+		want string
+	}{
+		{nil, "-"},
+		{at(5 * time.Minute), "5m"},
+		{at(3 * time.Hour), "3h"},
+		{at(50 * time.Hour), "2d"},
+	}
+	for _, tt := range tests {
+		if got := formatCommitAge(tt.in, now); got != tt.want {
+			t.Errorf("formatCommitAge(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Log command flags
+var (
+	polecatLogCount   int
+	polecatLogOneline bool
+	polecatLogBase    string
+)
+
+var polecatLogCmd = &cobra.Command{
+	Use:   "log <rig> <name>",
+	Short: "Show the commits on a polecat's branch",
+	Long: `Show the commits on a polecat's branch that are not on the base branch.
+
+The base defaults to origin/<default-branch> for the rig; use --base to
+compare against another branch. Commits are listed newest first.
+
+Examples:
+  gt polecat log greenplace Toast
+  gt polecat log greenplace Toast --n 3
+  gt polecat log greenplace Toast --oneline`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPolecatLog,
+}
+
+func init() {
+	polecatLogCmd.Flags().IntVar(&polecatLogCount, "n", 10, "Maximum number of commits to show")
+	polecatLogCmd.Flags().BoolVar(&polecatLogOneline, "oneline", false, "Show one line per commit")
+	polecatLogCmd.Flags().StringVar(&polecatLogBase, "base", "", "Branch to compare against (default: origin/<rig default branch>)")
+
+	polecatCmd.AddCommand(polecatLogCmd)
+}
+
+func runPolecatLog(cmd *cobra.Command, args []string) error {
+	rigName, polecatName := args[0], args[1]
+
+	mgr, r, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+	p, err := mgr.Get(polecatName)
+	if err != nil {
+		return fmt.Errorf("polecat '%s' not found in rig '%s'", polecatName, rigName)
+	}
+
+	base := polecatBaseRef(r, polecatLogBase)
+	entries, err := git.NewGit(p.ClonePath).Log(base+".."+p.Branch, polecatLogCount)
+	if err != nil {
+		return fmt.Errorf("reading log of %s: %w", p.Branch, err)
+	}
+	if len(entries) == 0 {
+		fmt.Printf("%s No commits on %s beyond %s\n", style.Dim.Render("○"), p.Branch, base)
+		return nil
+	}
+
+	for _, e := range entries {
+		fmt.Println(formatPolecatLogEntry(e, polecatLogOneline))
+	}
+	return nil
+}
+
+// formatPolecatLogEntry renders a commit: hash, author, date and subject,
+// or just the short hash and subject in oneline form.
+func formatPolecatLogEntry(e git.LogEntry, oneline bool) string {
+	hash := e.Hash
+	if len(hash) > 8 {
+		hash = hash[:8]
+	}
+	if oneline {
+		return fmt.Sprintf("%s %s", style.Dim.Render(hash), e.Subject)
+	}
+	return fmt.Sprintf("%s %s %s\n    %s",
+		style.Dim.Render(hash),
+		style.Info.Render(e.Author),
+		style.Dim.Render(e.Date.Local().Format("2006-01-02 15:04")),
+		style.Bold.Render(e.Subject))
+}
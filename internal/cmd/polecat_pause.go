@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// Pause/resume command flags
+var (
+	polecatPauseReason string
+	polecatPauseNudge  bool
+	polecatResumeNudge bool
+)
+
+var polecatPauseCmd = &cobra.Command{
+	Use:   "pause <rig> <name>",
+	Short: "Pause a working polecat",
+	Long: `Pause a working polecat without marking it done or nuking it.
+
+Use this when a polecat has to wait, e.g. for a dependency to land. The
+polecat shows as "paused" until 'gt polecat resume': it is skipped by
+'gt polecat sync' and by the doctor's stale-polecat check. Its session,
+worktree and hooked work are left alone.
+
+With --nudge, the polecat's session is told to stop and wait (including
+the --reason, if given).
+
+Examples:
+  gt polecat pause greenplace Toast
+  gt polecat pause greenplace Toast --reason "waiting on gt-abc" --nudge`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPolecatPause,
+}
+
+var polecatResumeCmd = &cobra.Command{
+	Use:   "resume <rig> <name>",
+	Short: "Resume a paused polecat",
+	Long: `Return a paused polecat to working.
+
+With --nudge, the polecat's session is told to carry on with its work.
+
+Examples:
+  gt polecat resume greenplace Toast
+  gt polecat resume greenplace Toast --nudge`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPolecatResume,
+}
+
+func init() {
+	polecatPauseCmd.Flags().StringVar(&polecatPauseReason, "reason", "", "Why the polecat is paused (shown in 'gt polecat status')")
+	polecatPauseCmd.Flags().BoolVar(&polecatPauseNudge, "nudge", false, "Tell the polecat's session to stop and wait")
+	polecatResumeCmd.Flags().BoolVar(&polecatResumeNudge, "nudge", false, "Tell the polecat's session to continue")
+
+	polecatCmd.AddCommand(polecatPauseCmd)
+	polecatCmd.AddCommand(polecatResumeCmd)
+}
+
+func runPolecatPause(cmd *cobra.Command, args []string) error {
+	rigName, polecatName := args[0], args[1]
+
+	mgr, _, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+	if err := mgr.Pause(polecatName, polecatPauseReason); err != nil {
+		return fmt.Errorf("pausing %s/%s: %w", rigName, polecatName, err)
+	}
+	fmt.Printf("%s Paused %s/%s\n", style.Success.Render("✓"), rigName, polecatName)
+
+	if polecatPauseNudge {
+		nudgePausedPolecat(rigName, polecatName, polecatPauseMessage(polecatPauseReason))
+	}
+	return nil
+}
+
+func runPolecatResume(cmd *cobra.Command, args []string) error {
+	rigName, polecatName := args[0], args[1]
+
+	mgr, _, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+	if err := mgr.Resume(polecatName); err != nil {
+		return fmt.Errorf("resuming %s/%s: %w", rigName, polecatName, err)
+	}
+	fmt.Printf("%s Resumed %s/%s\n", style.Success.Render("✓"), rigName, polecatName)
+
+	if polecatResumeNudge {
+		nudgePausedPolecat(rigName, polecatName, "You have been resumed: carry on with your work")
+	}
+	return nil
+}
+
+// polecatPauseMessage is the nudge sent by 'gt polecat pause --nudge'.
+func polecatPauseMessage(reason string) string {
+	msg := "You have been paused: finish your current step, commit, and wait until you are resumed"
+	if reason != "" {
+		msg += " (reason: " + reason + ")"
+	}
+	return msg
+}
+
+// nudgePausedPolecat sends message to a polecat's session. The state change
+// has already been made, so failures are warnings.
+func nudgePausedPolecat(rigName, polecatName, message string) {
+	_, r, err := getPolecatManager(rigName)
+	if err != nil {
+		style.PrintWarning("could not nudge %s/%s: %v", rigName, polecatName, err)
+		return
+	}
+	t := tmux.NewTmux()
+	sessionName := polecat.NewSessionManager(t, r).SessionName(polecatName)
+	if running, _ := t.HasSession(sessionName); !running {
+		style.PrintWarning("no session for %s/%s, skipping nudge", rigName, polecatName)
+		return
+	}
+	if err := t.NudgeSession(sessionName, message); err != nil {
+		style.PrintWarning("nudging %s: %v", sessionName, err)
+		return
+	}
+	fmt.Printf("  Nudged %s/%s\n", rigName, polecatName)
+}
@@ -0,0 +1,525 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// ErrPruneFailed is returned by gt polecat prune when any branch it chose to
+// prune could not be deleted, so scripts and CI see a non-zero exit.
+var ErrPruneFailed = errors.New("some branches could not be pruned")
+
+// Prune history branch types.
+const (
+	pruneTypeLocal  = "local"
+	pruneTypeRemote = "remote"
+)
+
+// newPruneLogger returns the diagnostic logger for gt polecat prune.
+// It writes to stderr so stdout stays the user-facing report; debug records
+// are only emitted with --verbose.
+func newPruneLogger(verbose bool) *slog.Logger {
+	level := slog.LevelWarn
+	if verbose {
+		level = slog.LevelDebug
+	}
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level}))
+}
+
+// What became of the polecat that owned a pruned branch.
+const (
+	pruneOriginNoPolecat = "no_polecat" // no polecat by that name, or one that has moved on to other work
+	pruneOriginDone      = "done"       // the polecat is still there, finished
+	pruneOriginNuked     = "nuked"      // the polecat was nuked; its agent bead remains
+)
+
+// pruneOriginFunc classifies a pruned branch as one of the pruneOrigin* values.
+type pruneOriginFunc func(branch string) string
+
+// polecatNameFromBranch returns the polecat name in a branch created by the
+// polecat manager: polecat/<name>/<issue>@<ts>, polecat/<name>-<ts> or the
+// legacy polecat/<name>.
+func polecatNameFromBranch(branch string) string {
+	rest, ok := strings.CutPrefix(branch, "polecat/")
+	if !ok || rest == "" {
+		return ""
+	}
+	if name, _, found := strings.Cut(rest, "/"); found {
+		return name
+	}
+	if i := strings.LastIndex(rest, "-"); i > 0 {
+		return rest[:i]
+	}
+	return rest
+}
+
+// rigPruneOrigins returns a pruneOriginFunc that looks up the polecat owning
+// each branch in r and, if it no longer exists, its agent bead.
+func rigPruneOrigins(r *rig.Rig) pruneOriginFunc {
+	mgr := polecat.NewManager(r, git.NewGit(r.Path), tmux.NewTmux())
+	bd := beads.New(r.Path)
+	return func(branch string) string {
+		name := polecatNameFromBranch(branch)
+		if name == "" {
+			return pruneOriginNoPolecat
+		}
+		if p, err := mgr.Get(name); err == nil {
+			if p.State == polecat.StateDone {
+				return pruneOriginDone
+			}
+			return pruneOriginNoPolecat
+		}
+		_, fields, err := bd.GetAgentBead(polecatBeadIDForRig(r, r.Name, name))
+		if err == nil && fields != nil && fields.AgentState == "nuked" {
+			return pruneOriginNuked
+		}
+		return pruneOriginNoPolecat
+	}
+}
+
+// pruneReasonAge is the prune reason for a branch selected by --older-than
+// rather than by merge or remote state.
+const pruneReasonAge = "older-than"
+
+// rigActivePolecatBranch returns a func reporting whether a branch belongs to
+// a polecat that still exists and isn't done, which --older-than never prunes.
+func rigActivePolecatBranch(r *rig.Rig) func(branch string) bool {
+	mgr := polecat.NewManager(r, git.NewGit(r.Path), tmux.NewTmux())
+	return func(branch string) bool {
+		name := polecatNameFromBranch(branch)
+		if name == "" {
+			return false
+		}
+		p, err := mgr.Get(name)
+		return err == nil && p.State != polecat.StateDone
+	}
+}
+
+// branchOlderThan reports whether ref's last commit is before cutoff.
+// A ref whose commit time can't be read is never old.
+func branchOlderThan(repoGit *git.Git, ref string, cutoff time.Time) bool {
+	last, err := repoGit.LastCommitTime(ref)
+	return err == nil && last.Before(cutoff)
+}
+
+// agedBranchCandidates returns the local branches matching pattern that are
+// not already in candidates, whose last commit is before cutoff and whose
+// polecat isn't active, for --older-than. The current and default branches
+// are never included. Branches matching an exclude pattern (prune-ignore)
+// that would otherwise qualify are returned separately as protected, so they
+// can be reported.
+func agedBranchCandidates(repoGit *git.Git, pattern string, exclude []string, candidates []git.PrunedBranch, cutoff time.Time, active func(string) bool) (aged []git.PrunedBranch, protected []string, err error) {
+	branches, err := repoGit.ListBranchesExcluding([]string{pattern}, exclude)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing branches: %w", err)
+	}
+	included := make(map[string]bool, len(branches))
+	for _, branch := range branches {
+		included[branch] = true
+	}
+	all := branches
+	if len(exclude) > 0 {
+		if all, err = repoGit.ListBranches(pattern); err != nil {
+			return nil, nil, fmt.Errorf("listing branches: %w", err)
+		}
+	}
+
+	seen := make(map[string]bool, len(candidates))
+	for _, b := range candidates {
+		seen[b.Name] = true
+	}
+	currentBranch, _ := repoGit.CurrentBranch()
+	defaultBranch := repoGit.RemoteDefaultBranch()
+
+	for _, branch := range all {
+		if branch == "" || seen[branch] || branch == currentBranch || branch == defaultBranch {
+			continue
+		}
+		if !branchOlderThan(repoGit, branch, cutoff) || active(branch) {
+			continue
+		}
+		if !included[branch] {
+			protected = append(protected, branch)
+			continue
+		}
+		aged = append(aged, git.PrunedBranch{Name: branch, Reason: pruneReasonAge})
+	}
+	return aged, protected, nil
+}
+
+// PruneBranchResult is one branch in gt polecat prune --json output.
+type PruneBranchResult struct {
+	Branch string `json:"branch"`
+	Reason string `json:"reason"`
+	Origin string `json:"origin,omitempty"` // Pruned branches only: no_polecat, done or nuked
+	Warn   bool   `json:"warn,omitempty"`   // Kept because of a problem rather than by policy
+}
+
+// PruneSummary counts pruned branches by what became of their polecat.
+type PruneSummary struct {
+	Pruned    int `json:"pruned"`
+	NoPolecat int `json:"no_polecat"`
+	Done      int `json:"done"`
+	Nuked     int `json:"nuked"`
+}
+
+// line formats the summary, e.g. "Pruned 3 local branch(es): 1 with no
+// polecat, 2 from done polecats, 0 from nuked polecats."
+func (s PruneSummary) line(verb, kind string) string {
+	return fmt.Sprintf("%s %d %s branch(es): %d with no polecat, %d from done polecats, %d from nuked polecats.",
+		verb, s.Pruned, kind, s.NoPolecat, s.Done, s.Nuked)
+}
+
+// PruneSectionResult is the local or remote half of gt polecat prune --json output.
+type PruneSectionResult struct {
+	PruneSummary
+	Branches []PruneBranchResult `json:"branches"` // Pruned (or, in a dry run, would be)
+	Kept     []PruneBranchResult `json:"kept"`
+}
+
+// PruneResult is the gt polecat prune --json output.
+type PruneResult struct {
+	Rig    string              `json:"rig"`
+	DryRun bool                `json:"dry_run"`
+	Local  PruneSectionResult  `json:"local"`
+	Remote *PruneSectionResult `json:"remote,omitempty"` // Only with --remote
+}
+
+// pruneReport collects prune decisions so pruned and kept branches are
+// printed as separate sections instead of interleaved in branch order.
+type pruneReport struct {
+	pruned []PruneBranchResult
+	kept   []PruneBranchResult
+	failed int // Deletions that failed; these are also in kept
+}
+
+// prune records a branch that was (or, in a dry run, would be) pruned, with
+// the pruneOrigin* value for its polecat.
+func (r *pruneReport) prune(branch, reason, origin string) {
+	r.pruned = append(r.pruned, PruneBranchResult{Branch: branch, Reason: reason, Origin: origin})
+}
+
+// keep records a branch that was considered for pruning but kept.
+func (r *pruneReport) keep(branch, reason string, warn bool) {
+	r.kept = append(r.kept, PruneBranchResult{Branch: branch, Reason: reason, Warn: warn})
+}
+
+// deleteFailed records a branch that should have been pruned but could not
+// be deleted.
+func (r *pruneReport) deleteFailed(branch string, err error) {
+	r.keep(branch, fmt.Sprintf("delete failed: %v", err), true)
+	r.failed++
+}
+
+// summary counts the pruned branches by origin.
+func (r *pruneReport) summary() PruneSummary {
+	s := PruneSummary{Pruned: len(r.pruned)}
+	for _, e := range r.pruned {
+		switch e.Origin {
+		case pruneOriginDone:
+			s.Done++
+		case pruneOriginNuked:
+			s.Nuked++
+		default:
+			s.NoPolecat++
+		}
+	}
+	return s
+}
+
+// result returns the report as --json output.
+func (r *pruneReport) result() PruneSectionResult {
+	res := PruneSectionResult{PruneSummary: r.summary(), Branches: r.pruned, Kept: r.kept}
+	if res.Branches == nil {
+		res.Branches = []PruneBranchResult{}
+	}
+	if res.Kept == nil {
+		res.Kept = []PruneBranchResult{}
+	}
+	return res
+}
+
+// write prints the "Pruning:" and "Keeping:" sections, omitting empty ones.
+func (r *pruneReport) write(w io.Writer) {
+	if len(r.pruned) > 0 {
+		fmt.Fprintln(w, "Pruning:")
+		for _, e := range r.pruned {
+			fmt.Fprintf(w, "  %s %s (%s)\n", style.Success.Render("✓"), e.Branch, e.Reason)
+		}
+	}
+	if len(r.kept) > 0 {
+		fmt.Fprintln(w, "Keeping:")
+		for _, e := range r.kept {
+			icon := style.Dim.Render("○")
+			if e.Warn {
+				icon = style.Warning.Render("⚠")
+			}
+			fmt.Fprintf(w, "  %s %s (%s)\n", icon, e.Branch, e.Reason)
+		}
+	}
+}
+
+// prChecker reports whether branch has an open pull request.
+type prChecker func(branch string) (bool, error)
+
+// ghOpenPRChecker returns a prChecker that asks the GitHub CLI, run in repoDir,
+// for open PRs whose head is the branch. Each call is bounded by timeout.
+func ghOpenPRChecker(repoDir string, timeout time.Duration) prChecker {
+	return func(branch string) (bool, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		cmd := exec.CommandContext(ctx, "gh", "pr", "list",
+			"--head", branch, "--state", "open", "--json", "number", "--jq", "length")
+		cmd.Dir = repoDir
+		out, err := cmd.Output()
+		if ctx.Err() == context.DeadlineExceeded {
+			return false, fmt.Errorf("timed out after %s", timeout)
+		}
+		if err != nil {
+			if ee, ok := err.(*exec.ExitError); ok && len(ee.Stderr) > 0 {
+				return false, fmt.Errorf("%s", strings.TrimSpace(string(ee.Stderr)))
+			}
+			return false, err
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(string(out)))
+		if err != nil {
+			return false, fmt.Errorf("unexpected gh output %q", strings.TrimSpace(string(out)))
+		}
+		return n > 0, nil
+	}
+}
+
+// branchesWithOpenPRs returns the subset of branches that have an open PR.
+// A failed lookup prints a warning to w and treats the branch as having no PR,
+// so an unavailable or slow gh never blocks the prune.
+func branchesWithOpenPRs(w io.Writer, branches []string, hasOpenPR prChecker) map[string]bool {
+	open := make(map[string]bool)
+	for _, branch := range branches {
+		hasPR, err := hasOpenPR(branch)
+		if err != nil {
+			fmt.Fprintf(w, "  %s PR lookup for %s: %v (not skipping)\n", style.Warning.Render("⚠"), branch, err)
+			continue
+		}
+		if hasPR {
+			open[branch] = true
+		}
+	}
+	return open
+}
+
+// pruneIgnorePath returns the path to the town's list of protected branch patterns.
+// Path: <townRoot>/settings/prune-ignore
+func pruneIgnorePath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirSettings, "prune-ignore")
+}
+
+// loadPruneIgnore reads the protected branch patterns: one glob per line
+// (e.g. polecat/release-*), with blank lines and # comments ignored.
+// A missing file means nothing is protected.
+func loadPruneIgnore(townRoot string) ([]string, error) {
+	data, err := os.ReadFile(pruneIgnorePath(townRoot))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading prune-ignore: %w", err)
+	}
+
+	var patterns []string
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if _, err := pruneGlobRegexp(line); err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid pattern %q: %w", pruneIgnorePath(townRoot), i+1, line, err)
+		}
+		patterns = append(patterns, line)
+	}
+	return patterns, nil
+}
+
+// pruneProtected reports whether branch matches any protected pattern.
+// Patterns match the way git branch --list does, so * also crosses a /;
+// --older-than hands the same patterns to git, and both paths must agree.
+func pruneProtected(branch string, patterns []string) bool {
+	for _, p := range patterns {
+		if re, err := pruneGlobRegexp(p); err == nil && re.MatchString(branch) {
+			return true
+		}
+	}
+	return false
+}
+
+// pruneGlobRegexp translates a git branch glob (*, ?, [...] and \ escapes,
+// with no special meaning for /) into an anchored regexp.
+func pruneGlobRegexp(pattern string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		case '\\':
+			if i+1 == len(pattern) {
+				return nil, fmt.Errorf("trailing backslash")
+			}
+			i++
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated [")
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + class + "]")
+			i += end + 1
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.Compile(b.String())
+}
+
+// PruneHistoryEntry is one line of the prune audit log, recorded for every
+// branch that gt polecat prune deletes.
+type PruneHistoryEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Rig       string    `json:"rig"`
+	Branch    string    `json:"branch"`
+	Type      string    `json:"type"` // "local" or "remote"
+	InvokedBy string    `json:"invokedBy"`
+}
+
+// pruneHistoryPath returns the path to the prune audit log.
+// Path: <townRoot>/.runtime/prune-history.jsonl
+func pruneHistoryPath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "prune-history.jsonl")
+}
+
+// appendPruneHistory appends a deletion record to the prune audit log,
+// creating the file if needed.
+func appendPruneHistory(townRoot, rigName, branch, branchType string) error {
+	entry := PruneHistoryEntry{
+		Timestamp: time.Now().UTC(),
+		Rig:       rigName,
+		Branch:    branch,
+		Type:      branchType,
+		InvokedBy: os.Getenv("USER"),
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	path := pruneHistoryPath(townRoot)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating prune history dir: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("opening prune history: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("writing prune history: %w", err)
+	}
+	return nil
+}
+
+// recordPrune appends to the prune audit log, warning to w (not failing) on
+// error since the branch has already been deleted.
+func recordPrune(w io.Writer, townRoot, rigName, branch, branchType string) {
+	if err := appendPruneHistory(townRoot, rigName, branch, branchType); err != nil {
+		fmt.Fprintf(w, "  %s prune history: %v\n", style.Warning.Render("⚠"), err)
+	}
+}
+
+// readPruneHistory returns the last limit entries for rigName from the prune
+// audit log, oldest first. Malformed lines are skipped. A missing log is not an error.
+func readPruneHistory(townRoot, rigName string, limit int) ([]PruneHistoryEntry, error) {
+	f, err := os.Open(pruneHistoryPath(townRoot))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []PruneHistoryEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e PruneHistoryEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		if rigName != "" && e.Rig != rigName {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+	return entries, nil
+}
+
+// printPruneHistory prints recent prune history for a rig.
+func printPruneHistory(townRoot, rigName string, limit int) error {
+	entries, err := readPruneHistory(townRoot, rigName, limit)
+	if err != nil {
+		return fmt.Errorf("reading prune history: %w", err)
+	}
+
+	if len(entries) == 0 {
+		fmt.Printf("No prune history for %s.\n", rigName)
+		return nil
+	}
+
+	fmt.Printf("%s\n\n", style.Bold.Render(fmt.Sprintf("Prune history for %s (last %d)", rigName, len(entries))))
+	for _, e := range entries {
+		invokedBy := e.InvokedBy
+		if invokedBy == "" {
+			invokedBy = "unknown"
+		}
+		fmt.Printf("  %s  %-6s  %s  %s\n",
+			e.Timestamp.Local().Format("2006-01-02 15:04:05"),
+			e.Type,
+			e.Branch,
+			style.Dim.Render("by "+invokedBy))
+	}
+	return nil
+}
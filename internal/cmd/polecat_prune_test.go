@@ -0,0 +1,405 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/git"
+)
+
+func TestPruneHistory_AppendAndRead(t *testing.T) {
+	townRoot := t.TempDir()
+	t.Setenv("USER", "tester")
+
+	if err := appendPruneHistory(townRoot, "gastown", "polecat/toast-abc", pruneTypeLocal); err != nil {
+		t.Fatalf("appendPruneHistory: %v", err)
+	}
+	if err := appendPruneHistory(townRoot, "beads", "polecat/nux-def", pruneTypeRemote); err != nil {
+		t.Fatalf("appendPruneHistory: %v", err)
+	}
+	if err := appendPruneHistory(townRoot, "gastown", "polecat/rictus-ghi", pruneTypeRemote); err != nil {
+		t.Fatalf("appendPruneHistory: %v", err)
+	}
+
+	entries, err := readPruneHistory(townRoot, "gastown", 0)
+	if err != nil {
+		t.Fatalf("readPruneHistory: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries for gastown, want 2", len(entries))
+	}
+	if entries[0].Branch != "polecat/toast-abc" || entries[0].Type != pruneTypeLocal {
+		t.Errorf("entries[0] = %+v", entries[0])
+	}
+	if entries[0].InvokedBy != "tester" {
+		t.Errorf("InvokedBy = %q, want %q", entries[0].InvokedBy, "tester")
+	}
+	if entries[0].Timestamp.IsZero() {
+		t.Error("Timestamp not set")
+	}
+}
+
+func TestPruneHistory_LimitKeepsMostRecent(t *testing.T) {
+	townRoot := t.TempDir()
+
+	for _, b := range []string{"polecat/a", "polecat/b", "polecat/c"} {
+		if err := appendPruneHistory(townRoot, "gastown", b, pruneTypeLocal); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := readPruneHistory(townRoot, "gastown", 2)
+	if err != nil {
+		t.Fatalf("readPruneHistory: %v", err)
+	}
+	if len(entries) != 2 || entries[0].Branch != "polecat/b" || entries[1].Branch != "polecat/c" {
+		t.Errorf("got %+v, want last two entries b, c", entries)
+	}
+}
+
+func TestPruneHistory_MissingFileAndMalformedLines(t *testing.T) {
+	townRoot := t.TempDir()
+
+	entries, err := readPruneHistory(townRoot, "gastown", 10)
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("missing history: got %v, %v; want empty, nil", entries, err)
+	}
+
+	if err := appendPruneHistory(townRoot, "gastown", "polecat/a", pruneTypeLocal); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.OpenFile(pruneHistoryPath(townRoot), os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString("not json\n")
+	f.Close()
+
+	entries, err = readPruneHistory(townRoot, "gastown", 10)
+	if err != nil {
+		t.Fatalf("readPruneHistory: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("got %d entries, want 1 (malformed line skipped)", len(entries))
+	}
+}
+
+func TestBranchesWithOpenPRs(t *testing.T) {
+	checker := func(branch string) (bool, error) {
+		switch branch {
+		case "polecat/toast":
+			return true, nil
+		case "polecat/broken":
+			return false, errors.New("gh: not logged in")
+		}
+		return false, nil
+	}
+
+	open := branchesWithOpenPRs(io.Discard, []string{"polecat/toast", "polecat/nux", "polecat/broken"}, checker)
+	if !open["polecat/toast"] {
+		t.Error("polecat/toast has an open PR and should be skipped")
+	}
+	if open["polecat/nux"] {
+		t.Error("polecat/nux has no PR and should be pruned")
+	}
+	if open["polecat/broken"] {
+		t.Error("a failed PR lookup should not block pruning")
+	}
+}
+
+func TestLoadPruneIgnore(t *testing.T) {
+	townRoot := t.TempDir()
+
+	if patterns, err := loadPruneIgnore(townRoot); err != nil || patterns != nil {
+		t.Fatalf("missing prune-ignore: got %v, %v; want nil, nil", patterns, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(pruneIgnorePath(townRoot)), 0755); err != nil {
+		t.Fatal(err)
+	}
+	content := "# keep release branches\npolecat/release-*\n\n  polecat/pinned  \n"
+	if err := os.WriteFile(pruneIgnorePath(townRoot), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	patterns, err := loadPruneIgnore(townRoot)
+	if err != nil {
+		t.Fatalf("loadPruneIgnore: %v", err)
+	}
+	if len(patterns) != 2 || patterns[0] != "polecat/release-*" || patterns[1] != "polecat/pinned" {
+		t.Fatalf("patterns = %q", patterns)
+	}
+
+	tests := map[string]bool{
+		"polecat/release-1.2":    true,
+		"polecat/pinned":         true,
+		"polecat/toast-abc":      false,
+		"polecat/release-1/fix":  true, // * crosses / as in git branch --list
+		"polecat/pinned-forever": false,
+	}
+	for branch, want := range tests {
+		if got := pruneProtected(branch, patterns); got != want {
+			t.Errorf("pruneProtected(%q) = %v, want %v", branch, got, want)
+		}
+	}
+
+	if err := os.WriteFile(pruneIgnorePath(townRoot), []byte("polecat/[bad\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadPruneIgnore(townRoot); err == nil {
+		t.Error("expected error for malformed pattern")
+	}
+}
+
+func TestRemoteOnlyCommits(t *testing.T) {
+	tmpDir := t.TempDir()
+	runGit := func(dir string, args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@test.com",
+			"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@test.com")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	origin := filepath.Join(tmpDir, "origin.git")
+	runGit(tmpDir, "init", "--bare", origin)
+	local := filepath.Join(tmpDir, "local")
+	runGit(tmpDir, "clone", origin, local)
+	runGit(local, "commit", "--allow-empty", "-m", "init")
+	runGit(local, "checkout", "-b", "polecat/toast")
+	runGit(local, "commit", "--allow-empty", "-m", "work")
+	runGit(local, "push", "origin", "polecat/toast")
+
+	g := git.NewGit(local)
+	if n, err := remoteOnlyCommits(g, "polecat/toast"); err != nil || n != 0 {
+		t.Fatalf("remoteOnlyCommits (in sync) = %d, %v; want 0", n, err)
+	}
+
+	// Another clone pushes to the branch; the local copy falls behind
+	other := filepath.Join(tmpDir, "other")
+	runGit(tmpDir, "clone", "--branch", "polecat/toast", origin, other)
+	runGit(other, "commit", "--allow-empty", "-m", "more work")
+	runGit(other, "push", "origin", "polecat/toast")
+	runGit(local, "fetch", "origin")
+
+	if n, err := remoteOnlyCommits(g, "polecat/toast"); err != nil || n != 1 {
+		t.Errorf("remoteOnlyCommits (diverged) = %d, %v; want 1", n, err)
+	}
+
+	// An --older-than candidate is force-deleted, so it must be kept too
+	var report pruneReport
+	aged := []git.PrunedBranch{{Name: "polecat/toast", Reason: pruneReasonAge}}
+	if pruned := pruneFilteredBranches(io.Discard, g, aged, nil, nil, false, &report); len(pruned) != 0 {
+		t.Errorf("pruned diverged aged branch: %+v", pruned)
+	}
+	if len(report.kept) != 1 || !report.kept[0].Warn || !strings.HasPrefix(report.kept[0].Reason, "diverged") {
+		t.Errorf("kept = %+v, want one diverged warning", report.kept)
+	}
+
+	runGit(local, "branch", "polecat/nux")
+	if n, err := remoteOnlyCommits(g, "polecat/nux"); err != nil || n != 0 {
+		t.Errorf("remoteOnlyCommits (no remote) = %d, %v; want 0", n, err)
+	}
+}
+
+func TestPruneReport(t *testing.T) {
+	var r pruneReport
+	r.keep("polecat/release-1", "protected", false)
+	r.prune("polecat/toast", "merged", pruneOriginDone)
+	r.keep("polecat/nux", "open PR", false)
+	r.prune("polecat/rictus", "no-remote", pruneOriginNoPolecat)
+
+	var buf bytes.Buffer
+	r.write(&buf)
+	out := buf.String()
+
+	// Pruned branches come first, then kept ones, each in the order recorded
+	order := []string{"Pruning:", "polecat/toast (merged)", "polecat/rictus (no-remote)",
+		"Keeping:", "polecat/release-1 (protected)", "polecat/nux (open PR)"}
+	last := -1
+	for _, want := range order {
+		i := strings.Index(out, want)
+		if i <= last {
+			t.Fatalf("%q missing or out of order in:\n%s", want, out)
+		}
+		last = i
+	}
+
+	// Empty sections are omitted
+	var keepOnly pruneReport
+	keepOnly.keep("polecat/nux", "open PR", false)
+	buf.Reset()
+	keepOnly.write(&buf)
+	if strings.Contains(buf.String(), "Pruning:") || !strings.Contains(buf.String(), "Keeping:") {
+		t.Errorf("keep-only report = %q, want only a Keeping section", buf.String())
+	}
+
+	buf.Reset()
+	(&pruneReport{}).write(&buf)
+	if buf.Len() != 0 {
+		t.Errorf("empty report = %q, want no output", buf.String())
+	}
+}
+
+func TestPruneReportDeleteFailed(t *testing.T) {
+	var r pruneReport
+	r.keep("polecat/nux", "open PR", false)
+	if r.failed != 0 {
+		t.Errorf("failed = %d after keep, want 0", r.failed)
+	}
+	r.deleteFailed("polecat/toast", errors.New("branch is checked out"))
+	if r.failed != 1 {
+		t.Errorf("failed = %d, want 1", r.failed)
+	}
+	res := r.result()
+	if len(res.Kept) != 2 || res.Kept[1].Reason != "delete failed: branch is checked out" || !res.Kept[1].Warn {
+		t.Errorf("kept = %+v, want the failed deletion kept with a warning", res.Kept)
+	}
+}
+
+func TestPruneFilteredBranchesKeepsUnmergedNoRemote(t *testing.T) {
+	dir := t.TempDir()
+	runGit := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@test.com",
+			"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@test.com")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	runGit("init", "-b", "main")
+	runGit("commit", "--allow-empty", "-m", "init")
+	runGit("branch", "polecat/done")
+	runGit("checkout", "-b", "polecat/wip")
+	runGit("commit", "--allow-empty", "-m", "unpushed work")
+	runGit("checkout", "main")
+
+	candidates := []git.PrunedBranch{
+		{Name: "polecat/done", Reason: "no-remote-merged"},
+		{Name: "polecat/wip", Reason: "no-remote"},
+	}
+	var report pruneReport
+	pruned := pruneFilteredBranches(io.Discard, git.NewGit(dir), candidates, nil, nil, false, &report)
+
+	if len(pruned) != 1 || pruned[0].Name != "polecat/done" {
+		t.Errorf("pruned = %+v, want only polecat/done", pruned)
+	}
+	if report.failed != 0 {
+		t.Errorf("failed = %d, want 0: -d refusing an unmerged branch is not a failure", report.failed)
+	}
+	res := report.result()
+	if len(res.Kept) != 1 || res.Kept[0].Branch != "polecat/wip" || res.Kept[0].Warn {
+		t.Errorf("kept = %+v, want polecat/wip kept without a warning", res.Kept)
+	}
+}
+
+func TestPruneReportSummary(t *testing.T) {
+	var r pruneReport
+	r.prune("polecat/toast-m1x2", "merged", pruneOriginDone)
+	r.prune("polecat/nux/gt-abc@m1x3", "merged", pruneOriginNuked)
+	r.prune("polecat/rictus", "no-remote", pruneOriginNoPolecat)
+	r.prune("polecat/slit-m1x4", "merged", pruneOriginDone)
+	r.keep("polecat/release-1", "protected", false)
+
+	want := PruneSummary{Pruned: 4, NoPolecat: 1, Done: 2, Nuked: 1}
+	if got := r.summary(); got != want {
+		t.Errorf("summary() = %+v, want %+v", got, want)
+	}
+	wantLine := "Pruned 4 local branch(es): 1 with no polecat, 2 from done polecats, 1 from nuked polecats."
+	if got := want.line("Pruned", "local"); got != wantLine {
+		t.Errorf("line() = %q, want %q", got, wantLine)
+	}
+
+	res := r.result()
+	if res.PruneSummary != want || len(res.Branches) != 4 || len(res.Kept) != 1 {
+		t.Errorf("result() = %+v", res)
+	}
+	data, err := json.Marshal(res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, field := range []string{`"pruned":4`, `"no_polecat":1`, `"done":2`, `"nuked":1`, `"origin":"nuked"`} {
+		if !strings.Contains(string(data), field) {
+			t.Errorf("JSON %s missing %s", data, field)
+		}
+	}
+
+	// An empty report still marshals its lists as [] rather than null
+	data, _ = json.Marshal((&pruneReport{}).result())
+	if !strings.Contains(string(data), `"branches":[]`) || !strings.Contains(string(data), `"kept":[]`) {
+		t.Errorf("empty report JSON = %s, want empty lists", data)
+	}
+}
+
+func TestPolecatNameFromBranch(t *testing.T) {
+	tests := map[string]string{
+		"polecat/toast-m1x2abc":        "toast",
+		"polecat/toast/gt-abc@m1x2abc": "toast",
+		"polecat/toast":                "toast",
+		"polecat/":                     "",
+		"feature/toast":                "",
+	}
+	for branch, want := range tests {
+		if got := polecatNameFromBranch(branch); got != want {
+			t.Errorf("polecatNameFromBranch(%q) = %q, want %q", branch, got, want)
+		}
+	}
+}
+
+func TestAgedBranchCandidates(t *testing.T) {
+	repo := t.TempDir()
+	runGit := func(date string, args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repo
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@test.com",
+			"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@test.com",
+			"GIT_COMMITTER_DATE="+date)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	const old, recent = "2026-01-01T00:00:00Z", "2026-03-01T00:00:00Z"
+	runGit(old, "init", "-b", "main")
+	runGit(old, "commit", "--allow-empty", "-m", "init")
+	for _, b := range []string{"polecat/old-1", "polecat/busy-1", "polecat/listed-1", "polecat/base"} {
+		runGit(old, "branch", b)
+	}
+	runGit(recent, "checkout", "-b", "polecat/recent-1")
+	runGit(recent, "commit", "--allow-empty", "-m", "work")
+	runGit(recent, "checkout", "main")
+
+	g := git.NewGit(repo)
+	candidates := []git.PrunedBranch{{Name: "polecat/listed-1", Reason: "no-remote"}}
+	active := func(branch string) bool { return branch == "polecat/busy-1" }
+	cutoff := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	aged, protected, err := agedBranchCandidates(g, "polecat/*", []string{"polecat/base"}, candidates, cutoff, active)
+	if err != nil {
+		t.Fatalf("agedBranchCandidates: %v", err)
+	}
+	want := []git.PrunedBranch{{Name: "polecat/old-1", Reason: pruneReasonAge}}
+	if !reflect.DeepEqual(aged, want) {
+		t.Errorf("agedBranchCandidates = %+v, want %+v", aged, want)
+	}
+	if !reflect.DeepEqual(protected, []string{"polecat/base"}) {
+		t.Errorf("protected = %v, want [polecat/base]", protected)
+	}
+}
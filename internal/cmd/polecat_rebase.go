@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Rebase command flags
+var (
+	polecatRebaseBase  string
+	polecatRebaseAbort bool
+)
+
+var polecatRebaseCmd = &cobra.Command{
+	Use:   "rebase <rig> <name>",
+	Short: "Rebase one polecat onto the latest base branch",
+	Long: `Rebase a single working polecat onto its base branch.
+
+Runs 'git rebase <base>' in the polecat's worktree. The base defaults to
+origin/<default-branch> for the rig; use --base to rebase onto another
+branch. Nothing is fetched, so run 'gt rig fetch <rig>' first to pick up
+new commits. To rebase every working polecat in a rig, use 'gt polecat sync'.
+
+The polecat must have no uncommitted changes. If the rebase stops on a
+conflict, the worktree is left mid-rebase, the conflicting files are listed,
+and the polecat shows as "conflict" until the rebase is continued or aborted.
+
+With --abort, runs 'git rebase --abort' in a conflicted polecat's worktree,
+returning it to its pre-rebase state and to "working".
+
+Examples:
+  gt polecat rebase greenplace Toast
+  gt polecat rebase greenplace Toast --base origin/integration/gt-epic
+  gt polecat rebase greenplace Toast --abort`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPolecatRebase,
+}
+
+func init() {
+	polecatRebaseCmd.Flags().StringVar(&polecatRebaseBase, "base", "", "Branch to rebase onto (default: origin/<rig default branch>)")
+	polecatRebaseCmd.Flags().BoolVar(&polecatRebaseAbort, "abort", false, "Abort a rebase stopped on a conflict")
+
+	polecatCmd.AddCommand(polecatRebaseCmd)
+}
+
+func runPolecatRebase(cmd *cobra.Command, args []string) error {
+	rigName, polecatName := args[0], args[1]
+	label := rigName + "/" + polecatName
+
+	if polecatRebaseAbort {
+		if cmd.Flags().Changed("base") {
+			return fmt.Errorf("cannot use --base with --abort")
+		}
+		return abortPolecatRebase(rigName, polecatName)
+	}
+
+	p, err := getWorkingPolecat(rigName, polecatName, "rebase")
+	if err != nil {
+		return err
+	}
+	_, r, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+	base := polecatBaseRef(r, polecatRebaseBase)
+	g := git.NewGit(p.ClonePath)
+
+	if dirty, err := g.HasUncommittedChanges(); err != nil {
+		return fmt.Errorf("checking %s for uncommitted changes: %w", label, err)
+	} else if dirty {
+		return fmt.Errorf("polecat %s has uncommitted changes; commit or stash them first (gt polecat stash %s %s)",
+			label, rigName, polecatName)
+	}
+
+	if upToDate, err := g.IsAncestor(base, "HEAD"); err == nil && upToDate {
+		fmt.Printf("%s %s is already up to date with %s\n", style.Dim.Render("○"), label, base)
+		return nil
+	}
+
+	if err := g.Rebase(base); err != nil {
+		if rebasing, _ := g.RebaseInProgress(); !rebasing {
+			return fmt.Errorf("rebasing %s onto %s: %w", label, base, err)
+		}
+		files, _ := g.GetConflictingFiles()
+		fmt.Printf("%s %s: conflict rebasing onto %s in:\n", style.Warning.Render("⚠"), label, base)
+		for _, f := range files {
+			fmt.Printf("  %s\n", f)
+		}
+		fmt.Printf("\nIn %s, resolve the conflicted files, 'git add' them, then run %s\n",
+			style.Dim.Render(p.ClonePath), style.Bold.Render("git rebase --continue"))
+		fmt.Printf("(or %s to return the polecat to its pre-rebase state)\n",
+			style.Bold.Render(fmt.Sprintf("gt polecat rebase %s %s --abort", rigName, polecatName)))
+		return NewSilentExit(1)
+	}
+
+	head, err := g.Rev("HEAD")
+	if err != nil {
+		return fmt.Errorf("reading HEAD: %w", err)
+	}
+	fmt.Printf("%s Rebased %s onto %s, HEAD is now %s\n", style.Success.Render("✓"), label, base, head[:8])
+	return nil
+}
+
+// abortPolecatRebase aborts a rebase stopped on a conflict in the polecat's
+// worktree. The conflict state is derived from the rebase, so aborting it
+// returns the polecat to working.
+func abortPolecatRebase(rigName, polecatName string) error {
+	mgr, _, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+	p, err := mgr.Get(polecatName)
+	if err != nil {
+		return fmt.Errorf("polecat '%s' not found in rig '%s'", polecatName, rigName)
+	}
+	if p.State != polecat.StateConflict {
+		return fmt.Errorf("polecat %s/%s has no rebase in progress (state: %s)", rigName, polecatName, p.State)
+	}
+
+	g := git.NewGit(p.ClonePath)
+	if err := g.AbortRebase(); err != nil {
+		return fmt.Errorf("aborting rebase: %w", err)
+	}
+	if p, err = mgr.Get(polecatName); err == nil && p.State != polecat.StateWorking {
+		fmt.Printf("%s Aborted rebase of %s/%s; it is now %s\n", style.Warning.Render("⚠"), rigName, polecatName, p.State)
+		return nil
+	}
+	fmt.Printf("%s Aborted rebase of %s/%s; it is back to working\n", style.Success.Render("✓"), rigName, polecatName)
+	return nil
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Stash command flags
+var polecatStashMessage string
+
+var polecatStashCmd = &cobra.Command{
+	Use:   "stash <rig> <name>",
+	Short: "Stash uncommitted changes in a polecat's worktree",
+	Long: `Shelve a polecat's uncommitted changes with 'git stash push'.
+
+Use this to set work aside while waiting on upstream changes, then restore it
+with 'gt polecat stash-pop'. The stash message defaults to
+"polecat stash: <timestamp>"; use --message to supply your own.
+
+The polecat must be in the working state.
+
+Examples:
+  gt polecat stash greenplace Toast
+  gt polecat stash greenplace Toast -m "parser rewrite, waiting on #123"`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPolecatStash,
+}
+
+var polecatStashPopCmd = &cobra.Command{
+	Use:   "stash-pop <rig> <name>",
+	Short: "Restore the most recent stash in a polecat's worktree",
+	Long: `Apply the most recent stash in a polecat's worktree and drop it.
+
+Stashes are shared by every worktree of the rig's repository, so this pops
+the most recent stash regardless of which polecat made it. Run
+'git stash list' in the worktree first if several polecats have stashed.
+
+The polecat must be in the working state.
+
+Examples:
+  gt polecat stash-pop greenplace Toast`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPolecatStashPop,
+}
+
+func init() {
+	polecatStashCmd.Flags().StringVarP(&polecatStashMessage, "message", "m", "", "Stash message (default: polecat stash: <timestamp>)")
+
+	polecatCmd.AddCommand(polecatStashCmd)
+	polecatCmd.AddCommand(polecatStashPopCmd)
+}
+
+// getWorkingPolecat returns the named polecat, or an error naming action if
+// it doesn't exist or isn't working.
+func getWorkingPolecat(rigName, polecatName, action string) (*polecat.Polecat, error) {
+	mgr, _, err := getPolecatManager(rigName)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := mgr.Get(polecatName)
+	if err != nil {
+		return nil, fmt.Errorf("polecat '%s' not found in rig '%s'", polecatName, rigName)
+	}
+	if p.State != polecat.StateWorking {
+		return nil, fmt.Errorf("polecat %s/%s is %s, not working; refusing to %s", rigName, polecatName, p.State, action)
+	}
+	return p, nil
+}
+
+func runPolecatStash(cmd *cobra.Command, args []string) error {
+	rigName, polecatName := args[0], args[1]
+
+	p, err := getWorkingPolecat(rigName, polecatName, "stash")
+	if err != nil {
+		return err
+	}
+
+	message := polecatStashMessage
+	if message == "" {
+		message = "polecat stash: " + time.Now().UTC().Format(time.RFC3339)
+	}
+
+	if err := git.NewGit(p.ClonePath).Stash(message); err != nil {
+		if errors.Is(err, git.ErrNothingToStash) {
+			fmt.Println("nothing to stash")
+			return nil
+		}
+		return fmt.Errorf("stashing %s/%s: %w", rigName, polecatName, err)
+	}
+
+	fmt.Printf("%s Stashed changes in %s/%s: %s\n", style.Success.Render("✓"), rigName, polecatName, message)
+	return nil
+}
+
+func runPolecatStashPop(cmd *cobra.Command, args []string) error {
+	rigName, polecatName := args[0], args[1]
+
+	p, err := getWorkingPolecat(rigName, polecatName, "pop a stash")
+	if err != nil {
+		return err
+	}
+
+	if err := git.NewGit(p.ClonePath).StashPop(); err != nil {
+		if errors.Is(err, git.ErrNoStash) {
+			return fmt.Errorf("nothing stashed in %s/%s", rigName, polecatName)
+		}
+		return fmt.Errorf("popping stash in %s/%s: %w", rigName, polecatName, err)
+	}
+
+	fmt.Printf("%s Restored stashed changes in %s/%s\n", style.Success.Render("✓"), rigName, polecatName)
+	return nil
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Sync command flags
+var (
+	polecatSyncDryRun bool
+	polecatSyncBase   string
+)
+
+var polecatSyncCmd = &cobra.Command{
+	Use:   "sync <rig>",
+	Short: "Rebase all working polecats onto the latest base branch",
+	Long: `Rebase every working polecat in a rig onto the latest base branch.
+
+Fetches origin once (all polecat worktrees share the rig's repository), then
+runs 'git rebase <base>' in each working polecat's worktree. The base
+defaults to origin/<default-branch> for the rig; use --base to sync onto
+another branch.
+
+Polecats with uncommitted changes are skipped. If a rebase stops on a
+conflict, the worktree is left mid-rebase and the polecat shows as
+"conflict" in 'gt polecat list' until the rebase is continued or aborted.
+
+With --dry-run, nothing is rebased: each polecat's branch is test-merged with
+the base in memory and the files that would conflict are reported.
+
+Examples:
+  gt polecat sync greenplace
+  gt polecat sync greenplace --dry-run
+  gt polecat sync greenplace --base origin/integration/gt-epic`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeRigNames,
+	RunE:              runPolecatSync,
+}
+
+func init() {
+	polecatSyncCmd.Flags().BoolVar(&polecatSyncDryRun, "dry-run", false, "Report which polecats would conflict without rebasing")
+	polecatSyncCmd.Flags().StringVar(&polecatSyncBase, "base", "", "Branch to rebase onto (default: origin/<rig default branch>)")
+
+	polecatCmd.AddCommand(polecatSyncCmd)
+}
+
+func runPolecatSync(cmd *cobra.Command, args []string) error {
+	rigName := args[0]
+
+	mgr, r, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	polecats, err := mgr.List()
+	if err != nil {
+		return fmt.Errorf("listing polecats: %w", err)
+	}
+	var working []*polecat.Polecat
+	for _, p := range polecats {
+		if p.State == polecat.StateWorking {
+			working = append(working, p)
+		}
+	}
+	if len(working) == 0 {
+		fmt.Printf("No working polecats in %s\n", rigName)
+		return nil
+	}
+
+	base := polecatBaseRef(r, polecatSyncBase)
+
+	// Worktrees share the rig's repository, so one fetch updates the base for all
+	if err := git.NewGit(working[0].ClonePath).Fetch("origin"); err != nil {
+		style.PrintWarning("fetching origin failed, syncing onto local %s: %v", base, err)
+	}
+
+	var conflicted []*polecat.Polecat
+	failed := 0
+	for _, p := range working {
+		label := rigName + "/" + p.Name
+		g := git.NewGit(p.ClonePath)
+
+		if dirty, err := g.HasUncommittedChanges(); err != nil || dirty {
+			reason := "has uncommitted changes"
+			if err != nil {
+				reason = err.Error()
+			}
+			fmt.Printf("  %s %s: skipped (%s)\n", style.Warning.Render("⚠"), label, reason)
+			failed++
+			continue
+		}
+
+		if upToDate, err := g.IsAncestor(base, "HEAD"); err == nil && upToDate {
+			fmt.Printf("  %s %s: already up to date\n", style.Dim.Render("○"), label)
+			continue
+		}
+
+		if polecatSyncDryRun {
+			files, err := g.RebaseConflicts(base)
+			switch {
+			case err != nil:
+				fmt.Printf("  %s %s: %v\n", style.Error.Render("✗"), label, err)
+				failed++
+			case len(files) > 0:
+				fmt.Printf("  %s %s: would conflict in %s\n", style.Warning.Render("⚠"), label, strings.Join(files, ", "))
+				conflicted = append(conflicted, p)
+			default:
+				fmt.Printf("  %s %s: would rebase cleanly\n", style.Success.Render("✓"), label)
+			}
+			continue
+		}
+
+		if err := g.Rebase(base); err != nil {
+			if rebasing, _ := g.RebaseInProgress(); rebasing {
+				files, _ := g.GetConflictingFiles()
+				fmt.Printf("  %s %s: conflict in %s\n", style.Warning.Render("⚠"), label, strings.Join(files, ", "))
+				conflicted = append(conflicted, p)
+				continue
+			}
+			fmt.Printf("  %s %s: %v\n", style.Error.Render("✗"), label, err)
+			failed++
+			continue
+		}
+		fmt.Printf("  %s %s: rebased onto %s\n", style.Success.Render("✓"), label, base)
+	}
+
+	if len(conflicted) > 0 && !polecatSyncDryRun {
+		fmt.Printf("\n%d polecat(s) stopped mid-rebase. In each worktree:\n", len(conflicted))
+		for _, p := range conflicted {
+			fmt.Printf("  %s\n", style.Dim.Render("cd "+p.ClonePath))
+		}
+		fmt.Printf("\nresolve the conflicted files, 'git add' them, then run %s\n", style.Bold.Render("git rebase --continue"))
+		fmt.Printf("(or %s to return the polecat to its pre-sync state)\n", style.Bold.Render("git rebase --abort"))
+	}
+
+	if len(conflicted) > 0 || failed > 0 {
+		return NewSilentExit(1)
+	}
+	return nil
+}
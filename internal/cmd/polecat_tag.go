@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Tag command flags
+var (
+	polecatTagLabels   []string
+	polecatUntagLabels []string
+)
+
+var polecatTagCmd = &cobra.Command{
+	Use:   "tag <rig> <name> --label <label>",
+	Short: "Add labels to a polecat",
+	Long: `Attach one or more labels to a polecat.
+
+Labels are free-form tags for grouping polecats, e.g. by area or team.
+They are stored in the polecat's state file (polecats/<name>/state.json)
+and removed along with the polecat. Labels may not contain whitespace or
+commas. Filter by label with 'gt polecat list --label'.
+
+Examples:
+  gt polecat tag greenplace Toast --label frontend
+  gt polecat tag greenplace Toast --label frontend --label urgent
+  gt polecat list greenplace --label frontend`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPolecatTag,
+}
+
+var polecatUntagCmd = &cobra.Command{
+	Use:   "untag <rig> <name> --label <label>",
+	Short: "Remove labels from a polecat",
+	Long: `Remove one or more labels from a polecat.
+
+Removing a label the polecat doesn't have is not an error.
+
+Examples:
+  gt polecat untag greenplace Toast --label urgent`,
+	Args: cobra.ExactArgs(2),
+	RunE: runPolecatUntag,
+}
+
+func init() {
+	polecatTagCmd.Flags().StringSliceVar(&polecatTagLabels, "label", nil, "Label to add (repeatable)")
+	_ = polecatTagCmd.MarkFlagRequired("label")
+	polecatUntagCmd.Flags().StringSliceVar(&polecatUntagLabels, "label", nil, "Label to remove (repeatable)")
+	_ = polecatUntagCmd.MarkFlagRequired("label")
+
+	polecatCmd.AddCommand(polecatTagCmd)
+	polecatCmd.AddCommand(polecatUntagCmd)
+}
+
+func runPolecatTag(cmd *cobra.Command, args []string) error {
+	return updatePolecatLabels(args[0], args[1], polecatTagLabels, (*polecat.Manager).AddLabels)
+}
+
+func runPolecatUntag(cmd *cobra.Command, args []string) error {
+	return updatePolecatLabels(args[0], args[1], polecatUntagLabels, (*polecat.Manager).RemoveLabels)
+}
+
+// updatePolecatLabels applies a label update to a polecat and reports its
+// resulting labels.
+func updatePolecatLabels(rigName, polecatName string, labels []string,
+	update func(*polecat.Manager, string, ...string) ([]string, error)) error {
+	mgr, _, err := getPolecatManager(rigName)
+	if err != nil {
+		return err
+	}
+
+	result, err := update(mgr, polecatName, labels...)
+	if err != nil {
+		if errors.Is(err, polecat.ErrPolecatNotFound) {
+			return fmt.Errorf("polecat '%s' not found in rig '%s'", polecatName, rigName)
+		}
+		return err
+	}
+
+	current := "(none)"
+	if len(result) > 0 {
+		current = strings.Join(result, ", ")
+	}
+	fmt.Printf("%s %s/%s labels: %s\n", style.Success.Render("✓"), rigName, polecatName, current)
+	return nil
+}
@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"golang.org/x/term"
+)
+
+// Watch command flags
+var (
+	polecatWatchInterval time.Duration
+	polecatWatchNotify   bool
+)
+
+var polecatWatchCmd = &cobra.Command{
+	Use:   "watch <rig>",
+	Short: "Watch polecat states in a rig, redrawing on change",
+	Long: `Watch the polecats in a rig and redraw their states whenever one changes.
+
+Polls every --interval (default 5s). On a terminal the table is redrawn in
+place; otherwise a new table is printed on each change. Each redraw lists
+the transitions since the previous one.
+
+With --notify, a desktop notification (osascript on macOS, notify-send on
+Linux) is sent when a polecat becomes done or hits a rebase conflict.
+
+Press Ctrl+C to stop.
+
+Examples:
+  gt polecat watch greenplace
+  gt polecat watch greenplace --interval 10s --notify`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeRigNames,
+	RunE:              runPolecatWatch,
+}
+
+func init() {
+	polecatWatchCmd.Flags().DurationVar(&polecatWatchInterval, "interval", 5*time.Second, "How often to poll polecat states")
+	polecatWatchCmd.Flags().BoolVar(&polecatWatchNotify, "notify", false, "Send a desktop notification when a polecat becomes done or conflicts")
+
+	polecatCmd.AddCommand(polecatWatchCmd)
+}
+
+func runPolecatWatch(cmd *cobra.Command, args []string) error {
+	if polecatWatchInterval <= 0 {
+		return fmt.Errorf("--interval must be positive, got %s", polecatWatchInterval)
+	}
+
+	mgr, r, err := getPolecatManager(args[0])
+	if err != nil {
+		return err
+	}
+	sessions := polecat.NewSessionManager(tmux.NewTmux(), r)
+
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigChan)
+
+	ticker := time.NewTicker(polecatWatchInterval)
+	defer ticker.Stop()
+
+	isTTY := term.IsTerminal(int(os.Stdout.Fd()))
+
+	var prev []PolecatListItem
+	first := true
+	for {
+		polecats, err := mgr.List()
+		if err != nil {
+			return fmt.Errorf("listing polecats: %w", err)
+		}
+		cur := make([]PolecatListItem, 0, len(polecats))
+		for _, p := range polecats {
+			running, _ := sessions.IsRunning(p.Name)
+			cur = append(cur, PolecatListItem{
+				Rig:            r.Name,
+				Name:           p.Name,
+				State:          p.State,
+				Issue:          p.Issue,
+				SessionRunning: running,
+				Branch:         p.Branch,
+			})
+		}
+		sort.Slice(cur, func(i, j int) bool { return cur[i].Name < cur[j].Name })
+
+		changes := polecatStateTransitions(prev, cur)
+		if first || len(changes) > 0 {
+			_, _ = os.Stdout.Write(renderPolecatWatch(r.Name, cur, changes, isTTY))
+			if polecatWatchNotify {
+				if notable := notablePolecatTransitions(prev, cur); len(notable) > 0 {
+					notifyDesktop("gt polecat watch", strings.Join(notable, "\n"))
+				}
+			}
+		}
+		prev = cur
+		first = false
+
+		select {
+		case <-sigChan:
+			if isTTY {
+				fmt.Println("\nStopped.")
+			}
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// renderPolecatWatch builds one frame of the watch display. The frame is
+// written at once so the screen never shows blank between redraws.
+func renderPolecatWatch(rigName string, items []PolecatListItem, changes []string, isTTY bool) []byte {
+	var buf bytes.Buffer
+	if isTTY {
+		buf.WriteString("\033[H\033[2J") // ANSI: cursor home + clear screen
+	}
+
+	header := fmt.Sprintf("[%s] gt polecat watch %s (every %s, Ctrl+C to stop)",
+		time.Now().Format("15:04:05"), rigName, polecatWatchInterval)
+	if isTTY {
+		header = style.Dim.Render(header)
+	}
+	fmt.Fprintf(&buf, "%s\n\n", header)
+
+	if len(items) == 0 {
+		buf.WriteString("No polecats.\n")
+	} else {
+		table := style.NewTable(
+			style.Column{Name: "", Width: 1},
+			style.Column{Name: "POLECAT", Width: 24},
+			style.Column{Name: "STATE", Width: 8},
+			style.Column{Name: "ISSUE", Width: 12},
+			style.Column{Name: "BRANCH", Width: 32},
+		)
+		for _, p := range items {
+			sessionStatus := style.Dim.Render("○")
+			if p.SessionRunning {
+				sessionStatus = style.Success.Render("●")
+			}
+			table.AddRow(sessionStatus, p.Name, renderPolecatState(p.displayState()), p.Issue, p.Branch)
+		}
+		buf.WriteString(table.Render())
+	}
+
+	if len(changes) > 0 {
+		fmt.Fprintf(&buf, "\n%s\n", style.Bold.Render("Changes"))
+		for _, c := range changes {
+			fmt.Fprintf(&buf, "  %s\n", c)
+		}
+	}
+	return buf.Bytes()
+}
+
+// polecatStateTransitions describes each difference in displayed state
+// between two polls: "name: old → new", with "(new)" and "(gone)" for
+// polecats that appeared or disappeared. Returns nil on the first poll.
+func polecatStateTransitions(prev, cur []PolecatListItem) []string {
+	if prev == nil {
+		return nil
+	}
+
+	before := make(map[string]polecat.State, len(prev))
+	for _, p := range prev {
+		before[p.Name] = p.displayState()
+	}
+
+	var changes []string
+	seen := make(map[string]bool, len(cur))
+	for _, p := range cur {
+		seen[p.Name] = true
+		state := p.displayState()
+		was, ok := before[p.Name]
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("%s: (new) → %s", p.Name, state))
+		case was != state:
+			changes = append(changes, fmt.Sprintf("%s: %s → %s", p.Name, was, state))
+		}
+	}
+	for _, p := range prev {
+		if !seen[p.Name] {
+			changes = append(changes, fmt.Sprintf("%s: %s → (gone)", p.Name, before[p.Name]))
+		}
+	}
+	return changes
+}
+
+// notablePolecatTransitions returns the polecats that became done or
+// conflicted since the previous poll, for desktop notifications.
+func notablePolecatTransitions(prev, cur []PolecatListItem) []string {
+	if prev == nil {
+		return nil
+	}
+
+	before := make(map[string]polecat.State, len(prev))
+	for _, p := range prev {
+		before[p.Name] = p.displayState()
+	}
+
+	var notable []string
+	for _, p := range cur {
+		state := p.displayState()
+		if state != polecat.StateDone && state != polecat.StateConflict {
+			continue
+		}
+		if was, ok := before[p.Name]; ok && was != state {
+			notable = append(notable, fmt.Sprintf("%s/%s is %s", p.Rig, p.Name, state))
+		}
+	}
+	return notable
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestPolecatStateTransitions(t *testing.T) {
+	prev := []PolecatListItem{
+		{Rig: "gastown", Name: "alpha", State: polecat.StateWorking, SessionRunning: true},
+		{Rig: "gastown", Name: "beta", State: polecat.StateWorking, SessionRunning: true},
+		{Rig: "gastown", Name: "gamma", State: polecat.StateWorking, SessionRunning: true},
+	}
+	cur := []PolecatListItem{
+		{Rig: "gastown", Name: "alpha", State: polecat.StateWorking, SessionRunning: true},
+		{Rig: "gastown", Name: "beta", State: polecat.StateWorking}, // session gone: shown as done
+		{Rig: "gastown", Name: "delta", State: polecat.StateWorking, SessionRunning: true},
+	}
+
+	if got := polecatStateTransitions(nil, cur); got != nil {
+		t.Errorf("transitions on first poll = %v, want nil", got)
+	}
+
+	want := []string{
+		"beta: working → done",
+		"delta: (new) → working",
+		"gamma: working → (gone)",
+	}
+	if got := polecatStateTransitions(prev, cur); !reflect.DeepEqual(got, want) {
+		t.Errorf("transitions = %v, want %v", got, want)
+	}
+
+	wantNotable := []string{"gastown/beta is done"}
+	if got := notablePolecatTransitions(prev, cur); !reflect.DeepEqual(got, wantNotable) {
+		t.Errorf("notable = %v, want %v", got, wantNotable)
+	}
+	if got := notablePolecatTransitions(cur, cur); got != nil {
+		t.Errorf("notable with no changes = %v, want nil", got)
+	}
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/spf13/cobra"
@@ -53,7 +54,9 @@ func runPruneBranches(cmd *cobra.Command, args []string) error {
 	}
 
 	// Run fetch --prune first to clean up stale remote tracking refs
-	if err := g.FetchPrune("origin"); err != nil {
+	fetchCtx, cancel := context.WithTimeout(context.Background(), gitpkg.DefaultFetchTimeout)
+	defer cancel()
+	if err := g.FetchPrune(fetchCtx, "origin"); err != nil {
 		// Non-fatal: we can still prune based on current state
 		fmt.Printf("%s Warning: git fetch --prune failed: %v\n", style.Warning.Render("⚠"), err)
 	}
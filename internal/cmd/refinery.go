@@ -284,7 +284,7 @@ func getRefineryManager(rigName string) (*refinery.Manager, *rig.Rig, string, er
 		}
 	}
 
-	_, r, err := getRig(rigName)
+	_, r, err := getValidRig(rigName)
 	if err != nil {
 		return nil, nil, "", err
 	}
@@ -579,7 +579,7 @@ func runRefineryClaim(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("could not determine rig: %w", err)
 	}
 
-	_, r, err := getRig(rigName)
+	_, r, err := getValidRig(rigName)
 	if err != nil {
 		return err
 	}
@@ -606,7 +606,7 @@ func runRefineryRelease(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("could not determine rig: %w", err)
 	}
 
-	_, r, err := getRig(rigName)
+	_, r, err := getValidRig(rigName)
 	if err != nil {
 		return err
 	}
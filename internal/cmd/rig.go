@@ -224,6 +224,9 @@ If no rig is specified, infers the rig from the current directory.
 
 Displays:
 - Rig information (name, path, beads prefix)
+- Health summary: working/done/nuked polecat counts, pending merges (done
+  polecats), last git fetch, worktrees with uncommitted changes, and any
+  failing rig doctor checks
 - Witness status (running/stopped, uptime)
 - Refinery status (running/stopped, uptime, queue size)
 - Polecats (name, state, assigned issue, session status)
@@ -540,6 +543,9 @@ func runRigAdd(cmd *cobra.Command, args []string) error {
 	if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
 		return fmt.Errorf("saving rigs config: %w", err)
 	}
+	if err := session.SaveRegistry(townRoot); err != nil {
+		fmt.Printf("  %s Could not save prefix registry: %v\n", style.Warning.Render("!"), err)
+	}
 
 	// Add new rig to daemon.json patrol config (witness + refinery rigs arrays)
 	if err := config.AddRigToDaemonPatrols(townRoot, name); err != nil {
@@ -684,6 +690,7 @@ func runRigList(cmd *cobra.Command, args []string) error {
 		Witness  string `json:"witness"`
 		Refinery string `json:"refinery"`
 		Polecats int    `json:"polecats"`
+		Active   int    `json:"polecats_active"` // Polecats in the working state
 		Crew     int    `json:"crew"`
 		// sorting fields (not exported to JSON)
 		sortPrio int
@@ -715,12 +722,18 @@ func runRigList(cmd *cobra.Command, args []string) error {
 		}
 
 		summary := r.Summary()
+		active, total, err := r.PolecatCount()
+		if err != nil {
+			// Fall back to counting polecat directories
+			active, total = 0, summary.PolecatCount
+		}
 		rigs = append(rigs, rigInfo{
 			Name:     name,
 			Status:   strings.ToLower(opState),
 			Witness:  witnessStatus,
 			Refinery: refineryStatus,
-			Polecats: summary.PolecatCount,
+			Polecats: total,
+			Active:   active,
 			Crew:     summary.CrewCount,
 			sortPrio: rigStatePriority(witnessRunning, refineryRunning, opState),
 		})
@@ -767,7 +780,7 @@ func runRigList(cmd *cobra.Command, args []string) error {
 
 		fmt.Printf("   Witness: %s %s  Refinery: %s %s\n",
 			witnessIcon, ri.Witness, refineryIcon, ri.Refinery)
-		fmt.Printf("   Polecats: %d  Crew: %d\n", ri.Polecats, ri.Crew)
+		fmt.Printf("   Polecats: %d (%d working)  Crew: %d\n", ri.Polecats, ri.Active, ri.Crew)
 		fmt.Println()
 	}
 
@@ -848,6 +861,9 @@ func runRigRemove(cmd *cobra.Command, args []string) error {
 	if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
 		return fmt.Errorf("saving rigs config: %w", err)
 	}
+	if err := session.SaveRegistry(townRoot); err != nil {
+		fmt.Printf("  %s Could not save prefix registry: %v\n", style.Warning.Render("!"), err)
+	}
 
 	// Remove route from routes.jsonl (issue #899)
 	if beadsPrefix != "" {
@@ -916,6 +932,9 @@ func runRigAdopt(_ *cobra.Command, args []string) error {
 	if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
 		return fmt.Errorf("saving rigs config: %w", err)
 	}
+	if err := session.SaveRegistry(townRoot); err != nil {
+		fmt.Printf("  %s Could not save prefix registry: %v\n", style.Warning.Render("!"), err)
+	}
 
 	// Add adopted rig to daemon.json patrol config (witness + refinery rigs arrays)
 	if err := config.AddRigToDaemonPatrols(townRoot, name); err != nil {
@@ -1613,6 +1632,25 @@ func runRigStatus(cmd *cobra.Command, args []string) error {
 	}
 	fmt.Println()
 
+	polecatGit := git.NewGit(r.Path)
+	polecatMgr := polecat.NewManager(r, polecatGit, t)
+	polecats, polecatErr := polecatMgr.List()
+	polecatStates := make(map[string]polecat.State, len(polecats))
+	polecatSessions := make(map[string]bool, len(polecats))
+	for _, p := range polecats {
+		sessionName := session.PolecatSessionName(session.PrefixFor(rigName), p.Name)
+		hasSession, _ := t.HasSession(sessionName)
+		polecatSessions[p.Name] = hasSession
+		polecatStates[p.Name] = PolecatListItem{State: p.State, SessionRunning: hasSession}.displayState()
+	}
+
+	crewMgr := crew.NewManager(r, git.NewGit(townRoot))
+	crewWorkers, crewErr := crewMgr.List()
+
+	// Health summary
+	printRigHealth(collectRigHealth(townRoot, r, polecats, polecatStates, crewWorkers))
+	fmt.Println()
+
 	// Witness status
 	fmt.Printf("%s\n", style.Bold.Render("Witness"))
 	witMgr := witness.NewManager(r)
@@ -1641,33 +1679,20 @@ func runRigStatus(cmd *cobra.Command, args []string) error {
 	fmt.Println()
 
 	// Polecats
-	polecatGit := git.NewGit(r.Path)
-	polecatMgr := polecat.NewManager(r, polecatGit, t)
-	polecats, err := polecatMgr.List()
 	fmt.Printf("%s", style.Bold.Render("Polecats"))
-	if err != nil || len(polecats) == 0 {
+	if polecatErr != nil || len(polecats) == 0 {
 		fmt.Printf(" (none)\n")
 	} else {
 		fmt.Printf(" (%d)\n", len(polecats))
 		for _, p := range polecats {
-			sessionName := session.PolecatSessionName(session.PrefixFor(rigName), p.Name)
-			hasSession, _ := t.HasSession(sessionName)
-
 			sessionIcon := style.Dim.Render("○")
-			if hasSession {
+			if polecatSessions[p.Name] {
 				sessionIcon = style.Success.Render("●")
 			}
 
-			// Reconcile display state with tmux session liveness.
-			// Per gt-zecmc design: tmux is ground truth for observable states.
-			// If session is running but beads says done, the polecat is still alive.
-			// If session is dead but beads says working, the polecat is actually done.
-			displayState := p.State
-			if hasSession && displayState == polecat.StateDone {
-				displayState = polecat.StateWorking
-			} else if !hasSession && displayState.IsActive() {
-				displayState = polecat.StateDone
-			}
+			// Display state is reconciled with tmux session liveness
+			// (per gt-zecmc design: tmux is ground truth for observable states).
+			displayState := polecatStates[p.Name]
 
 			stateStr := string(displayState)
 			if p.Issue != "" {
@@ -1680,10 +1705,8 @@ func runRigStatus(cmd *cobra.Command, args []string) error {
 	fmt.Println()
 
 	// Crew
-	crewMgr := crew.NewManager(r, git.NewGit(townRoot))
-	crewWorkers, err := crewMgr.List()
 	fmt.Printf("%s", style.Bold.Render("Crew"))
-	if err != nil || len(crewWorkers) == 0 {
+	if crewErr != nil || len(crewWorkers) == 0 {
 		fmt.Printf(" (none)\n")
 	} else {
 		fmt.Printf(" (%d)\n", len(crewWorkers))
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// Clone command flags
+var (
+	rigClonePrefix string
+	rigCloneShared bool
+)
+
+var rigCloneCmd = &cobra.Command{
+	Use:   "clone <source-rig> <new-rig>",
+	Short: "Create a new rig for the same repository as an existing rig",
+	Long: `Create a new rig from the same repository as an existing rig.
+
+Useful for running parallel experiments on one codebase. The new rig is
+created exactly like 'gt rig add' would create it, using the source rig's
+git URL, push URL and default branch: it gets its own bare repo, fresh
+witness, refinery, mayor and crew directories, its own beads prefix, and
+no polecats.
+
+With --shared, the new bare repo borrows objects from the source rig's
+repository (git clone --reference), so only objects the source doesn't
+have are fetched and stored. The source rig's repository must then stay
+in place for as long as the clone exists.
+
+Examples:
+  gt rig clone gastown gastown-exp
+  gt rig clone gastown gastown-exp --shared --prefix gx`,
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: completeRigNames,
+	RunE:              runRigClone,
+}
+
+func init() {
+	rigCloneCmd.Flags().StringVar(&rigClonePrefix, "prefix", "", "Beads issue prefix for the new rig (default: derived from name)")
+	rigCloneCmd.Flags().BoolVar(&rigCloneShared, "shared", false, "Share git objects with the source rig's repository")
+
+	rigCmd.AddCommand(rigCloneCmd)
+}
+
+func runRigClone(cmd *cobra.Command, args []string) error {
+	sourceName, newName := args[0], args[1]
+
+	_, src, err := getRig(sourceName)
+	if err != nil {
+		return err
+	}
+	if src.GitURL == "" {
+		return fmt.Errorf("rig %s has no git URL to clone from", sourceName)
+	}
+
+	rigAddPrefix = rigClonePrefix
+	rigAddPushURL = src.PushURL
+	rigAddBranch = src.DefaultBranch()
+	rigAddLocalRepo = ""
+	rigAddAdopt = false
+	if rigCloneShared {
+		rigAddLocalRepo = rigCloneReference(src)
+	}
+
+	fmt.Printf("Cloning rig %s → %s\n", sourceName, newName)
+	return runRigAdd(cmd, []string{newName, src.GitURL})
+}
+
+// rigCloneReference returns the repository a --shared clone borrows objects
+// from: the source rig's shared bare repo, or mayor/rig for legacy rigs.
+func rigCloneReference(src *rig.Rig) string {
+	bareRepo := filepath.Join(src.Path, ".repo.git")
+	if info, err := os.Stat(bareRepo); err == nil && info.IsDir() {
+		return bareRepo
+	}
+	return filepath.Join(src.Path, "mayor", "rig")
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestRigCloneReference(t *testing.T) {
+	src := &rig.Rig{Name: "gastown", Path: t.TempDir()}
+
+	// Legacy rigs have no shared bare repo
+	if got, want := rigCloneReference(src), filepath.Join(src.Path, "mayor", "rig"); got != want {
+		t.Errorf("legacy rig reference = %q, want %q", got, want)
+	}
+
+	bareRepo := filepath.Join(src.Path, ".repo.git")
+	if err := os.MkdirAll(bareRepo, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if got := rigCloneReference(src); got != bareRepo {
+		t.Errorf("reference = %q, want the shared bare repo %q", got, bareRepo)
+	}
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var rigDoctorCmd = &cobra.Command{
+	Use:   "doctor <rig>",
+	Short: "Run health checks scoped to one rig",
+	Long: `Run gt doctor scoped to a single rig.
+
+Equivalent to 'gt doctor --rig <rig>': per-rig checks (worktrees, witness,
+bare repo, hooks, settings) only look at this rig's directories, the
+rig-specific checks are added, and town-wide checks still run.
+
+Examples:
+  gt rig doctor greenplace
+  gt rig doctor greenplace --fix
+  gt rig doctor greenplace --json`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeRigNames,
+	RunE:              runRigDoctor,
+}
+
+func init() {
+	addDoctorRunFlags(rigDoctorCmd)
+	rigCmd.AddCommand(rigDoctorCmd)
+}
+
+func runRigDoctor(cmd *cobra.Command, args []string) error {
+	doctorRig = args[0]
+	return runDoctor(cmd, nil)
+}
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+var rigFetchAll bool
+
+var rigFetchCmd = &cobra.Command{
+	Use:   "fetch [rig]",
+	Short: "Fetch remote changes into a rig's repository",
+	Long: `Run 'git fetch --prune origin' in a rig's shared repository.
+
+The fetch runs in the shared bare repo (.repo.git) that all polecat and
+refinery worktrees use, or in mayor/rig for legacy rigs, so every worktree
+sees the new remote-tracking refs.
+
+Afterwards it reports how many new commits arrived on the rig's base
+branch (origin/<default branch>) and lists working polecats whose branches
+are now behind it.
+
+Use --all to fetch every rig.
+
+Examples:
+  gt rig fetch greenplace
+  gt rig fetch --all`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeRigNames,
+	RunE:              runRigFetch,
+}
+
+func init() {
+	rigFetchCmd.Flags().BoolVar(&rigFetchAll, "all", false, "Fetch all rigs")
+
+	rigCmd.AddCommand(rigFetchCmd)
+}
+
+func runRigFetch(cmd *cobra.Command, args []string) error {
+	var rigs []*rig.Rig
+	switch {
+	case rigFetchAll && len(args) > 0:
+		return fmt.Errorf("cannot use --all with a rig name")
+	case rigFetchAll:
+		allRigs, _, err := getAllRigs()
+		if err != nil {
+			return err
+		}
+		rigs = allRigs
+	case len(args) == 1:
+		_, r, err := getRig(args[0])
+		if err != nil {
+			return err
+		}
+		rigs = []*rig.Rig{r}
+	default:
+		return fmt.Errorf("rig name required (or use --all)")
+	}
+
+	failed := 0
+	for _, r := range rigs {
+		if err := fetchRig(r); err != nil {
+			fmt.Printf("  %s %s: %v\n", style.Error.Render("✗"), r.Name, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+// fetchRig fetches the rig's shared repository, then reports new commits on
+// the base branch and working polecats that have fallen behind it.
+func fetchRig(r *rig.Rig) error {
+	g, err := r.RepoBase()
+	if err != nil {
+		return err
+	}
+	baseRef := polecatBaseRef(r, "")
+	before, _ := g.Rev(baseRef) // Absent before the first fetch
+
+	ctx, cancel := context.WithTimeout(context.Background(), git.DefaultFetchTimeout)
+	defer cancel()
+	if err := g.FetchPrune(ctx, "origin"); err != nil {
+		return fmt.Errorf("fetching origin: %w", err)
+	}
+
+	after, err := g.Rev(baseRef)
+	if err != nil {
+		return fmt.Errorf("resolving %s: %w", baseRef, err)
+	}
+	switch {
+	case before == after:
+		fmt.Printf("  %s %s: %s is up to date\n", style.Success.Render("✓"), r.Name, baseRef)
+	case before == "":
+		fmt.Printf("  %s %s: fetched %s at %s\n", style.Success.Render("✓"), r.Name, baseRef, after[:8])
+	default:
+		count, err := g.CommitsAhead(before, after)
+		if err != nil {
+			return fmt.Errorf("counting new commits: %w", err)
+		}
+		fmt.Printf("  %s %s: %d new commit(s) on %s (%s → %s)\n", style.Success.Render("✓"), r.Name,
+			count, baseRef, before[:8], after[:8])
+	}
+
+	mgr := polecat.NewManager(r, git.NewGit(r.Path), tmux.NewTmux())
+	polecats, err := mgr.List()
+	if err != nil {
+		return fmt.Errorf("listing polecats: %w", err)
+	}
+	for _, d := range behindPolecats(g, baseRef, polecats) {
+		fmt.Printf("    %s %s (%s) is %d commit(s) behind %s, %d ahead\n", style.Warning.Render("⚠"),
+			d.Name, d.Branch, d.Behind, baseRef, d.Ahead)
+	}
+	return nil
+}
+
+// polecatDivergence is how far a polecat's branch is from the base branch.
+type polecatDivergence struct {
+	Name   string
+	Branch string
+	Ahead  int // Commits on the branch but not the base
+	Behind int // Commits on the base but not the branch
+}
+
+// behindPolecats returns the working polecats whose branches are missing
+// commits from baseRef. Branches that can't be compared are skipped.
+func behindPolecats(g *git.Git, baseRef string, polecats []*polecat.Polecat) []polecatDivergence {
+	var behind []polecatDivergence
+	for _, p := range polecats {
+		if p.State != polecat.StateWorking || p.Branch == "" {
+			continue
+		}
+		n, err := g.CommitsAhead(p.Branch, baseRef)
+		if err != nil || n == 0 {
+			continue
+		}
+		ahead, _ := g.CommitsAhead(baseRef, p.Branch)
+		behind = append(behind, polecatDivergence{Name: p.Name, Branch: p.Branch, Ahead: ahead, Behind: n})
+	}
+	return behind
+}
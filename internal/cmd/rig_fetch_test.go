@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestBehindPolecats(t *testing.T) {
+	repo := t.TempDir()
+	runGit := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repo
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@test.com",
+			"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@test.com")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	runGit("init", "-b", "main")
+	runGit("commit", "--allow-empty", "-m", "init")
+	runGit("branch", "polecat/current")
+	runGit("branch", "polecat/idle")
+	runGit("checkout", "-b", "polecat/stale")
+	runGit("commit", "--allow-empty", "-m", "polecat work")
+	runGit("checkout", "main")
+	runGit("commit", "--allow-empty", "-m", "upstream 1")
+	runGit("commit", "--allow-empty", "-m", "upstream 2")
+	runGit("branch", "-f", "polecat/current", "main")
+
+	polecats := []*polecat.Polecat{
+		{Name: "current", State: polecat.StateWorking, Branch: "polecat/current"},
+		{Name: "stale", State: polecat.StateWorking, Branch: "polecat/stale"},
+		{Name: "idle", State: polecat.StateDone, Branch: "polecat/idle"},
+		{Name: "missing", State: polecat.StateWorking, Branch: "polecat/missing"},
+	}
+	got := behindPolecats(git.NewGit(repo), "main", polecats)
+	want := []polecatDivergence{{Name: "stale", Branch: "polecat/stale", Ahead: 1, Behind: 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("behindPolecats = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// GC command flags
+var (
+	rigGCAggressive bool
+	rigGCAll        bool
+)
+
+var rigGCCmd = &cobra.Command{
+	Use:   "gc [rig]",
+	Short: "Run git garbage collection on a rig's repository",
+	Long: `Run 'git gc' on a rig's shared repository.
+
+Polecat branches are created and deleted constantly, so the rig's
+repository accumulates loose objects. This packs them and prunes
+unreachable ones. It runs in the shared bare repo (.repo.git) that all
+polecat and refinery worktrees use, or in mayor/rig for legacy rigs.
+
+The pack size and loose object count are shown before and after.
+
+Use --aggressive for 'git gc --aggressive' (much slower, tighter packs)
+and --all to gc every rig.
+
+Examples:
+  gt rig gc greenplace
+  gt rig gc greenplace --aggressive
+  gt rig gc --all`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeRigNames,
+	RunE:              runRigGC,
+}
+
+func init() {
+	rigGCCmd.Flags().BoolVar(&rigGCAggressive, "aggressive", false, "Run 'git gc --aggressive'")
+	rigGCCmd.Flags().BoolVar(&rigGCAll, "all", false, "Run gc on all rigs")
+
+	rigCmd.AddCommand(rigGCCmd)
+}
+
+func runRigGC(cmd *cobra.Command, args []string) error {
+	var rigs []*rig.Rig
+	switch {
+	case rigGCAll && len(args) > 0:
+		return fmt.Errorf("cannot use --all with a rig name")
+	case rigGCAll:
+		allRigs, _, err := getAllRigs()
+		if err != nil {
+			return err
+		}
+		rigs = allRigs
+	case len(args) == 1:
+		_, r, err := getRig(args[0])
+		if err != nil {
+			return err
+		}
+		rigs = []*rig.Rig{r}
+	default:
+		return fmt.Errorf("rig name required (or use --all)")
+	}
+
+	failed := 0
+	for _, r := range rigs {
+		if err := gcRig(r); err != nil {
+			fmt.Printf("  %s %s: %v\n", style.Error.Render("✗"), r.Name, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+// gcRig runs git gc on the rig's shared repository and prints the pack
+// size before and after.
+func gcRig(r *rig.Rig) error {
+	g, err := r.RepoBase()
+	if err != nil {
+		return err
+	}
+	before, err := g.CountObjects()
+	if err != nil {
+		return fmt.Errorf("counting objects: %w", err)
+	}
+	if err := g.GC(rigGCAggressive); err != nil {
+		return fmt.Errorf("git gc: %w", err)
+	}
+	after, err := g.CountObjects()
+	if err != nil {
+		return fmt.Errorf("counting objects: %w", err)
+	}
+
+	fmt.Printf("  %s %s: packs %s → %s, loose objects %d → %d\n",
+		style.Success.Render("✓"), r.Name,
+		formatBytes(before.PackKiB*1024), formatBytes(after.PackKiB*1024),
+		before.LooseObjects, after.LooseObjects)
+	return nil
+}
@@ -59,3 +59,17 @@ func getRig(rigName string) (string, *rig.Rig, error) {
 
 	return townRoot, r, nil
 }
+
+// getValidRig is getRig for commands that work inside the rig's directory
+// structure: it also fails, listing the problems, if the rig doesn't pass
+// rig.Validate.
+func getValidRig(rigName string) (string, *rig.Rig, error) {
+	townRoot, r, err := getRig(rigName)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := r.Validate(); err != nil {
+		return "", nil, fmt.Errorf("%w\nRun 'gt doctor --rig %s' to diagnose", err, rigName)
+	}
+	return townRoot, r, nil
+}
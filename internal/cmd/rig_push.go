@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// Push command flags
+var (
+	rigPushDryRun         bool
+	rigPushForceWithLease bool
+)
+
+var rigPushCmd = &cobra.Command{
+	Use:   "push <rig>",
+	Short: "Push a rig's default branch and working polecat branches to origin",
+	Long: `Push a rig's default branch, and the branch of every working polecat,
+to origin.
+
+Branches are pushed from the rig's shared repository (.repo.git, or
+mayor/rig for legacy rigs), one at a time. A failed push doesn't stop the
+others; failures are listed at the end and the command exits non-zero.
+
+Branches are compared with origin as of the last fetch ('gt rig fetch');
+those with no commits of their own are skipped. Use --dry-run to list the
+branches and how far each is ahead of origin without pushing.
+
+Use --force-with-lease to push rewritten branches (e.g. after
+'gt polecat rebase'); a branch is still refused if origin has moved since
+the last fetch.
+
+Examples:
+  gt rig push greenplace
+  gt rig push greenplace --dry-run
+  gt rig push greenplace --force-with-lease`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeRigNames,
+	RunE:              runRigPush,
+}
+
+func init() {
+	rigPushCmd.Flags().BoolVar(&rigPushDryRun, "dry-run", false, "Show what would be pushed without pushing")
+	rigPushCmd.Flags().BoolVar(&rigPushForceWithLease, "force-with-lease", false, "Force-push, unless origin has moved since the last fetch")
+
+	rigCmd.AddCommand(rigPushCmd)
+}
+
+func runRigPush(cmd *cobra.Command, args []string) error {
+	_, r, err := getRig(args[0])
+	if err != nil {
+		return err
+	}
+	g, err := r.RepoBase()
+	if err != nil {
+		return err
+	}
+	mgr := polecat.NewManager(r, git.NewGit(r.Path), tmux.NewTmux())
+	polecats, err := mgr.List()
+	if err != nil {
+		return fmt.Errorf("listing polecats: %w", err)
+	}
+
+	var failures []string
+	for _, branch := range rigPushBranches(r.DefaultBranch(), polecats) {
+		status, needsPush := pushStatus(g, branch)
+		if !needsPush {
+			fmt.Printf("  %s %s (%s)\n", style.Dim.Render("○"), branch, status)
+			continue
+		}
+		if rigPushDryRun {
+			fmt.Printf("  %s Would push %s (%s)\n", style.Dim.Render("○"), branch, status)
+			continue
+		}
+		var err error
+		if rigPushForceWithLease {
+			err = g.PushWithLease("origin", branch)
+		} else {
+			err = g.Push("origin", branch, false)
+		}
+		if err != nil {
+			fmt.Printf("  %s %s (%s)\n", style.Error.Render("✗"), branch, status)
+			failures = append(failures, fmt.Sprintf("%s: %v", branch, err))
+			continue
+		}
+		fmt.Printf("  %s Pushed %s (%s)\n", style.Success.Render("✓"), branch, status)
+	}
+
+	if len(failures) > 0 {
+		fmt.Printf("\n%s %d branch(es) failed to push:\n", style.Error.Render("✗"), len(failures))
+		for _, f := range failures {
+			fmt.Printf("  %s\n", f)
+		}
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+// rigPushBranches returns the branches gt rig push pushes: the default
+// branch first, then each working polecat's branch.
+func rigPushBranches(defaultBranch string, polecats []*polecat.Polecat) []string {
+	branches := []string{defaultBranch}
+	seen := map[string]bool{defaultBranch: true}
+	for _, p := range polecats {
+		if p.State != polecat.StateWorking || p.Branch == "" || seen[p.Branch] {
+			continue
+		}
+		seen[p.Branch] = true
+		branches = append(branches, p.Branch)
+	}
+	return branches
+}
+
+// pushStatus describes how a local branch compares with its copy on origin,
+// as of the last fetch, and reports whether it has anything to push.
+// Branches with no commits of their own are never pushed, so a branch that
+// is only behind origin can't rewind it, even with --force-with-lease.
+func pushStatus(g *git.Git, branch string) (string, bool) {
+	remote := "origin/" + branch
+	if _, err := g.Rev(remote); err != nil {
+		return "new branch", true
+	}
+	ahead, err := g.CommitsAhead(remote, branch)
+	if err != nil {
+		return "can't compare with " + remote, true
+	}
+	behind, _ := g.CommitsAhead(branch, remote)
+	switch {
+	case ahead == 0 && behind == 0:
+		return "up to date", false
+	case ahead == 0:
+		return fmt.Sprintf("%d commit(s) behind %s, nothing to push", behind, remote), false
+	case behind == 0:
+		return fmt.Sprintf("%d commit(s) ahead", ahead), true
+	default:
+		return fmt.Sprintf("%d ahead, %d behind %s", ahead, behind, remote), true
+	}
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+func TestRigPushBranches(t *testing.T) {
+	polecats := []*polecat.Polecat{
+		{Name: "toast", State: polecat.StateWorking, Branch: "polecat/toast"},
+		{Name: "nux", State: polecat.StateDone, Branch: "polecat/nux"},
+		{Name: "rictus", State: polecat.StateWorking},
+		{Name: "slit", State: polecat.StateWorking, Branch: "polecat/toast"},
+	}
+	got := rigPushBranches("main", polecats)
+	want := []string{"main", "polecat/toast"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rigPushBranches = %v, want %v", got, want)
+	}
+}
+
+func TestPushStatus(t *testing.T) {
+	tmp := t.TempDir()
+	remote := filepath.Join(tmp, "remote.git")
+	repo := filepath.Join(tmp, "repo")
+	runGit := func(dir string, args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@test.com",
+			"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@test.com")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	runGit(tmp, "init", "--bare", remote)
+	runGit(tmp, "init", "-b", "main", repo)
+	runGit(repo, "remote", "add", "origin", remote)
+	runGit(repo, "commit", "--allow-empty", "-m", "init")
+	runGit(repo, "branch", "polecat/behind")
+	runGit(repo, "branch", "polecat/ahead")
+	runGit(repo, "push", "origin", "main", "polecat/behind", "polecat/ahead")
+	runGit(repo, "branch", "polecat/new")
+
+	runGit(repo, "checkout", "polecat/behind")
+	runGit(repo, "commit", "--allow-empty", "-m", "pushed")
+	runGit(repo, "push", "origin", "polecat/behind")
+	runGit(repo, "reset", "--hard", "HEAD~1")
+	runGit(repo, "checkout", "polecat/ahead")
+	runGit(repo, "commit", "--allow-empty", "-m", "unpushed")
+
+	g := git.NewGit(repo)
+	tests := []struct {
+		branch    string
+		wantPush  bool
+		wantState string
+	}{
+		{"main", false, "up to date"},
+		{"polecat/ahead", true, "1 commit(s) ahead"},
+		{"polecat/behind", false, "1 commit(s) behind origin/polecat/behind, nothing to push"},
+		{"polecat/new", true, "new branch"},
+	}
+	for _, tt := range tests {
+		status, push := pushStatus(g, tt.branch)
+		if status != tt.wantState || push != tt.wantPush {
+			t.Errorf("pushStatus(%s) = %q, %v; want %q, %v", tt.branch, status, push, tt.wantState, tt.wantPush)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var rigRenameCmd = &cobra.Command{
+	Use:   "rename <old> <new>",
+	Short: "Rename a rig",
+	Long: `Rename a rig and everything that refers to it by name or path.
+
+The rename:
+  - Moves the rig directory (a single atomic rename)
+  - Re-links polecat and crew git worktrees to their new paths
+  - Updates the name in the rig's config.json
+  - Re-keys the rig in mayor/rigs.json (the prefix registry source)
+  - Updates beads routes that point into the rig
+  - Migrates the rig's agent beads (witness, refinery, crew, polecats),
+    whose IDs embed the rig name: each is recreated under the new name
+    with its fields, and the old one is closed with "renamed to <id>"
+
+Tmux session names are derived from the rig's beads prefix, which is
+unchanged, so they keep their names; polecat branches (polecat/<name>-...)
+and the state under the rig directory don't include the rig name either.
+Sessions must be stopped first because their working directories move.
+
+If any step before the agent beads fails, the completed steps are rolled
+back. Agent beads that can't be migrated are listed, and the command exits
+non-zero; the rename itself is kept.
+
+Examples:
+  gt rig shutdown myproject
+  gt rig rename myproject my_project`,
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: completeRigNames,
+	RunE:              runRigRename,
+}
+
+func init() {
+	rigCmd.AddCommand(rigRenameCmd)
+}
+
+func runRigRename(cmd *cobra.Command, args []string) error {
+	oldName, newName := args[0], args[1]
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+
+	rigsPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	rigsConfig, err := config.LoadRigsConfig(rigsPath)
+	if err != nil {
+		return fmt.Errorf("loading rigs config: %w", err)
+	}
+
+	mgr := rig.NewManager(townRoot, rigsConfig, git.NewGit(townRoot))
+	if !mgr.RigExists(oldName) {
+		return fmt.Errorf("rig '%s' not found", oldName)
+	}
+
+	// Running sessions would be left in a directory that no longer exists
+	sessions, err := findRigSessions(tmux.NewTmux(), oldName)
+	if err != nil {
+		return fmt.Errorf("could not verify session state for rig %s: %w", oldName, err)
+	}
+	if len(sessions) > 0 {
+		fmt.Printf("%s Rig %s has %d running tmux session(s):\n",
+			style.Warning.Render("⚠"), oldName, len(sessions))
+		for _, s := range sessions {
+			fmt.Printf("  - %s\n", s)
+		}
+		fmt.Printf("\nShut them down first:\n")
+		fmt.Printf("  %s\n", style.Dim.Render(fmt.Sprintf("gt rig shutdown %s", oldName)))
+		return fmt.Errorf("refusing to rename rig with running sessions")
+	}
+
+	if err := mgr.RenameRig(oldName, newName); err != nil {
+		return fmt.Errorf("renaming rig: %w", err)
+	}
+	fmt.Printf("  Moved %s → %s\n", filepath.Join(townRoot, oldName), filepath.Join(townRoot, newName))
+
+	// From here on, undo the rename (and restore rigs.json) if a later step fails
+	rollback := func(cause error) error {
+		if err := mgr.RenameRig(newName, oldName); err != nil {
+			return fmt.Errorf("%w (rollback failed: %v; rig left at %s)", cause, err, filepath.Join(townRoot, newName))
+		}
+		if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
+			return fmt.Errorf("%w (rollback failed saving rigs config: %v)", cause, err)
+		}
+		return fmt.Errorf("%w (rolled back)", cause)
+	}
+
+	if err := config.SaveRigsConfig(rigsPath, rigsConfig); err != nil {
+		return rollback(fmt.Errorf("saving rigs config: %w", err))
+	}
+
+	if err := renameRigRoutes(townRoot, oldName, newName); err != nil {
+		return rollback(fmt.Errorf("updating beads routes: %w", err))
+	}
+	if err := session.SaveRegistry(townRoot); err != nil {
+		fmt.Printf("  %s Could not save prefix registry: %v\n", style.Warning.Render("!"), err)
+	}
+
+	failures := renameRigAgentBeads(beads.New(filepath.Join(townRoot, newName)), oldName, newName)
+
+	fmt.Printf("%s Rig %s renamed to %s\n", style.Success.Render("✓"), oldName, newName)
+	if len(failures) > 0 {
+		fmt.Printf("\n%s %d agent bead(s) could not be migrated:\n", style.Error.Render("✗"), len(failures))
+		for _, f := range failures {
+			fmt.Printf("  %s\n", f)
+		}
+		return NewSilentExit(1)
+	}
+	fmt.Printf("\nStart it again with: %s\n", style.Dim.Render(fmt.Sprintf("gt rig start %s", newName)))
+	return nil
+}
+
+// renameRigAgentBeads recreates each open agent bead of the old rig under
+// its ID for the new rig name, with the same fields, and closes the old
+// one. It returns a description of each bead it couldn't migrate.
+func renameRigAgentBeads(bd *beads.Beads, oldName, newName string) []string {
+	agents, err := bd.ListAgentBeads()
+	if err != nil {
+		return []string{fmt.Sprintf("listing agent beads: %v", err)}
+	}
+	ids := make([]string, 0, len(agents))
+	for id := range agents {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var failures []string
+	for _, oldID := range ids {
+		newID, ok := renamedAgentBeadID(oldID, oldName, newName)
+		if !ok || agents[oldID].Status == "closed" {
+			continue
+		}
+		issue, fields, err := bd.GetAgentBead(oldID)
+		if err != nil || issue == nil {
+			failures = append(failures, fmt.Sprintf("%s: reading bead: %v", oldID, err))
+			continue
+		}
+		fields.Rig = newName
+		title := strings.ReplaceAll(issue.Title, oldName, newName)
+		if _, err := bd.CreateOrReopenAgentBead(newID, title, fields); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: creating %s: %v", oldID, newID, err))
+			continue
+		}
+		if err := bd.CloseWithReason(fmt.Sprintf("renamed to %s", newID), oldID); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: closing after creating %s: %v", oldID, newID, err))
+			continue
+		}
+		fmt.Printf("  Migrated agent bead %s → %s\n", oldID, newID)
+	}
+	return failures
+}
+
+// renamedAgentBeadID returns the ID an agent bead of rig oldName has once
+// the rig is renamed to newName, keeping its prefix, role and name. It
+// reports false for beads that don't belong to oldName.
+func renamedAgentBeadID(id, oldName, newName string) (string, bool) {
+	rigName, role, name, ok := beads.ParseAgentBeadID(id)
+	if !ok || rigName != oldName {
+		return "", false
+	}
+	prefix := id[:strings.Index(id, "-")]
+	return beads.AgentBeadIDWithPrefix(prefix, newName, role, name), true
+}
+
+// renameRigRoutes rewrites beads routes whose path points into the old rig directory.
+func renameRigRoutes(townRoot, oldName, newName string) error {
+	beadsDir := filepath.Join(townRoot, ".beads")
+	routes, err := beads.LoadRoutes(beadsDir)
+	if err != nil {
+		return fmt.Errorf("loading routes: %w", err)
+	}
+
+	changed := false
+	for i, r := range routes {
+		if r.Path == oldName || strings.HasPrefix(r.Path, oldName+"/") {
+			routes[i].Path = newName + strings.TrimPrefix(r.Path, oldName)
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return beads.WriteRoutes(beadsDir, routes)
+}
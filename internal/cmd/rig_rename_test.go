@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestRenameRigRoutes(t *testing.T) {
+	townRoot := t.TempDir()
+	beadsDir := filepath.Join(townRoot, ".beads")
+
+	if err := beads.WriteRoutes(beadsDir, []beads.Route{
+		{Prefix: "hq-", Path: "."},
+		{Prefix: "mp-", Path: "myproject/mayor/rig"},
+		{Prefix: "mq-", Path: "myproject_two"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := renameRigRoutes(townRoot, "myproject", "my_project"); err != nil {
+		t.Fatalf("renameRigRoutes: %v", err)
+	}
+
+	routes, err := beads.LoadRoutes(beadsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"hq-": ".",
+		"mp-": "my_project/mayor/rig",
+		"mq-": "myproject_two", // shares a name prefix but is a different rig
+	}
+	for _, r := range routes {
+		if want[r.Prefix] != r.Path {
+			t.Errorf("route %s path = %q, want %q", r.Prefix, r.Path, want[r.Prefix])
+		}
+	}
+}
+
+func TestRenamedAgentBeadID(t *testing.T) {
+	tests := []struct {
+		id     string
+		want   string
+		wantOK bool
+	}{
+		{"mp-myproject-witness", "mp-my_project-witness", true},
+		{"mp-myproject-refinery", "mp-my_project-refinery", true},
+		{"mp-myproject-polecat-Toast", "mp-my_project-polecat-Toast", true},
+		{"mp-myproject-crew-max", "mp-my_project-crew-max", true},
+		{"mp-otherrig-witness", "", false},
+		{"hq-mayor", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := renamedAgentBeadID(tt.id, "myproject", "my_project")
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("renamedAgentBeadID(%q) = %q, %v; want %q, %v", tt.id, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/crew"
+	"github.com/steveyegge/gastown/internal/doctor"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// rigHealth is the at-a-glance summary at the top of 'gt rig status'.
+type rigHealth struct {
+	Working int
+	Done    int // Done polecats are waiting on the merge queue
+	Nuked   int // -1 if agent beads could not be read
+	Other   int // stuck, conflict, zombie
+
+	LastFetch time.Time // Zero if the rig has never fetched
+	Dirty     int       // Polecat and crew worktrees with uncommitted changes
+
+	DoctorFailures []*doctor.CheckResult
+}
+
+// collectRigHealth gathers the health summary for a rig. states holds each
+// polecat's display state (already reconciled with tmux).
+func collectRigHealth(townRoot string, r *rig.Rig, polecats []*polecat.Polecat, states map[string]polecat.State, crewWorkers []*crew.CrewWorker) rigHealth {
+	var h rigHealth
+	for _, p := range polecats {
+		switch states[p.Name] {
+		case polecat.StateWorking:
+			h.Working++
+		case polecat.StateDone:
+			h.Done++
+		default:
+			h.Other++
+		}
+		if dirty, err := git.NewGit(p.ClonePath).HasUncommittedChanges(); err == nil && dirty {
+			h.Dirty++
+		}
+	}
+	for _, w := range crewWorkers {
+		if dirty, err := git.NewGit(w.ClonePath).HasUncommittedChanges(); err == nil && dirty {
+			h.Dirty++
+		}
+	}
+
+	h.Nuked = countNukedPolecats(r)
+	h.LastFetch = rigLastFetch(r.Path)
+
+	d := doctor.NewDoctor()
+	d.RegisterAll(doctor.RigChecks()...)
+	report := d.Run(&doctor.CheckContext{TownRoot: townRoot, RigName: r.Name})
+	for _, res := range report.Checks {
+		if res.Status != doctor.StatusOK {
+			h.DoctorFailures = append(h.DoctorFailures, res)
+		}
+	}
+	return h
+}
+
+// countNukedPolecats counts the rig's polecat agent beads in the nuked state:
+// identities whose sandbox is gone but which persist for the next assignment.
+// Returns -1 if the rig's beads can't be read.
+func countNukedPolecats(r *rig.Rig) int {
+	agents, err := beads.New(r.BeadsPath()).ListAgentBeads()
+	if err != nil {
+		return -1
+	}
+	nuked := 0
+	for id, issue := range agents {
+		rigName, role, _, ok := beads.ParseAgentBeadID(id)
+		if !ok || role != "polecat" || rigName != r.Name {
+			continue
+		}
+		if fields := beads.ParseAgentFields(issue.Description); fields != nil && fields.AgentState == "nuked" {
+			nuked++
+		}
+	}
+	return nuked
+}
+
+// rigLastFetch returns when the rig's shared repository last fetched, from
+// the FETCH_HEAD mtime in .repo.git (or mayor/rig for legacy rigs).
+// Returns the zero time if it has never fetched.
+func rigLastFetch(rigPath string) time.Time {
+	for _, p := range []string{
+		filepath.Join(rigPath, ".repo.git", "FETCH_HEAD"),
+		filepath.Join(rigPath, "mayor", "rig", ".git", "FETCH_HEAD"),
+	} {
+		if info, err := os.Stat(p); err == nil {
+			return info.ModTime()
+		}
+	}
+	return time.Time{}
+}
+
+// printRigHealth prints the health summary with color-coded indicators.
+func printRigHealth(h rigHealth) {
+	fmt.Printf("%s\n", style.Bold.Render("Health"))
+
+	nuked := "?"
+	if h.Nuked >= 0 {
+		nuked = fmt.Sprintf("%d", h.Nuked)
+	}
+	counts := fmt.Sprintf("%d working, %d done, %s nuked", h.Working, h.Done, nuked)
+	if h.Other > 0 {
+		counts += fmt.Sprintf(", %s", style.Warning.Render(fmt.Sprintf("%d need attention", h.Other)))
+	}
+	fmt.Printf("  Polecats:       %s\n", counts)
+
+	if h.Done > 0 {
+		fmt.Printf("  Pending merges: %s\n", style.Warning.Render(fmt.Sprintf("%d", h.Done)))
+	} else {
+		fmt.Printf("  Pending merges: %s\n", style.Dim.Render("0"))
+	}
+
+	switch {
+	case h.LastFetch.IsZero():
+		fmt.Printf("  Last fetch:     %s\n", style.Warning.Render("never"))
+	case time.Since(h.LastFetch) > 24*time.Hour:
+		fmt.Printf("  Last fetch:     %s\n", style.Warning.Render(formatAge(h.LastFetch)))
+	default:
+		fmt.Printf("  Last fetch:     %s\n", formatAge(h.LastFetch))
+	}
+
+	if h.Dirty > 0 {
+		fmt.Printf("  Uncommitted:    %s\n", style.Warning.Render(fmt.Sprintf("%d worktree(s)", h.Dirty)))
+	} else {
+		fmt.Printf("  Uncommitted:    %s\n", style.Dim.Render("none"))
+	}
+
+	if len(h.DoctorFailures) == 0 {
+		fmt.Printf("  Doctor:         %s\n", style.Success.Render("✓ rig checks pass"))
+		return
+	}
+	fmt.Printf("  Doctor:         %d check(s) failing (run 'gt rig doctor' for details)\n", len(h.DoctorFailures))
+	for _, res := range h.DoctorFailures {
+		icon := style.Warning.Render("⚠")
+		if res.Status == doctor.StatusError {
+			icon = style.Error.Render("✗")
+		}
+		fmt.Printf("    %s %s: %s\n", icon, res.Name, res.Message)
+	}
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRigLastFetch(t *testing.T) {
+	rigPath := t.TempDir()
+	if got := rigLastFetch(rigPath); !got.IsZero() {
+		t.Fatalf("rigLastFetch with no FETCH_HEAD = %v, want zero", got)
+	}
+
+	// Legacy rigs fetch in mayor/rig
+	legacy := filepath.Join(rigPath, "mayor", "rig", ".git", "FETCH_HEAD")
+	if err := os.MkdirAll(filepath.Dir(legacy), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(legacy, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-48 * time.Hour).Truncate(time.Second)
+	if err := os.Chtimes(legacy, old, old); err != nil {
+		t.Fatal(err)
+	}
+	if got := rigLastFetch(rigPath); !got.Equal(old) {
+		t.Errorf("rigLastFetch = %v, want %v", got, old)
+	}
+
+	// The shared bare repo takes precedence
+	bare := filepath.Join(rigPath, ".repo.git", "FETCH_HEAD")
+	if err := os.MkdirAll(filepath.Dir(bare), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(bare, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got := rigLastFetch(rigPath); got.Equal(old) {
+		t.Errorf("rigLastFetch = %v, want the .repo.git FETCH_HEAD time", got)
+	}
+}
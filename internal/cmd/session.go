@@ -31,6 +31,7 @@ var (
 	sessionFile      string
 	sessionRigFilter string
 	sessionListJSON  bool
+	sessionReadOnly  bool
 )
 
 var sessionCmd = &cobra.Command{
@@ -75,12 +76,26 @@ Use --force to skip graceful shutdown.`,
 }
 
 var sessionAtCmd = &cobra.Command{
-	Use:     "at <rig>/<polecat>",
+	Use:     "at <address>",
 	Aliases: []string{"attach"},
 	Short:   "Attach to a running session",
-	Long: `Attach to a running polecat session.
+	Long: `Attach to a running agent session.
 
-Attaches the current terminal to the tmux session. Detach with Ctrl-B D.`,
+The address is translated to its tmux session name using the rig's beads
+prefix, so you don't need to know the session naming scheme:
+
+  mayor, deacon                 hq-mayor, hq-deacon
+  <rig>/witness, <rig>/refinery <prefix>-witness, <prefix>-refinery
+  <rig>/crew/<name>             <prefix>-crew-<name>
+  <rig>/<polecat>               <prefix>-<polecat>
+
+Attaches the current terminal to the tmux session. Detach with Ctrl-B D.
+Use -r to attach read-only (watch without sending keystrokes).
+
+Examples:
+  gt session attach gastown/Toast
+  gt session attach gastown/crew/max -r
+  gt session attach mayor`,
 	Args: cobra.ExactArgs(1),
 	RunE: runSessionAttach,
 }
@@ -90,7 +105,18 @@ var sessionListCmd = &cobra.Command{
 	Short: "List all sessions",
 	Long: `List all running polecat sessions.
 
-Shows session status, rig, and polecat name. Use --rig to filter by rig.`,
+Shows session status, rig, and polecat name. Use --rig to filter by rig.
+
+--json prints an array of objects with these fields, for scripting:
+  rig, polecat, session_id, running
+  address      Agent address, e.g. gastown/witness or gastown/polecats/Toast
+  agent_type   polecat, witness, refinery or crew
+  agent_name   Crew or polecat name (empty for witness and refinery)
+  created_at   When the tmux session was created (RFC 3339, UTC)
+
+Example: the witnesses that have been running for over an hour
+  gt session list --json | jq -r '.[] | select(.agent_type == "witness")
+    | select(.created_at | fromdate < now - 3600) | .address'`,
 	RunE: runSessionList,
 }
 
@@ -174,6 +200,9 @@ func init() {
 	// Stop flags
 	sessionStopCmd.Flags().BoolVarP(&sessionForce, "force", "f", false, "Force immediate shutdown")
 
+	// Attach flags
+	sessionAtCmd.Flags().BoolVarP(&sessionReadOnly, "read-only", "r", false, "Attach read-only")
+
 	// List flags
 	sessionListCmd.Flags().StringVar(&sessionRigFilter, "rig", "", "Filter by rig name")
 	sessionListCmd.Flags().BoolVar(&sessionListJSON, "json", false, "Output as JSON")
@@ -322,26 +351,73 @@ func runSessionStop(cmd *cobra.Command, args []string) error {
 }
 
 func runSessionAttach(cmd *cobra.Command, args []string) error {
-	rigName, polecatName, err := parseAddress(args[0])
+	address := args[0]
+	identity, err := session.ParseAddress(address)
 	if err != nil {
 		return err
 	}
+	sessionName := identity.SessionName()
 
-	polecatMgr, _, err := getSessionManager(rigName)
+	exists, err := tmux.NewTmux().HasSession(sessionName)
 	if err != nil {
-		return err
+		return fmt.Errorf("checking session: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("no running session for %s: looked for %q (%s)",
+			address, sessionName, sessionNamePattern(identity))
 	}
 
 	// Attach (this replaces the process)
-	return polecatMgr.Attach(polecatName)
+	return attachToTmuxSessionWithOptions(sessionName, sessionReadOnly)
+}
+
+// sessionNamePattern describes how an address maps to a session name,
+// for error messages when the session can't be found.
+func sessionNamePattern(identity *session.AgentIdentity) string {
+	var pattern string
+	switch identity.Role {
+	case session.RoleWitness:
+		pattern = "<prefix>-witness"
+	case session.RoleRefinery:
+		pattern = "<prefix>-refinery"
+	case session.RoleCrew:
+		pattern = "<prefix>-crew-<name>"
+	case session.RolePolecat:
+		pattern = "<prefix>-<name>"
+	default:
+		return "town-level session"
+	}
+	return fmt.Sprintf("pattern %s, prefix %q for rig %s", pattern, identity.Prefix, identity.Rig)
 }
 
 // SessionListItem represents a session in list output.
 type SessionListItem struct {
-	Rig       string `json:"rig"`
-	Polecat   string `json:"polecat"`
-	SessionID string `json:"session_id"`
-	Running   bool   `json:"running"`
+	Rig       string    `json:"rig"`
+	Polecat   string    `json:"polecat"` // Session name suffix, e.g. "Toast", "witness", "crew-max"
+	SessionID string    `json:"session_id"`
+	Running   bool      `json:"running"`
+	Address   string    `json:"address,omitempty"`    // Agent address, e.g. "gastown/polecats/Toast"
+	AgentType string    `json:"agent_type,omitempty"` // polecat, witness, refinery or crew
+	AgentName string    `json:"agent_name,omitempty"` // Crew or polecat name
+	CreatedAt time.Time `json:"created_at"`           // Zero if tmux can't report it
+}
+
+// newSessionListItem builds the list entry for a rig session. The agent
+// fields are left empty if the session name doesn't parse as an agent.
+func newSessionListItem(info polecat.SessionInfo, createdAt time.Time) SessionListItem {
+	item := SessionListItem{
+		Rig:       info.RigName,
+		Polecat:   info.Polecat,
+		SessionID: info.SessionID,
+		Running:   info.Running,
+		CreatedAt: createdAt.UTC(),
+	}
+	if identity, err := session.ParseSessionName(info.SessionID); err == nil {
+		item.Address = identity.Address()
+		item.AgentType = string(identity.Role)
+		item.AgentName = identity.Name
+	}
+	return item
 }
 
 func runSessionList(cmd *cobra.Command, args []string) error {
@@ -380,6 +456,7 @@ func runSessionList(cmd *cobra.Command, args []string) error {
 	// Collect sessions from all rigs
 	t := tmux.NewTmux()
 	var allSessions []SessionListItem
+	created, _ := t.ListSessionsCreated() // Missing times show as unknown
 
 	for _, r := range rigs {
 		polecatMgr := polecat.NewSessionManager(t, r)
@@ -389,12 +466,7 @@ func runSessionList(cmd *cobra.Command, args []string) error {
 		}
 
 		for _, info := range infos {
-			allSessions = append(allSessions, SessionListItem{
-				Rig:       r.Name,
-				Polecat:   info.Polecat,
-				SessionID: info.SessionID,
-				Running:   info.Running,
-			})
+			allSessions = append(allSessions, newSessionListItem(info, unixCreatedAt(created[info.SessionID])))
 		}
 	}
 
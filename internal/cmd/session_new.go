@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/crew"
+	"github.com/steveyegge/gastown/internal/deacon"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/mayor"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/refinery"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/suggest"
+	"github.com/steveyegge/gastown/internal/tmux"
+	"github.com/steveyegge/gastown/internal/witness"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var sessionNewAttach bool
+
+var sessionNewCmd = &cobra.Command{
+	Use:   "new <address>",
+	Short: "Start a session for any agent address",
+	Long: `Start a tmux session for an agent, given its address.
+
+The address is translated to the session name (see 'gt session at') and the
+session is started the same way 'gt start' and 'gt up' start that role: in
+the agent's working directory, running the role's configured agent command.
+
+  mayor, deacon                 town-level agents
+  <rig>/witness, <rig>/refinery rig agents
+  <rig>/crew/<name>             crew workspace (created if missing)
+  <rig>/<polecat>               existing polecat
+
+Fails if the session is already running. Use --attach to attach to the
+new session once it has started.
+
+Examples:
+  gt session new gastown/witness
+  gt session new gastown/crew/max --attach
+  gt session new mayor`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSessionNew,
+}
+
+func init() {
+	sessionNewCmd.Flags().BoolVar(&sessionNewAttach, "attach", false, "Attach to the session after starting it")
+	sessionCmd.AddCommand(sessionNewCmd)
+}
+
+func runSessionNew(cmd *cobra.Command, args []string) error {
+	address := args[0]
+	identity, err := session.ParseAddress(address)
+	if err != nil {
+		return err
+	}
+	sessionName := identity.SessionName()
+
+	running, err := tmux.NewTmux().HasSession(sessionName)
+	if err != nil {
+		return fmt.Errorf("checking session: %w", err)
+	}
+	if running {
+		return fmt.Errorf("%s is already running in session %q (attach with: gt session at %s)", address, sessionName, address)
+	}
+
+	fmt.Printf("Starting %s in session %s...\n", address, sessionName)
+	if err := startAgentSession(identity); err != nil {
+		return fmt.Errorf("starting %s: %w", address, err)
+	}
+	fmt.Printf("%s Session started. Attach with: %s\n",
+		style.Bold.Render("✓"),
+		style.Dim.Render("gt session at "+address))
+
+	if sessionNewAttach {
+		return attachToTmuxSessionWithOptions(sessionName, false)
+	}
+	return nil
+}
+
+// startAgentSession starts the session for identity using its role's manager.
+func startAgentSession(identity *session.AgentIdentity) error {
+	switch identity.Role {
+	case session.RoleMayor, session.RoleDeacon:
+		townRoot, err := workspace.FindFromCwdOrError()
+		if err != nil {
+			return fmt.Errorf("not in a Gas Town workspace: %w", err)
+		}
+		if identity.Role == session.RoleMayor {
+			return mayor.NewManager(townRoot).Start("")
+		}
+		return deacon.NewManager(townRoot).Start("")
+	}
+
+	_, r, err := getRig(identity.Rig)
+	if err != nil {
+		return err
+	}
+
+	switch identity.Role {
+	case session.RoleWitness:
+		return witness.NewManager(r).Start(false, "", nil)
+	case session.RoleRefinery:
+		return refinery.NewManager(r).Start(false, "")
+	case session.RoleCrew:
+		return crew.NewManager(r, git.NewGit(r.Path)).Start(identity.Name, crew.StartOptions{})
+	case session.RolePolecat:
+		found := false
+		for _, p := range r.Polecats {
+			if p == identity.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			suggestions := suggest.FindSimilar(identity.Name, r.Polecats, 3)
+			hint := fmt.Sprintf("Create with: gt polecat add %s/%s", r.Name, identity.Name)
+			return fmt.Errorf("%s", suggest.FormatSuggestion("Polecat", identity.Name, suggestions, hint))
+		}
+		return polecat.NewSessionManager(tmux.NewTmux(), r).Start(identity.Name, polecat.SessionStartOptions{})
+	default:
+		return fmt.Errorf("no session to start for role %s", identity.Role)
+	}
+}
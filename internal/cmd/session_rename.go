@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+var sessionRenameCmd = &cobra.Command{
+	Use:   "rename <old-address> <new-address>",
+	Short: "Rename an agent's tmux session to match a new address",
+	Long: `Rename the tmux session for one agent address to the session name of another.
+
+Both addresses are translated to session names (see 'gt session at'), so
+after a rig is renamed or an agent changes role, the running session can
+follow without being restarted:
+
+  gt session rename oldrig/witness newrig/witness
+
+<old-address> may also be the raw tmux session name, for sessions named
+under a rig prefix that has since changed in rigs.json (the session names
+are derived from the current prefixes).
+
+Fails if the old session isn't running or the new name is already taken.
+
+Examples:
+  gt session rename gastown/Toast gastown/crew/toast
+  gt session rename gs-witness gastown/witness`,
+	Args: cobra.ExactArgs(2),
+	RunE: runSessionRename,
+}
+
+func init() {
+	sessionCmd.AddCommand(sessionRenameCmd)
+}
+
+func runSessionRename(cmd *cobra.Command, args []string) error {
+	t := tmux.NewTmux()
+	oldName, newName, err := resolveSessionRename(args[0], args[1], t.HasSession)
+	if err != nil {
+		return err
+	}
+	if err := t.RenameSession(oldName, newName); err != nil {
+		return fmt.Errorf("renaming session %s: %w", oldName, err)
+	}
+	fmt.Printf("%s Renamed session %s → %s\n", style.Bold.Render("✓"), oldName, newName)
+	return nil
+}
+
+// resolveSessionRename translates the old and new addresses to session names
+// and checks that the old session exists and the new name is free.
+// oldArg falls back to a literal session name when its address doesn't
+// resolve to a running session.
+func resolveSessionRename(oldArg, newArg string, hasSession func(string) (bool, error)) (oldName, newName string, err error) {
+	newIdentity, err := session.ParseAddress(newArg)
+	if err != nil {
+		return "", "", err
+	}
+	newName = newIdentity.SessionName()
+
+	oldName = oldArg
+	if oldIdentity, parseErr := session.ParseAddress(oldArg); parseErr == nil {
+		oldName = oldIdentity.SessionName()
+	}
+	exists, err := hasSession(oldName)
+	if err != nil {
+		return "", "", fmt.Errorf("checking session: %w", err)
+	}
+	if !exists && oldName != oldArg {
+		if exists, err = hasSession(oldArg); err != nil {
+			return "", "", fmt.Errorf("checking session: %w", err)
+		}
+		if exists {
+			oldName = oldArg
+		}
+	}
+	if !exists {
+		return "", "", fmt.Errorf("no session for %s (looked for %q)", oldArg, oldName)
+	}
+
+	if newName == oldName {
+		return "", "", fmt.Errorf("%s and %s both map to session %q", oldArg, newArg, newName)
+	}
+	taken, err := hasSession(newName)
+	if err != nil {
+		return "", "", fmt.Errorf("checking session: %w", err)
+	}
+	if taken {
+		return "", "", fmt.Errorf("session %q for %s already exists", newName, newArg)
+	}
+	return oldName, newName, nil
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// Export/restore flags
+var (
+	sessionExportOutput string
+	sessionRestoreInput string
+)
+
+var sessionExportCmd = &cobra.Command{
+	Use:   "export <rig>",
+	Short: "Save a rig's running sessions to a snapshot file",
+	Long: `Save a rig's running tmux sessions to a JSON snapshot file, so they can
+be recreated with 'gt session restore' after the machine restarts.
+
+For each session the snapshot records its name, the working directory of
+its first pane, and the command the session was started with. Sessions
+started without a command (a plain shell) are recorded with none.
+
+Examples:
+  gt session export greenplace --output ~/greenplace-sessions.json
+  gt session restore --input ~/greenplace-sessions.json`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeRigNames,
+	RunE:              runSessionExport,
+}
+
+var sessionRestoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Recreate sessions from a snapshot file",
+	Long: `Recreate the tmux sessions saved by 'gt session export'.
+
+Each session is started detached, in its recorded working directory,
+running its recorded command. Sessions that are already running are left
+alone. A failed session doesn't stop the others; failures are listed at
+the end and the command exits non-zero.
+
+Restored agents start fresh: their conversation history isn't part of
+the snapshot.
+
+Examples:
+  gt session restore --input ~/greenplace-sessions.json`,
+	Args: cobra.NoArgs,
+	RunE: runSessionRestore,
+}
+
+func init() {
+	sessionExportCmd.Flags().StringVarP(&sessionExportOutput, "output", "o", "", "Snapshot file to write")
+	_ = sessionExportCmd.MarkFlagRequired("output")
+	sessionRestoreCmd.Flags().StringVarP(&sessionRestoreInput, "input", "i", "", "Snapshot file to read")
+	_ = sessionRestoreCmd.MarkFlagRequired("input")
+
+	sessionCmd.AddCommand(sessionExportCmd)
+	sessionCmd.AddCommand(sessionRestoreCmd)
+}
+
+// sessionSnapshot is the file written by gt session export.
+type sessionSnapshot struct {
+	Rig        string                 `json:"rig"`
+	ExportedAt time.Time              `json:"exported_at"`
+	Sessions   []sessionSnapshotEntry `json:"sessions"`
+}
+
+// sessionSnapshotEntry records how to recreate one tmux session.
+type sessionSnapshotEntry struct {
+	Name    string `json:"name"`
+	WorkDir string `json:"work_dir"`
+	Command string `json:"command,omitempty"`
+}
+
+func runSessionExport(cmd *cobra.Command, args []string) error {
+	_, r, err := getRig(args[0])
+	if err != nil {
+		return err
+	}
+	t := tmux.NewTmux()
+	infos, err := polecat.NewSessionManager(t, r).List()
+	if err != nil {
+		return fmt.Errorf("listing sessions: %w", err)
+	}
+
+	snapshot := sessionSnapshot{Rig: r.Name, ExportedAt: time.Now().UTC()}
+	for _, info := range infos {
+		workDir, err := t.GetPaneWorkDir(info.SessionID)
+		if err != nil {
+			fmt.Printf("  %s Skipping %s: %v\n", style.Warning.Render("⚠"), info.SessionID, err)
+			continue
+		}
+		command, err := t.GetPaneStartCommand(info.SessionID)
+		if err != nil {
+			fmt.Printf("  %s Skipping %s: %v\n", style.Warning.Render("⚠"), info.SessionID, err)
+			continue
+		}
+		snapshot.Sessions = append(snapshot.Sessions, sessionSnapshotEntry{
+			Name:    info.SessionID,
+			WorkDir: workDir,
+			Command: command,
+		})
+	}
+
+	if err := writeSessionSnapshot(sessionExportOutput, snapshot); err != nil {
+		return err
+	}
+	fmt.Printf("%s Exported %d session(s) from %s to %s\n",
+		style.SuccessPrefix, len(snapshot.Sessions), r.Name, sessionExportOutput)
+	return nil
+}
+
+func runSessionRestore(cmd *cobra.Command, args []string) error {
+	snapshot, err := readSessionSnapshot(sessionRestoreInput)
+	if err != nil {
+		return err
+	}
+
+	t := tmux.NewTmux()
+	restored := 0
+	var failures []string
+	for _, s := range snapshot.Sessions {
+		exists, err := t.HasSession(s.Name)
+		if err == nil && exists {
+			fmt.Printf("  %s %s (already running)\n", style.Dim.Render("○"), s.Name)
+			continue
+		}
+		if s.Command != "" {
+			err = t.NewSessionWithCommand(s.Name, s.WorkDir, s.Command)
+		} else {
+			err = t.NewSession(s.Name, s.WorkDir)
+		}
+		if err != nil {
+			fmt.Printf("  %s %s\n", style.Error.Render("✗"), s.Name)
+			failures = append(failures, fmt.Sprintf("%s: %v", s.Name, err))
+			continue
+		}
+		fmt.Printf("  %s Restored %s\n", style.Success.Render("✓"), s.Name)
+		restored++
+	}
+
+	fmt.Printf("\nRestored %d of %d session(s) from %s snapshot (%s)\n",
+		restored, len(snapshot.Sessions), snapshot.Rig, snapshot.ExportedAt.Local().Format("2006-01-02 15:04"))
+	if len(failures) > 0 {
+		fmt.Printf("\n%s %d session(s) failed to restore:\n", style.Error.Render("✗"), len(failures))
+		for _, f := range failures {
+			fmt.Printf("  %s\n", f)
+		}
+		return NewSilentExit(1)
+	}
+	return nil
+}
+
+// writeSessionSnapshot writes snapshot to path as indented JSON.
+func writeSessionSnapshot(path string, snapshot sessionSnapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding snapshot: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing snapshot: %w", err)
+	}
+	return nil
+}
+
+// readSessionSnapshot reads a snapshot written by writeSessionSnapshot.
+func readSessionSnapshot(path string) (*sessionSnapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading snapshot: %w", err)
+	}
+	var snapshot sessionSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("parsing snapshot %s: %w", path, err)
+	}
+	for i, s := range snapshot.Sessions {
+		if s.Name == "" {
+			return nil, fmt.Errorf("parsing snapshot %s: session %d has no name", path, i)
+		}
+	}
+	return &snapshot, nil
+}
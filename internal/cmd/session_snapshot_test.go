@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSessionSnapshotRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions.json")
+	want := sessionSnapshot{
+		Rig:        "gastown",
+		ExportedAt: time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC),
+		Sessions: []sessionSnapshotEntry{
+			{Name: "gt-witness", WorkDir: "/town/gastown/witness", Command: `exec env GT_ROLE=witness claude "start"`},
+			{Name: "gt-crew-max", WorkDir: "/town/gastown/crew/max"},
+		},
+	}
+	if err := writeSessionSnapshot(path, want); err != nil {
+		t.Fatalf("writeSessionSnapshot: %v", err)
+	}
+	got, err := readSessionSnapshot(path)
+	if err != nil {
+		t.Fatalf("readSessionSnapshot: %v", err)
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("round trip = %+v, want %+v", *got, want)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"sessions":[{"work_dir":"/tmp"}]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readSessionSnapshot(path); err == nil {
+		t.Error("readSessionSnapshot accepted a session with no name")
+	}
+}
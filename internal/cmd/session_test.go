@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+func TestTmuxAttachArgs(t *testing.T) {
+	tests := []struct {
+		name       string
+		readOnly   bool
+		insideTmux bool
+		want       []string
+	}{
+		{"attach", false, false, []string{"tmux", "-u", "attach-session", "-t", "gt-toast"}},
+		{"attach read-only", true, false, []string{"tmux", "-u", "attach-session", "-r", "-t", "gt-toast"}},
+		{"switch inside tmux", false, true, []string{"tmux", "-u", "switch-client", "-t", "gt-toast"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tmuxAttachArgs("gt-toast", tt.readOnly, tt.insideTmux); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("tmuxAttachArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSessionNamePattern(t *testing.T) {
+	tests := []struct {
+		identity *session.AgentIdentity
+		want     string
+	}{
+		{&session.AgentIdentity{Role: session.RolePolecat, Rig: "gastown", Name: "Toast", Prefix: "gt"}, `pattern <prefix>-<name>, prefix "gt" for rig gastown`},
+		{&session.AgentIdentity{Role: session.RoleCrew, Rig: "gastown", Name: "max", Prefix: "gt"}, "<prefix>-crew-<name>"},
+		{&session.AgentIdentity{Role: session.RoleWitness, Rig: "beads", Prefix: "bd"}, `prefix "bd" for rig beads`},
+		{&session.AgentIdentity{Role: session.RoleMayor}, "town-level session"},
+	}
+	for _, tt := range tests {
+		if got := sessionNamePattern(tt.identity); !strings.Contains(got, tt.want) {
+			t.Errorf("sessionNamePattern(%+v) = %q, want it to contain %q", tt.identity, got, tt.want)
+		}
+	}
+}
+
+func TestResolveSessionRename(t *testing.T) {
+	setupNudgeTestRegistry(t)
+	running := func(names ...string) func(string) (bool, error) {
+		return func(name string) (bool, error) {
+			for _, n := range names {
+				if n == name {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+	}
+
+	oldName, newName, err := resolveSessionRename("gastown/witness", "beads/witness", running("gt-witness"))
+	if err != nil || oldName != "gt-witness" || newName != "bd-witness" {
+		t.Errorf("resolveSessionRename = %q, %q, %v; want gt-witness, bd-witness", oldName, newName, err)
+	}
+
+	// A raw session name works for the old side
+	oldName, newName, err = resolveSessionRename("gs-witness", "gastown/witness", running("gs-witness"))
+	if err != nil || oldName != "gs-witness" || newName != "gt-witness" {
+		t.Errorf("raw old name = %q, %q, %v; want gs-witness, gt-witness", oldName, newName, err)
+	}
+
+	for _, tt := range []struct {
+		name, oldArg, newArg string
+		running              []string
+		wantErr              string
+	}{
+		{"old not running", "gastown/witness", "beads/witness", nil, "no session"},
+		{"new taken", "gastown/witness", "beads/witness", []string{"gt-witness", "bd-witness"}, "already exists"},
+		{"same session", "gastown/witness", "gastown/witness", []string{"gt-witness"}, "both map to"},
+		{"bad new address", "gastown/witness", "gastown/crew", []string{"gt-witness"}, "invalid address"},
+	} {
+		if _, _, err := resolveSessionRename(tt.oldArg, tt.newArg, running(tt.running...)); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: err = %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestNewSessionListItem(t *testing.T) {
+	setupCostsTestRegistry(t)
+	created := time.Date(2026, 3, 10, 15, 0, 0, 0, time.FixedZone("CET", 3600))
+
+	tests := []struct {
+		info                          polecat.SessionInfo
+		address, agentType, agentName string
+	}{
+		{polecat.SessionInfo{Polecat: "Toast", SessionID: "gt-Toast", RigName: "gastown", Running: true}, "gastown/polecats/Toast", "polecat", "Toast"},
+		{polecat.SessionInfo{Polecat: "witness", SessionID: "gt-witness", RigName: "gastown", Running: true}, "gastown/witness", "witness", ""},
+		{polecat.SessionInfo{Polecat: "crew-max", SessionID: "gt-crew-max", RigName: "gastown", Running: true}, "gastown/crew/max", "crew", "max"},
+	}
+	for _, tt := range tests {
+		item := newSessionListItem(tt.info, created)
+		if item.Address != tt.address || item.AgentType != tt.agentType || item.AgentName != tt.agentName {
+			t.Errorf("%s: got address %q, type %q, name %q; want %q, %q, %q",
+				tt.info.SessionID, item.Address, item.AgentType, item.AgentName, tt.address, tt.agentType, tt.agentName)
+		}
+		if item.Rig != "gastown" || item.SessionID != tt.info.SessionID || !item.Running {
+			t.Errorf("%s: base fields = %+v", tt.info.SessionID, item)
+		}
+		if !item.CreatedAt.Equal(created) || item.CreatedAt.Location() != time.UTC {
+			t.Errorf("%s: CreatedAt = %v, want %v in UTC", tt.info.SessionID, item.CreatedAt, created)
+		}
+	}
+}
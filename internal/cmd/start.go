@@ -901,7 +901,7 @@ func cleanupPolecats(townRoot string) {
 			branchName := fmt.Sprintf("polecat/%s", p.Name)
 			mayorPath := filepath.Join(r.Path, "mayor", "rig")
 			mayorGit := git.NewGit(mayorPath)
-			_ = mayorGit.DeleteBranch(branchName, true) // Ignore errors
+			_ = mayorGit.DeleteBranch(branchName, git.BranchDeleteOptions{Force: true}) // Ignore errors
 
 			fmt.Printf("  %s %s/%s: cleaned up\n", style.Bold.Render("✓"), r.Name, p.Name)
 			totalCleaned++
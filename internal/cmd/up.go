@@ -628,37 +628,12 @@ func discoverRigs(townRoot string) []string {
 	}
 
 	// Fallback: scan directory for rig-like directories
-	entries, err := os.ReadDir(townRoot)
+	found, err := rig.Discover(townRoot)
 	if err != nil {
 		return rigs
 	}
-
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
-		}
-
-		name := entry.Name()
-		// Skip known non-rig directories
-		if name == "mayor" || name == "daemon" || name == "deacon" ||
-			name == ".git" || name == "docs" || name[0] == '.' {
-			continue
-		}
-
-		dirPath := filepath.Join(townRoot, name)
-
-		// Check for .beads directory (indicates a rig)
-		beadsPath := filepath.Join(dirPath, ".beads")
-		if _, err := os.Stat(beadsPath); err == nil {
-			rigs = append(rigs, name)
-			continue
-		}
-
-		// Check for polecats directory (indicates a rig)
-		polecatsPath := filepath.Join(dirPath, "polecats")
-		if _, err := os.Stat(polecatsPath); err == nil {
-			rigs = append(rigs, name)
-		}
+	for _, r := range found {
+		rigs = append(rigs, r.Name)
 	}
 
 	return rigs
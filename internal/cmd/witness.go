@@ -145,7 +145,7 @@ func init() {
 
 // getWitnessManager creates a witness manager for a rig.
 func getWitnessManager(rigName string) (*witness.Manager, error) {
-	_, r, err := getRig(rigName)
+	_, r, err := getValidRig(rigName)
 	if err != nil {
 		return nil, err
 	}
@@ -236,7 +236,7 @@ func runWitnessStatus(cmd *cobra.Command, args []string) error {
 	rigName := args[0]
 
 	// Get rig for polecat info
-	_, r, err := getRig(rigName)
+	_, r, err := getValidRig(rigName)
 	if err != nil {
 		return err
 	}
@@ -352,7 +352,7 @@ func (m *Manager) syncRemotesFromRig(crewPath string) error {
 			}
 		} else if existingURL != url {
 			// Remote exists but URL differs — update it
-			if _, setErr := crewGit.SetRemoteURL(remote, url); setErr != nil {
+			if setErr := crewGit.SetRemoteURL(remote, url); setErr != nil {
 				style.PrintWarning("could not update remote %s: %v", remote, setErr)
 			}
 		}
@@ -27,6 +27,7 @@ import (
 	"github.com/steveyegge/gastown/internal/feed"
 	gitpkg "github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/mayor"
+	"github.com/steveyegge/gastown/internal/nudge"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/refinery"
 	"github.com/steveyegge/gastown/internal/rig"
@@ -291,6 +292,11 @@ func (d *Daemon) Run() error {
 		d.logger.Printf("Dolt remotes push ticker started (interval %v)", interval)
 	}
 
+	// Scheduled nudges ('gt nudge --schedule') are checked on their own ticker
+	// so delivery isn't held up to the 3-minute heartbeat.
+	scheduledNudgeTicker := time.NewTicker(scheduledNudgeInterval)
+	defer scheduledNudgeTicker.Stop()
+
 	// Note: PATCH-010 uses per-session hooks in deacon/manager.go (SetAutoRespawnHook).
 	// Global pane-died hooks don't fire reliably in tmux 3.2a, so we rely on the
 	// per-session approach which has been tested to work for continuous recovery.
@@ -328,6 +334,11 @@ func (d *Daemon) Run() error {
 				d.pushDoltRemotes()
 			}
 
+		case <-scheduledNudgeTicker.C:
+			if !d.isShutdownInProgress() {
+				d.deliverScheduledNudges()
+			}
+
 		case <-timer.C:
 			d.heartbeat(state)
 
@@ -337,6 +348,10 @@ func (d *Daemon) Run() error {
 	}
 }
 
+// scheduledNudgeInterval is how often the daemon checks for scheduled nudges
+// that have come due.
+const scheduledNudgeInterval = 30 * time.Second
+
 // recoveryHeartbeatInterval is the fixed interval for recovery-focused daemon.
 // Normal wake is handled by feed subscription (bd activity --follow).
 // The daemon is a safety net for dead sessions, GUPP violations, and orphaned work.
@@ -1667,6 +1682,45 @@ Manual intervention may be required.`,
 	}
 }
 
+// deliverScheduledNudges sends the scheduled nudges that have come due by
+// running gt nudge for each with its recorded options. Claimed nudges are
+// removed first, so a failed delivery is logged rather than retried.
+func (d *Daemon) deliverScheduledNudges() {
+	due, err := nudge.ClaimDue(d.config.TownRoot, time.Now())
+	if err != nil {
+		d.logger.Printf("Warning: reading scheduled nudges: %v", err)
+		return
+	}
+	for _, n := range due {
+		cmd := exec.Command(d.gtPath, scheduledNudgeArgs(n)...) //nolint:gosec // G204: args are from the town's own schedule
+		cmd.Dir = d.config.TownRoot
+		cmd.Env = os.Environ() // Inherit PATH to find gt executable
+		if out, err := cmd.CombinedOutput(); err != nil {
+			d.logger.Printf("Warning: scheduled nudge %s to %s failed: %v: %s", n.ID, n.Target, err, strings.TrimSpace(string(out)))
+			continue
+		}
+		d.logger.Printf("Delivered scheduled nudge %s to %s", n.ID, n.Target)
+	}
+}
+
+// scheduledNudgeArgs returns the gt arguments that deliver n.
+func scheduledNudgeArgs(n nudge.ScheduledNudge) []string {
+	args := []string{"nudge", n.Target, "-m", n.Message}
+	if n.Sender != "" {
+		args = append(args, "--sender", n.Sender)
+	}
+	if n.Mode != "" {
+		args = append(args, "--mode", n.Mode)
+	}
+	if n.Priority != "" {
+		args = append(args, "--priority", n.Priority)
+	}
+	if n.Force {
+		args = append(args, "--force")
+	}
+	return args
+}
+
 // cleanupOrphanedProcesses kills orphaned claude subagent processes.
 // These are Task tool subagents that didn't clean up after completion.
 // Detection uses TTY column: processes with TTY "?" have no controlling terminal.
@@ -1701,7 +1755,9 @@ func (d *Daemon) pruneStaleBranches() {
 		}
 
 		// Fetch --prune first to clean up stale remote tracking refs
-		_ = g.FetchPrune("origin")
+		fetchCtx, cancel := context.WithTimeout(d.ctx, gitpkg.DefaultFetchTimeout)
+		_ = g.FetchPrune(fetchCtx, "origin")
+		cancel()
 
 		pruned, err := g.PruneStaleBranches("polecat/*", false)
 		if err != nil {
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/gofrs/flock"
+	"github.com/steveyegge/gastown/internal/nudge"
 )
 
 func TestDefaultConfig(t *testing.T) {
@@ -402,3 +403,25 @@ func TestDaemon_StopsManagerAndScanner(t *testing.T) {
 		t.Fatal("Stop() did not complete within 5s")
 	}
 }
+
+func TestScheduledNudgeArgs(t *testing.T) {
+	got := scheduledNudgeArgs(nudge.ScheduledNudge{
+		Target:   "gastown/Toast",
+		Message:  "standup in 5",
+		Sender:   "mayor",
+		Mode:     "queue",
+		Priority: "urgent",
+		Force:    true,
+	})
+	want := []string{"nudge", "gastown/Toast", "-m", "standup in 5", "--sender", "mayor",
+		"--mode", "queue", "--priority", "urgent", "--force"}
+	if !slices.Equal(got, want) {
+		t.Errorf("scheduledNudgeArgs = %q, want %q", got, want)
+	}
+
+	// Unset options are left to gt nudge's defaults
+	got = scheduledNudgeArgs(nudge.ScheduledNudge{Target: "mayor", Message: "hi"})
+	if want := []string{"nudge", "mayor", "-m", "hi"}; !slices.Equal(got, want) {
+		t.Errorf("scheduledNudgeArgs = %q, want %q", got, want)
+	}
+}
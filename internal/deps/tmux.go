@@ -0,0 +1,61 @@
+package deps
+
+import (
+	"context"
+	"os/exec"
+	"regexp"
+	"time"
+)
+
+// MinTmuxVersion is the minimum tmux version Gas Town supports.
+// Update this when Gas Town starts relying on newer tmux features.
+const MinTmuxVersion = "3.2"
+
+// TmuxStatus represents the state of the tmux installation.
+type TmuxStatus int
+
+const (
+	TmuxOK       TmuxStatus = iota // tmux found, version compatible
+	TmuxNotFound                   // tmux not in PATH
+	TmuxTooOld                     // tmux found but version too old
+	TmuxUnknown                    // tmux found but couldn't parse version
+)
+
+// CheckTmux checks if tmux is installed and compatible.
+// Returns status and the installed version (if found).
+func CheckTmux() (TmuxStatus, string) {
+	if _, err := exec.LookPath("tmux"); err != nil {
+		return TmuxNotFound, ""
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	output, err := exec.CommandContext(ctx, "tmux", "-V").Output()
+	if err != nil {
+		return TmuxUnknown, ""
+	}
+
+	version := parseTmuxVersion(string(output))
+	if version == "" {
+		return TmuxUnknown, ""
+	}
+
+	if compareVersions(version, MinTmuxVersion) < 0 {
+		return TmuxTooOld, version
+	}
+
+	return TmuxOK, version
+}
+
+// tmuxVersionRe matches "tmux 3.3a", "tmux 3.2-rc2" and "tmux next-3.5".
+// Letter suffixes are patch releases and are ignored for comparison.
+var tmuxVersionRe = regexp.MustCompile(`tmux (?:next-)?(\d+\.\d+)`)
+
+// parseTmuxVersion extracts the major.minor version from "tmux -V" output.
+func parseTmuxVersion(output string) string {
+	matches := tmuxVersionRe.FindStringSubmatch(output)
+	if len(matches) >= 2 {
+		return matches[1]
+	}
+	return ""
+}
@@ -0,0 +1,44 @@
+package deps
+
+import "testing"
+
+func TestParseTmuxVersion(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"tmux 3.4\n", "3.4"},
+		{"tmux 3.3a", "3.3"},
+		{"tmux 3.2-rc2", "3.2"},
+		{"tmux next-3.5", "3.5"},
+		{"tmux 2.9", "2.9"},
+		{"tmux master", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		result := parseTmuxVersion(tt.input)
+		if result != tt.expected {
+			t.Errorf("parseTmuxVersion(%q) = %q, want %q", tt.input, result, tt.expected)
+		}
+	}
+}
+
+func TestTmuxVersionMinimum(t *testing.T) {
+	tests := []struct {
+		version string
+		tooOld  bool
+	}{
+		{"3.1", true},
+		{"2.9", true},
+		{"3.2", false},
+		{"3.10", false},
+		{"4.0", false},
+	}
+
+	for _, tt := range tests {
+		if got := compareVersions(tt.version, MinTmuxVersion) < 0; got != tt.tooOld {
+			t.Errorf("%s below %s = %v, want %v", tt.version, MinTmuxVersion, got, tt.tooOld)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package doctor
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// BareRepoCheck verifies that each rig's shared .repo.git is a healthy bare repository.
+// Every polecat worktree is created from .repo.git, so corruption there breaks
+// polecat operations in ways that are hard to trace back to the source.
+// Corruption is not auto-fixable; repair requires manual intervention.
+type BareRepoCheck struct {
+	BaseCheck
+}
+
+// NewBareRepoCheck creates a new bare repo health check.
+func NewBareRepoCheck() *BareRepoCheck {
+	return &BareRepoCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "bare-repo-health",
+			CheckDescription: "Verify each rig's .repo.git passes git fsck",
+			CheckCategory:    CategoryRig,
+		},
+	}
+}
+
+// Run runs git fsck against every rig's .repo.git (or only --rig's, if set).
+func (c *BareRepoCheck) Run(ctx *CheckContext) *CheckResult {
+	var corrupt, legacy []string
+	checked := 0
+
+	for _, rigPath := range rigsInScope(ctx) {
+		rigName := filepath.Base(rigPath)
+
+		bareRepoPath := filepath.Join(rigPath, ".repo.git")
+		if _, err := os.Stat(bareRepoPath); os.IsNotExist(err) {
+			// Legacy rigs cloned straight into mayor/rig have no shared bare repo
+			if _, err := os.Stat(filepath.Join(rigPath, "mayor", "rig")); err == nil {
+				legacy = append(legacy, rigName)
+			}
+			ctx.Log().Debug("skipping rig without .repo.git", "rig", rigName)
+			continue
+		}
+
+		checked++
+		start := time.Now()
+		problems := fsckBareRepo(bareRepoPath)
+		ctx.Log().Debug("git fsck finished", "rig", rigName, "problems", len(problems), "elapsed", time.Since(start))
+		if len(problems) > 0 {
+			corrupt = append(corrupt, rigName+"/.repo.git:")
+			for _, p := range problems {
+				corrupt = append(corrupt, "  "+p)
+			}
+		}
+	}
+
+	if len(corrupt) > 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: "git fsck reported errors in shared bare repo(s)",
+			Details: corrupt,
+			FixHint: "Repair manually (e.g. re-clone .repo.git from origin and run 'git worktree repair')",
+		}
+	}
+
+	if len(legacy) > 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("%d rig(s) have no .repo.git (legacy mayor/rig layout)", len(legacy)),
+			Details: legacy,
+			FixHint: "Migrate to the shared bare repo layout by re-adding the rig with 'gt rig add'",
+		}
+	}
+
+	if checked == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No bare repos to check",
+		}
+	}
+
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusOK,
+		Message: fmt.Sprintf("All %d bare repo(s) healthy", checked),
+	}
+}
+
+// fsckBareRepo runs git fsck on a bare repo and returns its reported problems.
+// Returns nil if fsck exits cleanly.
+func fsckBareRepo(bareRepoPath string) []string {
+	cmd := exec.Command("git", "--git-dir", bareRepoPath, "fsck", "--no-dangling")
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		return nil
+	}
+
+	var problems []string
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		// Informational notices (e.g. unborn HEAD) are not corruption
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "notice:") {
+			problems = append(problems, line)
+		}
+	}
+	if len(problems) == 0 {
+		problems = append(problems, err.Error())
+	}
+	return problems
+}
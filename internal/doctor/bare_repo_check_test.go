@@ -0,0 +1,117 @@
+package doctor
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBareRepoCheck_Name(t *testing.T) {
+	check := NewBareRepoCheck()
+	if check.Name() != "bare-repo-health" {
+		t.Errorf("expected name 'bare-repo-health', got %q", check.Name())
+	}
+	if check.CanFix() {
+		t.Error("expected CanFix to return false")
+	}
+}
+
+func TestBareRepoCheck_HealthyRepo(t *testing.T) {
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "testrig")
+	if err := os.MkdirAll(filepath.Join(rigPath, "polecats"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := exec.Command("git", "init", "--bare", filepath.Join(rigPath, ".repo.git")).CombinedOutput(); err != nil {
+		t.Fatalf("git init --bare: %v\n%s", err, out)
+	}
+
+	result := NewBareRepoCheck().Run(&CheckContext{TownRoot: townRoot})
+	if result.Status != StatusOK {
+		t.Errorf("expected StatusOK, got %v: %s %v", result.Status, result.Message, result.Details)
+	}
+}
+
+func TestBareRepoCheck_CorruptRepo(t *testing.T) {
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "testrig")
+	if err := os.MkdirAll(filepath.Join(rigPath, "polecats"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	bareRepo := filepath.Join(rigPath, ".repo.git")
+	if out, err := exec.Command("git", "init", "--bare", bareRepo).CombinedOutput(); err != nil {
+		t.Fatalf("git init --bare: %v\n%s", err, out)
+	}
+	// Point a branch at an object that does not exist
+	ref := filepath.Join(bareRepo, "refs", "heads", "main")
+	if err := os.WriteFile(ref, []byte("0123456789abcdef0123456789abcdef01234567\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result := NewBareRepoCheck().Run(&CheckContext{TownRoot: townRoot})
+	if result.Status != StatusError {
+		t.Errorf("expected StatusError, got %v: %s", result.Status, result.Message)
+	}
+	if len(result.Details) == 0 {
+		t.Error("expected fsck output in details")
+	}
+}
+
+func TestBareRepoCheck_LegacyLayout(t *testing.T) {
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "testrig")
+	if err := os.MkdirAll(filepath.Join(rigPath, "polecats"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(rigPath, "mayor", "rig"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	result := NewBareRepoCheck().Run(&CheckContext{TownRoot: townRoot})
+	if result.Status != StatusWarning {
+		t.Errorf("expected StatusWarning for legacy layout, got %v: %s", result.Status, result.Message)
+	}
+}
+
+func TestBareRepoCheck_RigFilter(t *testing.T) {
+	townRoot := t.TempDir()
+	for _, name := range []string{"legacyrig", "otherrig"} {
+		if err := os.MkdirAll(filepath.Join(townRoot, name, "mayor", "rig"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(filepath.Join(townRoot, name, "polecats"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result := NewBareRepoCheck().Run(&CheckContext{TownRoot: townRoot, RigName: "otherrig"})
+	if len(result.Details) != 1 || result.Details[0] != "otherrig" {
+		t.Errorf("expected only otherrig in details, got %v", result.Details)
+	}
+}
+
+func TestBareRepoCheck_DebugLog(t *testing.T) {
+	townRoot := t.TempDir()
+	rigPath := filepath.Join(townRoot, "testrig")
+	if err := os.MkdirAll(filepath.Join(rigPath, "polecats"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := exec.Command("git", "init", "--bare", filepath.Join(rigPath, ".repo.git")).CombinedOutput(); err != nil {
+		t.Fatalf("git init --bare: %v\n%s", err, out)
+	}
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	NewBareRepoCheck().Run(&CheckContext{TownRoot: townRoot, Logger: logger})
+	if out := buf.String(); !strings.Contains(out, "git fsck finished") || !strings.Contains(out, "rig=testrig") {
+		t.Errorf("debug log = %q, want a git fsck record for testrig", out)
+	}
+
+	if (&CheckContext{}).Log() != slog.Default() {
+		t.Error("Log() with nil Logger should return slog.Default()")
+	}
+}
@@ -0,0 +1,192 @@
+package doctor
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// TownChecks returns a new instance of every check 'gt doctor' runs for a
+// town, in registration order. Workspace-level checks come first, since the
+// rest depend on them. Rig checks (see RigChecks) are not included.
+func TownChecks() []Check {
+	return append(WorkspaceChecks(),
+		NewGlobalStateCheck(),
+
+		// Built-in checks
+		NewStaleBinaryCheck(),
+		NewBeadsBinaryCheck(),
+		NewTmuxVersionCheck(),
+		// All database queries go through bd CLI
+		NewTownGitCheck(),
+		NewTownRootBranchCheck(),
+		NewPreCheckoutHookCheck(),
+		NewDaemonCheck(),
+		NewBootHealthCheck(),
+		NewTownBeadsConfigCheck(),
+		NewCustomTypesCheck(),
+		NewRoleLabelCheck(),
+		NewFormulaCheck(),
+		NewPrefixConflictCheck(),
+		NewRigNameMismatchCheck(),
+		NewPrefixMismatchCheck(),
+		NewDatabasePrefixCheck(),
+		NewRoutesCheck(),
+		NewRigRoutesJSONLCheck(),
+		NewRoutingModeCheck(),
+		NewMalformedSessionNameCheck(),
+		NewOrphanSessionCheck(),
+		NewZombieSessionCheck(),
+		NewOrphanProcessCheck(),
+		NewWispGCCheck(),
+		NewCheckMisclassifiedWisps(),
+		NewStaleBeadsRedirectCheck(),
+		NewBeadsRedirectTargetCheck(),
+		NewBranchCheck(),
+		NewCloneDivergenceCheck(),
+		NewDefaultBranchAllRigsCheck(),
+		NewIdentityCollisionCheck(),
+		NewLinkedPaneCheck(),
+		NewThemeCheck(),
+		NewCrashReportCheck(),
+		NewEnvVarsCheck(),
+
+		// Patrol system checks
+		NewPatrolMoleculesExistCheck(),
+		NewPatrolHooksWiredCheck(),
+		NewPatrolNotStuckCheck(),
+		NewPatrolPluginsAccessibleCheck(),
+		NewWitnessCheck(),
+		NewAgentBeadsCheck(),
+		NewStaleAgentBeadsCheck(),
+		NewRigBeadsCheck(),
+		NewRoleBeadsCheck(),
+
+		// NOTE: StaleAttachmentsCheck removed - staleness detection belongs in Deacon molecule
+
+		// Config architecture checks
+		NewSettingsCheck(),
+		NewSessionHookCheck(),
+		NewRuntimeGitignoreCheck(),
+		NewLegacyGastownCheck(),
+		NewClaudeSettingsCheck(),
+		NewMayorSettingsCheck(),
+		NewDeprecatedMergeQueueKeysCheck(),
+		NewLandWorktreeGitignoreCheck(),
+		NewHooksPathAllRigsCheck(),
+
+		// Sparse checkout migration (runs across all rigs, not just --rig mode)
+		NewSparseCheckoutCheck(),
+
+		// Priming subsystem check
+		NewPrimingCheck(),
+
+		// Crew workspace checks
+		NewCrewStateCheck(),
+		NewCrewWorktreeCheck(),
+		NewCommandsCheck(),
+
+		// Polecat checks
+		NewPolecatStateCheck(DefaultPolecatStaleDuration),
+
+		// Lifecycle hygiene checks
+		NewLifecycleHygieneCheck(),
+
+		// Hook attachment checks
+		NewHookAttachmentValidCheck(),
+		NewHookSingletonCheck(),
+		NewOrphanedAttachmentsCheck(),
+
+		// Hooks sync check
+		NewStaleTaskDispatchCheck(),
+		NewHooksSyncCheck(),
+
+		// Dolt health checks
+		NewDoltBinaryCheck(),
+		NewDoltMetadataCheck(),
+		NewDoltServerReachableCheck(),
+		NewDoltOrphanedDatabaseCheck(),
+
+		// Worktree gitdir validity (runs across all rigs, or specific rig with --rig)
+		NewWorktreeGitdirCheck(),
+		NewBareRepoCheck(),
+	)
+}
+
+// RunAll runs every town check, plus the rig checks if ctx.RigName is set,
+// and returns their results in priority order. It is the programmatic
+// equivalent of 'gt doctor' without --fix; see Doctor.RunParallel for how
+// checks are scheduled.
+func RunAll(ctx *CheckContext) ([]CheckResult, error) {
+	if ctx == nil || ctx.TownRoot == "" {
+		return nil, fmt.Errorf("doctor: RunAll needs a CheckContext with a TownRoot")
+	}
+
+	d := NewDoctor()
+	d.RegisterAll(TownChecks()...)
+	if ctx.RigName != "" {
+		d.RegisterAll(RigChecks()...)
+	}
+
+	report := d.RunParallel(ctx)
+	results := make([]CheckResult, len(report.Checks))
+	for i, r := range report.Checks {
+		results[i] = *r
+	}
+	return results, nil
+}
+
+// RunParallel executes all registered checks like Run, but runs checks that
+// aren't fatal concurrently. Fatal checks run alone, after every check
+// before them in priority order has finished, so lower-priority checks are
+// still skipped when one errors. Results are reported in priority order.
+func (d *Doctor) RunParallel(ctx *CheckContext) *Report {
+	checks := d.sortedChecks()
+	results := make([]*CheckResult, len(checks))
+
+	var wg sync.WaitGroup
+	var fatal Check
+	for i, check := range checks {
+		if fatal != nil && check.Priority() > fatal.Priority() {
+			results[i] = skippedResult(check, fatal)
+			continue
+		}
+		if !check.IsFatal() {
+			wg.Add(1)
+			go func(i int, check Check) {
+				defer wg.Done()
+				results[i] = runCheck(check, ctx)
+			}(i, check)
+			continue
+		}
+
+		wg.Wait()
+		results[i] = runCheck(check, ctx)
+		if fatal == nil && results[i].Status == StatusError {
+			fatal = check
+		}
+	}
+	wg.Wait()
+
+	report := NewReport()
+	for _, result := range results {
+		report.Add(result)
+	}
+	return report
+}
+
+// runCheck runs a single check, filling in its elapsed time and, if the
+// check left them empty, its name and category.
+func runCheck(check Check, ctx *CheckContext) *CheckResult {
+	start := time.Now()
+	result := check.Run(ctx)
+	result.Elapsed = time.Since(start)
+
+	if result.Name == "" {
+		result.Name = check.Name()
+	}
+	if cg, ok := check.(categoryGetter); ok && result.Category == "" {
+		result.Category = cg.Category()
+	}
+	return result
+}
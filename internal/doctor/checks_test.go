@@ -0,0 +1,107 @@
+package doctor
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// barrierCheck passes only if all checks sharing its barrier run at once.
+type barrierCheck struct {
+	BaseCheck
+	barrier *sync.WaitGroup
+}
+
+func (b *barrierCheck) Run(ctx *CheckContext) *CheckResult {
+	b.barrier.Done()
+	done := make(chan struct{})
+	go func() {
+		b.barrier.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return &CheckResult{Status: StatusOK}
+	case <-time.After(5 * time.Second):
+		return &CheckResult{Status: StatusError, Message: "ran alone"}
+	}
+}
+
+func TestDoctor_RunParallelRunsChecksConcurrently(t *testing.T) {
+	var barrier sync.WaitGroup
+	barrier.Add(3)
+	d := NewDoctor()
+	for _, name := range []string{"a", "b", "c"} {
+		d.Register(&barrierCheck{BaseCheck: BaseCheck{CheckName: name, CheckCategory: CategoryCore}, barrier: &barrier})
+	}
+
+	report := d.RunParallel(&CheckContext{TownRoot: "/test"})
+
+	if report.Summary.OK != 3 {
+		t.Fatalf("RunParallel() results = %+v, want all OK", report.Checks)
+	}
+	for i, want := range []string{"a", "b", "c"} {
+		if got := report.Checks[i]; got.Name != want || got.Category != CategoryCore {
+			t.Errorf("result %d = %+v, want %s with its category filled in", i, got, want)
+		}
+	}
+}
+
+func TestDoctor_RunParallelFatalSkipsLowerPriority(t *testing.T) {
+	d := NewDoctor()
+
+	late := newMockCheck("late", StatusOK)
+	late.CheckPriority = 90
+	d.Register(late)
+
+	fatal := newMockCheck("fatal", StatusError)
+	fatal.CheckPriority = 10
+	fatal.CheckFatal = true
+	d.Register(fatal)
+
+	early := newMockCheck("early", StatusOK)
+	early.CheckPriority = 5
+	d.Register(early)
+
+	peer := newMockCheck("peer", StatusOK)
+	peer.CheckPriority = 10
+	d.Register(peer)
+
+	report := d.RunParallel(&CheckContext{TownRoot: "/test"})
+
+	var got []string
+	for _, r := range report.Checks {
+		got = append(got, r.Name+":"+r.Status.String())
+	}
+	want := []string{"early:OK", "fatal:Error", "peer:OK", "late:Warning"}
+	if len(got) != len(want) {
+		t.Fatalf("RunParallel() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("RunParallel() = %v, want %v", got, want)
+		}
+	}
+	if report.Checks[3].Message != "skipped (fatal failed)" {
+		t.Errorf("late result = %+v, want skipped", report.Checks[3])
+	}
+}
+
+func TestTownChecksHaveUniqueNames(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, check := range append(TownChecks(), RigChecks()...) {
+		if seen[check.Name()] {
+			t.Errorf("duplicate check name %q", check.Name())
+		}
+		seen[check.Name()] = true
+	}
+}
+
+func TestRunAllRequiresTownRoot(t *testing.T) {
+	if _, err := RunAll(nil); err == nil {
+		t.Error("RunAll(nil) should fail")
+	}
+	if _, err := RunAll(&CheckContext{}); err == nil {
+		t.Error("RunAll without a TownRoot should fail")
+	}
+}
@@ -1,14 +1,19 @@
 package doctor
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/session"
 	"github.com/steveyegge/gastown/internal/style"
@@ -31,6 +36,74 @@ const (
 type ClaudeSettingsCheck struct {
 	FixableCheck
 	staleSettings []staleSettingsInfo
+	backups       []string // settingsBackupSuffix files left by earlier fixes
+}
+
+// settingsBackupSuffix is appended to a settings file's path for the copy
+// Fix makes before rewriting it in place.
+const settingsBackupSuffix = ".bak"
+
+// defaultKnownPlugins are the enabledPlugins entries gastown's templates use.
+// A town can replace the list with settings/known-plugins.json.
+var defaultKnownPlugins = []string{"beads@beads-marketplace"}
+
+// knownPluginsPath returns the town's plugin allowlist override.
+func knownPluginsPath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirSettings, "known-plugins.json")
+}
+
+// loadKnownPlugins returns the plugin allowlist: the JSON array of names in
+// settings/known-plugins.json if present, otherwise defaultKnownPlugins.
+func loadKnownPlugins(townRoot string) ([]string, error) {
+	data, err := os.ReadFile(knownPluginsPath(townRoot))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return defaultKnownPlugins, nil
+		}
+		return nil, err
+	}
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", knownPluginsPath(townRoot), err)
+	}
+	return names, nil
+}
+
+// minSettingsSchemaVersion is the oldest settings schemaVersion gastown
+// supports. Files without a schemaVersion key are not checked.
+const minSettingsSchemaVersion = 1
+
+// knownSettingsKeys are the top-level keys of Claude's settings schema.
+// Other keys are reported, since they may be typos or new settings that
+// conflict with the ones gastown relies on. Extend this as the schema grows.
+var knownSettingsKeys = []string{
+	"$schema",
+	"alwaysThinkingEnabled",
+	"apiKeyHelper",
+	"awsAuthRefresh",
+	"awsCredentialExport",
+	"cleanupPeriodDays",
+	"companyAnnouncements",
+	"disableAllHooks",
+	"disabledMcpjsonServers",
+	"editorMode",
+	"enableAllProjectMcpServers",
+	"enabledMcpjsonServers",
+	"enabledPlugins",
+	"env",
+	"extraKnownMarketplaces",
+	"forceLoginMethod",
+	"forceLoginOrgUUID",
+	"hooks",
+	"includeCoAuthoredBy",
+	"model",
+	"otelHeadersHelper",
+	"outputStyle",
+	"permissions",
+	"sandbox",
+	"schemaVersion",
+	"spinnerTipsEnabled",
+	"statusLine",
 }
 
 type staleSettingsInfo struct {
@@ -39,6 +112,9 @@ type staleSettingsInfo struct {
 	rigName        string        // Rig name (empty for town-level agents)
 	sessionName    string        // tmux session name for cycling
 	missing        []string      // What's missing from the settings
+	schemaProblem  string        // Why the file's schemaVersion is unsupported
+	malformedHooks []string      // Hook entries missing "matcher" or "hooks"
+	duplicateHooks []string      // Hook commands that appear more than once
 	wrongLocation  bool          // True if file is in wrong location (should be deleted)
 	missingFile    bool          // True if settings.local.json doesn't exist (needs agent restart)
 	gitStatus      gitFileStatus // Git status for wrong-location files (for safe deletion)
@@ -60,20 +136,44 @@ func NewClaudeSettingsCheck() *ClaudeSettingsCheck {
 // Run checks all Claude settings files for staleness or missing settings.json.
 func (c *ClaudeSettingsCheck) Run(ctx *CheckContext) *CheckResult {
 	c.staleSettings = nil
+	c.backups = nil
 
 	var details []string
 	var hasModifiedFiles bool
 	var hasMissingFiles bool
 	var hasStaleFiles bool
+	var hasDuplicateHooks bool
+
+	// Unknown plugins and keys and anonymous session-started nudges are only
+	// reported; Fix never deletes files for them
+	var unknownPlugins int
+	var unknownKeyFiles int
+	var anonymousNudgeFiles int
+	knownPlugins, err := loadKnownPlugins(ctx.TownRoot)
+	if err != nil {
+		details = append(details, fmt.Sprintf("%s: %v (using built-in plugin list)", townRelPath(ctx.TownRoot, knownPluginsPath(ctx.TownRoot)), err))
+		knownPlugins = defaultKnownPlugins
+		unknownPlugins++
+	}
 
 	// Find all settings files (stale and missing)
 	settingsFiles := c.findSettingsFiles(ctx.TownRoot)
 
 	for _, sf := range settingsFiles {
+		// Details name files relative to the town root so users know which to open
+		relPath := townRelPath(ctx.TownRoot, sf.path)
+
+		// A backup means an earlier fix rewrote this file; it should be reviewed
+		if backup := sf.path + settingsBackupSuffix; fileExists(backup) {
+			c.backups = append(c.backups, backup)
+			details = append(details, fmt.Sprintf("%s: backup from a previous fix (review against %s)",
+				townRelPath(ctx.TownRoot, backup), filepath.Base(sf.path)))
+		}
+
 		// Missing settings.local.json files need agent restart to create
 		if sf.missingFile {
 			c.staleSettings = append(c.staleSettings, sf)
-			details = append(details, fmt.Sprintf("%s: missing (restart %s to create)", sf.path, sf.agentType))
+			details = append(details, fmt.Sprintf("%s: missing (restart %s to create)", relPath, sf.agentType))
 			hasMissingFiles = true
 			continue
 		}
@@ -111,7 +211,39 @@ func (c *ClaudeSettingsCheck) Run(ctx *CheckContext) *CheckResult {
 			default:
 				statusMsg = "wrong location (inside source repo)"
 			}
-			details = append(details, fmt.Sprintf("%s: %s", sf.path, statusMsg))
+			// A local file takes precedence over a settings.json beside it;
+			// without one, it is the agent's only settings and must be complete
+			if baseName == "settings.local.json" {
+				sibling := filepath.Join(filepath.Dir(sf.path), "settings.json")
+				if fileExists(sibling) {
+					if shadowed := shadowedHooks(sf.path, sibling); len(shadowed) > 0 {
+						statusMsg += "; shadows " + strings.Join(shadowed, ", ") + " from settings.json"
+					}
+				} else if missing := c.checkSettings(sf.path, sf.agentType); len(missing) > 0 {
+					statusMsg += "; missing " + strings.Join(missing, ", ")
+				}
+			}
+			details = append(details, fmt.Sprintf("%s: %s", relPath, statusMsg))
+			continue
+		}
+
+		// Settings written for an unsupported schema are stale as a whole
+		if problem := settingsSchemaProblem(sf.path); problem != "" {
+			sf.schemaProblem = problem
+			c.staleSettings = append(c.staleSettings, sf)
+			hasStaleFiles = true
+			details = append(details, fmt.Sprintf("%s: %s", relPath, problem))
+			continue
+		}
+
+		// Claude silently ignores hook entries without a matcher or hooks list
+		if malformed := malformedHookEntries(sf.path); len(malformed) > 0 {
+			sf.malformedHooks = malformed
+			c.staleSettings = append(c.staleSettings, sf)
+			hasStaleFiles = true
+			for _, m := range malformed {
+				details = append(details, fmt.Sprintf("%s: %s", relPath, m))
+			}
 			continue
 		}
 
@@ -121,11 +253,74 @@ func (c *ClaudeSettingsCheck) Run(ctx *CheckContext) *CheckResult {
 			sf.missing = missing
 			c.staleSettings = append(c.staleSettings, sf)
 			hasStaleFiles = true
-			details = append(details, fmt.Sprintf("%s: missing %s", sf.path, strings.Join(missing, ", ")))
+			details = append(details, fmt.Sprintf("%s: missing %s", relPath, strings.Join(missing, ", ")))
+			continue
+		}
+
+		// Duplicate hooks (e.g. from repeated fixes) waste cycles and can double-nudge
+		if dups := c.findDuplicateHooks(sf.path); len(dups) > 0 {
+			sf.duplicateHooks = dups
+			c.staleSettings = append(c.staleSettings, sf)
+			hasDuplicateHooks = true
+			for _, d := range dups {
+				details = append(details, fmt.Sprintf("%s: duplicate hook %s", relPath, d))
+			}
+		}
+
+		for _, name := range unknownEnabledPlugins(sf.path, knownPlugins) {
+			details = append(details, fmt.Sprintf("%s: enabledPlugins: unknown plugin '%s' (known: %s)",
+				relPath, name, strings.Join(knownPlugins, ", ")))
+			unknownPlugins++
+		}
+
+		if keys := unknownSettingsKeys(sf.path); len(keys) > 0 {
+			details = append(details, fmt.Sprintf("%s: unknown top-level key(s): %s", relPath, strings.Join(keys, ", ")))
+			unknownKeyFiles++
+		}
+
+		if hooks := sessionStartedNudgesWithoutFrom(sf.path); len(hooks) > 0 {
+			details = append(details, fmt.Sprintf("%s: %s hook nudges session-started without --from", relPath, strings.Join(hooks, ", ")))
+			anonymousNudgeFiles++
 		}
 	}
 
 	if len(c.staleSettings) == 0 {
+		if len(c.backups) > 0 {
+			return &CheckResult{
+				Name:    c.Name(),
+				Status:  StatusWarning,
+				Message: fmt.Sprintf("Found %d Claude settings backup(s) from a previous fix", len(c.backups)),
+				Details: details,
+				FixHint: "Review them, then run 'gt doctor --fix --remove-backups' to delete them",
+			}
+		}
+		if unknownPlugins > 0 {
+			return &CheckResult{
+				Name:    c.Name(),
+				Status:  StatusWarning,
+				Message: fmt.Sprintf("Found %d unknown plugin(s) in Claude settings", unknownPlugins),
+				Details: details,
+				FixHint: "Remove them from enabledPlugins, or list them in settings/known-plugins.json",
+			}
+		}
+		if unknownKeyFiles > 0 {
+			return &CheckResult{
+				Name:    c.Name(),
+				Status:  StatusWarning,
+				Message: fmt.Sprintf("Found unknown settings keys in %d Claude settings file(s)", unknownKeyFiles),
+				Details: details,
+				FixHint: "Remove or correct them; if they are new Claude settings, add them to knownSettingsKeys",
+			}
+		}
+		if anonymousNudgeFiles > 0 {
+			return &CheckResult{
+				Name:    c.Name(),
+				Status:  StatusWarning,
+				Message: fmt.Sprintf("Found session-started nudges without a sender address in %d Claude settings file(s)", anonymousNudgeFiles),
+				Details: details,
+				FixHint: `Add --from so the deacon can tell sessions apart: gt nudge deacon session-started --from "$GT_ROLE"`,
+			}
+		}
 		return &CheckResult{
 			Name:    c.Name(),
 			Status:  StatusOK,
@@ -133,6 +328,17 @@ func (c *ClaudeSettingsCheck) Run(ctx *CheckContext) *CheckResult {
 		}
 	}
 
+	// Duplicate hooks alone are a warning - the settings still work
+	if hasDuplicateHooks && !hasMissingFiles && !hasStaleFiles {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("Found %d Claude settings file(s) with duplicate hooks", len(c.staleSettings)),
+			Details: details,
+			FixHint: "Run 'gt doctor --fix' to remove duplicate hook entries",
+		}
+	}
+
 	// Build appropriate message and fix hint
 	var message string
 	var fixHint string
@@ -165,6 +371,16 @@ func (c *ClaudeSettingsCheck) Run(ctx *CheckContext) *CheckResult {
 	}
 }
 
+// townRelPath returns path relative to townRoot, or path unchanged if it
+// lies outside the town.
+func townRelPath(townRoot, path string) string {
+	rel, err := filepath.Rel(townRoot, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return path
+	}
+	return rel
+}
+
 // findSettingsFiles locates all .claude/settings.json files and identifies their agent type.
 // Settings are now installed in gastown-managed parent directories (crew/, polecats/,
 // witness/, refinery/) and passed via --settings flag. Old settings.local.json files
@@ -273,24 +489,14 @@ func (c *ClaudeSettingsCheck) findSettingsFiles(townRoot string) []staleSettings
 	}
 
 	// Find rig directories
-	entries, err := os.ReadDir(townRoot)
+	rigs, err := rig.Discover(townRoot)
 	if err != nil {
 		return files
 	}
 
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
-		}
-
-		rigName := entry.Name()
-		rigPath := filepath.Join(townRoot, rigName)
-
-		// Skip known non-rig directories
-		if rigName == "mayor" || rigName == "deacon" || rigName == "daemon" ||
-			rigName == ".git" || rigName == "docs" || rigName[0] == '.' {
-			continue
-		}
+	for _, r := range rigs {
+		rigName := r.Name
+		rigPath := r.Path
 
 		// Check for witness settings
 		witnessDir := filepath.Join(rigPath, "witness")
@@ -559,17 +765,374 @@ func (c *ClaudeSettingsCheck) checkSettings(path, _ string) []string {
 		return append(missing, "hooks")
 	}
 
-	// Check SessionStart hook has PATH export
-	if !c.hookHasPattern(hooks, "SessionStart", "PATH=") {
-		missing = append(missing, "PATH export")
+	for _, req := range requiredHooks {
+		if !c.hookHasPattern(hooks, req.hook, req.pattern) {
+			missing = append(missing, req.name)
+		}
 	}
 
-	// Check Stop hook exists with gt costs record (for all roles)
-	if !c.hookHasPattern(hooks, "Stop", "gt costs record") {
-		missing = append(missing, "Stop hook")
+	return missing
+}
+
+// settingsSchemaProblem describes why a settings file's schemaVersion is
+// unsupported: not a number, or below minSettingsSchemaVersion. It returns
+// "" if the version is supported, absent, or the file can't be parsed
+// (checkSettings reports that).
+func settingsSchemaProblem(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	var settings struct {
+		SchemaVersion json.RawMessage `json:"schemaVersion"`
+	}
+	if err := json.Unmarshal(data, &settings); err != nil || len(settings.SchemaVersion) == 0 {
+		return ""
+	}
+	var version float64
+	if err := json.Unmarshal(settings.SchemaVersion, &version); err != nil {
+		return fmt.Sprintf("schemaVersion %s is not a number", settings.SchemaVersion)
 	}
+	if version < minSettingsSchemaVersion {
+		return fmt.Sprintf("schemaVersion %s is below the minimum supported version %d",
+			settings.SchemaVersion, minSettingsSchemaVersion)
+	}
+	return ""
+}
 
-	return missing
+// malformedHookEntries returns a problem for each hook entry in a settings
+// file that lacks a "matcher" or "hooks" field, naming the event and entry
+// index, e.g. "hooks.Stop[1]: missing matcher". Events are sorted.
+// Unparseable files yield nil (checkSettings reports those).
+func malformedHookEntries(path string) []string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var settings struct {
+		Hooks map[string]json.RawMessage `json:"hooks"`
+	}
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil
+	}
+	events := make([]string, 0, len(settings.Hooks))
+	for event := range settings.Hooks {
+		events = append(events, event)
+	}
+	sort.Strings(events)
+
+	var problems []string
+	for _, event := range events {
+		var entries []json.RawMessage
+		if err := json.Unmarshal(settings.Hooks[event], &entries); err != nil {
+			problems = append(problems, fmt.Sprintf("hooks.%s: not a list of hook entries", event))
+			continue
+		}
+		for i, raw := range entries {
+			var entry map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &entry); err != nil || entry == nil {
+				problems = append(problems, fmt.Sprintf("hooks.%s[%d]: not an object", event, i))
+				continue
+			}
+			var missing []string
+			for _, field := range []string{"matcher", "hooks"} {
+				if _, ok := entry[field]; !ok {
+					missing = append(missing, field)
+				}
+			}
+			if len(missing) > 0 {
+				problems = append(problems, fmt.Sprintf("hooks.%s[%d]: missing %s", event, i, strings.Join(missing, " and ")))
+			}
+		}
+	}
+	return problems
+}
+
+// unknownSettingsKeys returns the top-level keys in a settings file that are
+// not in knownSettingsKeys, sorted. Unreadable files yield nil.
+func unknownSettingsKeys(path string) []string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var settings map[string]json.RawMessage
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil
+	}
+	var unknown []string
+	for key := range settings {
+		if !slices.Contains(knownSettingsKeys, key) {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// sessionStartedNudgesWithoutFrom returns the hook events in a settings file
+// whose commands nudge session-started without --from, leaving the receiver
+// unable to tell which session started. Unreadable files yield nil.
+func sessionStartedNudgesWithoutFrom(path string) []string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	type hookCommand struct {
+		Command string `json:"command"`
+	}
+	var settings struct {
+		Hooks map[string][]struct {
+			Hooks []hookCommand `json:"hooks"`
+		} `json:"hooks"`
+	}
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil
+	}
+	anonymous := func(h hookCommand) bool {
+		return strings.Contains(h.Command, "nudge") && strings.Contains(h.Command, "session-started") &&
+			!strings.Contains(h.Command, "--from")
+	}
+
+	var events []string
+	for event, entries := range settings.Hooks {
+		for _, entry := range entries {
+			if slices.ContainsFunc(entry.Hooks, anonymous) {
+				events = append(events, event)
+				break
+			}
+		}
+	}
+	sort.Strings(events)
+	return events
+}
+
+// requiredHooks are the hook commands every agent's settings must contain:
+// a SessionStart PATH export and a Stop hook running gt costs record.
+var requiredHooks = []struct {
+	name    string // Name used in details, e.g. "Stop hook"
+	hook    string
+	pattern string
+}{
+	{"PATH export", "SessionStart", "PATH="},
+	{"Stop hook", "Stop", "gt costs record"},
+}
+
+// shadowedHooks returns the required hooks that settingsPath provides but
+// localPath, a settings.local.json beside it, overrides without. Claude
+// gives the local file precedence, so an incomplete hooks section there
+// hides the required ones. Returns nil if localPath doesn't set hooks.
+func shadowedHooks(localPath, settingsPath string) []string {
+	readHooks := func(path string) (map[string]any, bool) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, false
+		}
+		var settings map[string]any
+		if err := json.Unmarshal(data, &settings); err != nil {
+			return nil, false
+		}
+		hooks, ok := settings["hooks"].(map[string]any)
+		return hooks, ok
+	}
+
+	localHooks, ok := readHooks(localPath)
+	if !ok {
+		return nil
+	}
+	hooks, ok := readHooks(settingsPath)
+	if !ok {
+		return nil
+	}
+	var shadowed []string
+	for _, req := range requiredHooks {
+		if hookCommandContains(hooks, req.hook, req.pattern) && !hookCommandContains(localHooks, req.hook, req.pattern) {
+			shadowed = append(shadowed, req.name)
+		}
+	}
+	return shadowed
+}
+
+// unknownEnabledPlugins returns the enabledPlugins keys in a settings file
+// that are not in known, sorted. Unreadable files yield nil; checkSettings
+// reports those.
+func unknownEnabledPlugins(path string, known []string) []string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var settings struct {
+		EnabledPlugins json.RawMessage `json:"enabledPlugins"`
+	}
+	if err := json.Unmarshal(data, &settings); err != nil || len(settings.EnabledPlugins) == 0 {
+		return nil
+	}
+
+	// Claude writes enabledPlugins as a name→enabled map; older files use a list
+	var names []string
+	var byName map[string]any
+	if err := json.Unmarshal(settings.EnabledPlugins, &byName); err == nil {
+		for name := range byName {
+			names = append(names, name)
+		}
+	} else if err := json.Unmarshal(settings.EnabledPlugins, &names); err != nil {
+		return nil
+	}
+
+	knownSet := make(map[string]bool, len(known))
+	for _, k := range known {
+		knownSet[k] = true
+	}
+	var unknown []string
+	for _, name := range names {
+		if !knownSet[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// findDuplicateHooks returns a description of each hook command in the file
+// that repeats an earlier entry for the same event and matcher.
+func (c *ClaudeSettingsCheck) findDuplicateHooks(path string) []string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var actual map[string]any
+	if err := json.Unmarshal(data, &actual); err != nil {
+		return nil
+	}
+	hooks, ok := actual["hooks"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	return dedupeHooks(hooks)
+}
+
+// dedupeHooks removes hook entries whose command repeats an earlier entry for
+// the same event and matcher, keeping the first occurrence and preserving order.
+// Matcher entries left with no hooks are dropped. hooks is modified in place.
+// Returns a description of each removed entry.
+func dedupeHooks(hooks map[string]any) []string {
+	var removed []string
+
+	events := make([]string, 0, len(hooks))
+	for event := range hooks {
+		events = append(events, event)
+	}
+	sort.Strings(events)
+
+	for _, event := range events {
+		hookList, ok := hooks[event].([]any)
+		if !ok {
+			continue
+		}
+
+		seen := make(map[string]bool)
+		keptEntries := make([]any, 0, len(hookList))
+		for _, entry := range hookList {
+			entryMap, ok := entry.(map[string]any)
+			if !ok {
+				keptEntries = append(keptEntries, entry)
+				continue
+			}
+			innerHooks, ok := entryMap["hooks"].([]any)
+			if !ok {
+				keptEntries = append(keptEntries, entry)
+				continue
+			}
+
+			matcher, _ := entryMap["matcher"].(string)
+			keptInner := make([]any, 0, len(innerHooks))
+			for _, inner := range innerHooks {
+				innerMap, ok := inner.(map[string]any)
+				cmd, isCmd := innerMap["command"].(string)
+				if !ok || !isCmd {
+					keptInner = append(keptInner, inner)
+					continue
+				}
+				key := matcher + "\x00" + cmd
+				if seen[key] {
+					removed = append(removed, fmt.Sprintf("%s: %q", event, cmd))
+					continue
+				}
+				seen[key] = true
+				keptInner = append(keptInner, inner)
+			}
+
+			if len(keptInner) == 0 {
+				continue
+			}
+			entryMap["hooks"] = keptInner
+			keptEntries = append(keptEntries, entryMap)
+		}
+		hooks[event] = keptEntries
+	}
+
+	return removed
+}
+
+// backupSettings copies a settings file to path+settingsBackupSuffix before
+// Fix rewrites it, so a bad merge or an interrupted write can be recovered.
+// An existing backup is kept: it holds the oldest, unreviewed original.
+func backupSettings(path string, data []byte, perm os.FileMode) error {
+	backup := path + settingsBackupSuffix
+	if fileExists(backup) {
+		return nil
+	}
+	return os.WriteFile(backup, data, perm)
+}
+
+// backupSettingsFile backs up the settings file at path, as it is on disk,
+// to backupOf+settingsBackupSuffix.
+func backupSettingsFile(path, backupOf string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(backupOf), 0755); err != nil {
+		return err
+	}
+	return backupSettings(backupOf, data, info.Mode().Perm())
+}
+
+// removeDuplicateHooks rewrites a settings file with duplicate hooks removed.
+// The original is backed up first.
+func removeDuplicateHooks(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var actual map[string]any
+	if err := json.Unmarshal(data, &actual); err != nil {
+		return err
+	}
+	hooks, ok := actual["hooks"].(map[string]any)
+	if !ok || len(dedupeHooks(hooks)) == 0 {
+		return nil
+	}
+
+	// Don't HTML-escape: hook commands routinely contain && and >.
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(actual); err != nil {
+		return err
+	}
+	if err := backupSettings(path, data, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("backing up: %w", err)
+	}
+	return os.WriteFile(path, buf.Bytes(), info.Mode().Perm())
 }
 
 // getGitFileStatus determines the git status of a file.
@@ -620,6 +1183,12 @@ func (c *ClaudeSettingsCheck) getGitFileStatus(filePath string) gitFileStatus {
 
 // hookHasPattern checks if a hook contains a specific pattern.
 func (c *ClaudeSettingsCheck) hookHasPattern(hooks map[string]any, hookName, pattern string) bool {
+	return hookCommandContains(hooks, hookName, pattern)
+}
+
+// hookCommandContains reports whether any command registered for hookName
+// in a settings.json "hooks" object contains pattern.
+func hookCommandContains(hooks map[string]any, hookName, pattern string) bool {
 	hookList, ok := hooks[hookName].([]any)
 	if !ok {
 		return false
@@ -648,17 +1217,56 @@ func (c *ClaudeSettingsCheck) hookHasPattern(hooks map[string]any, hookName, pat
 	return false
 }
 
-// Fix deletes stale settings files. Agents auto-install correct settings on restart.
+// SupportsDryRun reports that Fix honors ctx.DryRun.
+func (c *ClaudeSettingsCheck) SupportsDryRun() bool {
+	return true
+}
+
+// Fix deletes stale settings files, after backing each up. Agents auto-install
+// correct settings on restart. Files whose only problem is duplicate hooks are
+// deduplicated in place instead, also after backing up the original. Backups
+// are only deleted with ctx.RemoveBackups.
 // Files with local modifications are skipped to avoid losing user changes.
+// In dry-run mode, the files that would be deleted are printed instead.
 func (c *ClaudeSettingsCheck) Fix(ctx *CheckContext) error {
+	out := ctx.Output()
 	var errors []string
 	var skipped []string
 	var needsRestart bool
 	t := tmux.NewTmux()
 
+	for _, backup := range c.backups {
+		switch {
+		case !ctx.RemoveBackups:
+			skipped = append(skipped, fmt.Sprintf("%s: review it, then use --remove-backups to delete it", backup))
+		case ctx.DryRun:
+			fmt.Fprintf(out, "  Would delete backup: %s\n", backup)
+		default:
+			if err := os.Remove(backup); err != nil {
+				errors = append(errors, fmt.Sprintf("failed to delete %s: %v", backup, err))
+				continue
+			}
+			fmt.Fprintf(out, "  Deleted backup: %s\n", backup)
+		}
+	}
+
 	for _, sf := range c.staleSettings {
+		// Files whose only problem is duplicate hooks are deduplicated in place
+		if len(sf.duplicateHooks) > 0 && !sf.wrongLocation && len(sf.missing) == 0 && sf.schemaProblem == "" && len(sf.malformedHooks) == 0 {
+			if ctx.DryRun {
+				fmt.Fprintf(out, "  Would remove %d duplicate hook(s): %s\n", len(sf.duplicateHooks), sf.path)
+				continue
+			}
+			if err := removeDuplicateHooks(sf.path); err != nil {
+				errors = append(errors, fmt.Sprintf("failed to dedupe hooks in %s: %v", sf.path, err))
+				continue
+			}
+			fmt.Fprintf(out, "  Removed %d duplicate hook(s): %s\n", len(sf.duplicateHooks), sf.path)
+			continue
+		}
+
 		// Skip files that aren't stale (correct settings.json files)
-		if !sf.wrongLocation && len(sf.missing) == 0 {
+		if !sf.wrongLocation && len(sf.missing) == 0 && sf.schemaProblem == "" && len(sf.malformedHooks) == 0 {
 			continue
 		}
 
@@ -678,12 +1286,28 @@ func (c *ClaudeSettingsCheck) Fix(ctx *CheckContext) error {
 			continue
 		}
 
-		// Delete the stale settings file
+		if ctx.DryRun {
+			fmt.Fprintf(out, "  Would delete stale: %s\n", sf.path)
+			continue
+		}
+
+		// Delete the stale settings file, keeping a backup of it. Town-root
+		// files move to mayor/, so their backup goes there too and the town
+		// root is left clean.
+		townRootFile := sf.agentType == "mayor" && !strings.Contains(sf.path, "/mayor/")
+		backupOf := sf.path
+		if townRootFile {
+			backupOf = filepath.Join(ctx.TownRoot, "mayor", ".claude", filepath.Base(sf.path))
+		}
+		if err := backupSettingsFile(sf.path, backupOf); err != nil {
+			errors = append(errors, fmt.Sprintf("failed to back up %s: %v", sf.path, err))
+			continue
+		}
 		if err := os.Remove(sf.path); err != nil {
 			errors = append(errors, fmt.Sprintf("failed to delete %s: %v", sf.path, err))
 			continue
 		}
-		fmt.Printf("  Deleted stale: %s\n", sf.path)
+		fmt.Fprintf(out, "  Deleted stale: %s (backup: %s)\n", sf.path, backupOf+settingsBackupSuffix)
 		needsRestart = true
 
 		// Also delete parent .claude directory if empty
@@ -693,7 +1317,7 @@ func (c *ClaudeSettingsCheck) Fix(ctx *CheckContext) error {
 		// Handle town-root files: redirect to mayor/ instead of recreating at root.
 		// Town-root settings pollute ALL agents via directory traversal.
 		// This handles both settings.json and settings.local.json at the town root.
-		if sf.agentType == "mayor" && !strings.Contains(sf.path, "/mayor/") {
+		if townRootFile {
 			mayorDir := filepath.Join(ctx.TownRoot, "mayor")
 
 			if strings.HasSuffix(claudeDir, ".claude") {
@@ -707,8 +1331,8 @@ func (c *ClaudeSettingsCheck) Fix(ctx *CheckContext) error {
 			// Town-root files were inherited by ALL agents via directory traversal.
 			// Warn user to restart agents - don't auto-kill sessions as that's too disruptive,
 			// especially since deacon runs gt doctor automatically which would create a loop.
-			fmt.Printf("\n  %s Town-root settings were moved. Restart agents to pick up new config:\n", style.Warning.Render("⚠"))
-			fmt.Printf("      gt up --restart\n\n")
+			fmt.Fprintf(out, "\n  %s Town-root settings were moved. Restart agents to pick up new config:\n", style.Warning.Render("⚠"))
+			fmt.Fprintf(out, "      gt up --restart\n\n")
 			continue
 		}
 
@@ -754,15 +1378,15 @@ func (c *ClaudeSettingsCheck) Fix(ctx *CheckContext) error {
 	// Report skipped files as warnings, not errors
 	if len(skipped) > 0 {
 		for _, s := range skipped {
-			fmt.Printf("  Warning: %s\n", s)
+			fmt.Fprintf(out, "  Warning: %s\n", s)
 		}
 	}
 
 	// Tell user to restart agents so they create correct settings
 	if needsRestart && !ctx.RestartSessions {
-		fmt.Printf("\n  %s Restart agents to create new settings:\n", style.Warning.Render("⚠"))
-		fmt.Printf("      gt up --restart\n")
-		fmt.Printf("\n  If you had custom Claude settings edits, re-apply them via 'gt hooks override <role>'.\n\n")
+		fmt.Fprintf(out, "\n  %s Restart agents to create new settings:\n", style.Warning.Render("⚠"))
+		fmt.Fprintf(out, "      gt up --restart\n")
+		fmt.Fprintf(out, "\n  If you had custom Claude settings edits, re-apply them via 'gt hooks override <role>'.\n\n")
 	}
 
 	if len(errors) > 0 {
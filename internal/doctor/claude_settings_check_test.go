@@ -1,6 +1,7 @@
 package doctor
 
 import (
+	"bytes"
 	"encoding/json"
 	"os"
 	"os/exec"
@@ -28,6 +29,7 @@ func TestClaudeSettingsCheck_NoSettingsFiles(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusOK {
 		t.Errorf("expected StatusOK when no settings files, got %v", result.Status)
@@ -40,7 +42,7 @@ func createValidSettings(t *testing.T, path string) {
 	t.Helper()
 
 	settings := map[string]any{
-		"enabledPlugins": []string{"plugin1"},
+		"enabledPlugins": []string{"beads@beads-marketplace"},
 		"hooks": map[string]any{
 			"SessionStart": []any{
 				map[string]any{
@@ -86,7 +88,7 @@ func createStaleSettings(t *testing.T, path string, missingElements ...string) {
 	t.Helper()
 
 	settings := map[string]any{
-		"enabledPlugins": []string{"plugin1"},
+		"enabledPlugins": []string{"beads@beads-marketplace"},
 		"hooks": map[string]any{
 			"SessionStart": []any{
 				map[string]any{
@@ -166,6 +168,7 @@ func TestClaudeSettingsCheck_ValidMayorSettings(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusOK {
 		t.Errorf("expected StatusOK for valid settings, got %v: %s", result.Status, result.Message)
@@ -183,6 +186,7 @@ func TestClaudeSettingsCheck_ValidDeaconSettings(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusOK {
 		t.Errorf("expected StatusOK for valid deacon settings, got %v: %s", result.Status, result.Message)
@@ -202,6 +206,7 @@ func TestClaudeSettingsCheck_ValidWitnessSettings(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusOK {
 		t.Errorf("expected StatusOK for valid witness settings, got %v: %s", result.Status, result.Message)
@@ -221,6 +226,7 @@ func TestClaudeSettingsCheck_ValidRefinerySettings(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusOK {
 		t.Errorf("expected StatusOK for valid refinery settings, got %v: %s", result.Status, result.Message)
@@ -240,6 +246,7 @@ func TestClaudeSettingsCheck_ValidCrewSettings(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusOK {
 		t.Errorf("expected StatusOK for valid crew settings, got %v: %s", result.Status, result.Message)
@@ -259,6 +266,7 @@ func TestClaudeSettingsCheck_ValidPolecatSettings(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusOK {
 		t.Errorf("expected StatusOK for valid polecat settings, got %v: %s", result.Status, result.Message)
@@ -276,6 +284,7 @@ func TestClaudeSettingsCheck_MissingEnabledPlugins(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for missing enabledPlugins, got %v", result.Status)
@@ -296,6 +305,7 @@ func TestClaudeSettingsCheck_MissingHooks(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for missing hooks, got %v", result.Status)
@@ -313,13 +323,14 @@ func TestClaudeSettingsCheck_MissingPATH(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for missing PATH, got %v", result.Status)
 	}
 	found := false
 	for _, d := range result.Details {
-		if strings.Contains(d, "PATH export") {
+		if strings.HasPrefix(d, filepath.Join("mayor", ".claude", "settings.json")+": ") && strings.Contains(d, "PATH export") {
 			found = true
 			break
 		}
@@ -340,6 +351,7 @@ func TestClaudeSettingsCheck_MissingStopHook(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for missing Stop hook, got %v", result.Status)
@@ -369,6 +381,7 @@ func TestClaudeSettingsCheck_WrongLocationWitness(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for wrong location, got %v", result.Status)
@@ -398,6 +411,7 @@ func TestClaudeSettingsCheck_WrongLocationRefinery(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for wrong location, got %v", result.Status)
@@ -435,6 +449,7 @@ func TestClaudeSettingsCheck_MultipleStaleFiles(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for multiple stale files, got %v", result.Status)
@@ -462,6 +477,7 @@ func TestClaudeSettingsCheck_InvalidJSON(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for invalid JSON, got %v", result.Status)
@@ -490,6 +506,7 @@ func TestClaudeSettingsCheck_FixDeletesStaleFile(t *testing.T) {
 
 	// Run to detect - should find stale file AND missing settings.json
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 	if result.Status != StatusError {
 		t.Fatalf("expected StatusError before fix, got %v", result.Status)
 	}
@@ -507,11 +524,220 @@ func TestClaudeSettingsCheck_FixDeletesStaleFile(t *testing.T) {
 	// After fix, settings.json is recreated at the correct location by EnsureSettingsForRole.
 	// The check should now pass since the correct file exists.
 	result = check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 	if result.Status != StatusOK {
 		t.Errorf("expected StatusOK after fix (settings recreated at correct location), got %v: %v", result.Status, result.Details)
 	}
 }
 
+func TestClaudeSettingsCheck_FixBacksUpRecreatedFile(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	// Right location but missing hooks: deleted and recreated from the template
+	mayorSettings := filepath.Join(tmpDir, "mayor", ".claude", "settings.json")
+	createStaleSettings(t, mayorSettings, "hooks")
+	original, err := os.ReadFile(mayorSettings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check := NewClaudeSettingsCheck()
+	ctx := &CheckContext{TownRoot: tmpDir}
+	check.Run(ctx)
+	if err := check.Fix(ctx); err != nil {
+		t.Fatalf("Fix failed: %v", err)
+	}
+
+	backup, err := os.ReadFile(mayorSettings + ".bak")
+	if err != nil {
+		t.Fatalf("expected settings.json.bak after recreate: %v", err)
+	}
+	if !bytes.Equal(backup, original) {
+		t.Errorf("backup = %s, want the original file", backup)
+	}
+}
+
+func TestClaudeSettingsCheck_FixDryRunKeepsStaleFile(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	staleSettings := filepath.Join(tmpDir, "mayor", ".claude", "settings.local.json")
+	createValidSettings(t, staleSettings)
+
+	check := NewClaudeSettingsCheck()
+	ctx := &CheckContext{TownRoot: tmpDir, DryRun: true}
+
+	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
+	if result.Status != StatusError {
+		t.Fatalf("expected StatusError before fix, got %v", result.Status)
+	}
+
+	if err := check.Fix(ctx); err != nil {
+		t.Fatalf("Fix failed: %v", err)
+	}
+
+	// Dry run must not delete anything or recreate settings
+	if _, err := os.Stat(staleSettings); err != nil {
+		t.Errorf("expected stale settings.local.json to survive dry run: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "mayor", ".claude", "settings.json")); !os.IsNotExist(err) {
+		t.Error("expected settings.json not to be created during dry run")
+	}
+}
+
+// duplicateStopHook appends a second copy of the Stop hook entry to a settings file,
+// as happens when a fix tool is run repeatedly.
+func duplicateStopHook(t *testing.T, path string) {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var settings map[string]any
+	if err := json.Unmarshal(data, &settings); err != nil {
+		t.Fatal(err)
+	}
+	hooks := settings["hooks"].(map[string]any)
+	stop := hooks["Stop"].([]any)
+	hooks["Stop"] = append(stop, stop[0])
+
+	data, err = json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClaudeSettingsCheck_DuplicateHooksWarn(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	mayorSettings := filepath.Join(tmpDir, "mayor", ".claude", "settings.json")
+	createValidSettings(t, mayorSettings)
+	duplicateStopHook(t, mayorSettings)
+
+	check := NewClaudeSettingsCheck()
+	result := check.Run(&CheckContext{TownRoot: tmpDir})
+	assertDetailPaths(t, tmpDir, result)
+
+	if result.Status != StatusWarning {
+		t.Fatalf("expected StatusWarning for duplicate hooks, got %v: %s", result.Status, result.Message)
+	}
+	if len(result.Details) != 1 || !strings.Contains(result.Details[0], "gt costs record") {
+		t.Errorf("expected one detail naming the duplicate command, got %v", result.Details)
+	}
+}
+
+func TestClaudeSettingsCheck_FixDedupesHooks(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	mayorSettings := filepath.Join(tmpDir, "mayor", ".claude", "settings.json")
+	createValidSettings(t, mayorSettings)
+	duplicateStopHook(t, mayorSettings)
+
+	check := NewClaudeSettingsCheck()
+	ctx := &CheckContext{TownRoot: tmpDir}
+	check.Run(ctx)
+
+	if err := check.Fix(ctx); err != nil {
+		t.Fatalf("Fix failed: %v", err)
+	}
+
+	// File is kept, not deleted, and the original is backed up
+	if _, err := os.Stat(mayorSettings); err != nil {
+		t.Fatalf("expected settings.json to survive dedupe: %v", err)
+	}
+	if _, err := os.Stat(mayorSettings + ".bak"); err != nil {
+		t.Fatalf("expected settings.json.bak after dedupe: %v", err)
+	}
+
+	// The backup is reported until it's explicitly removed
+	result := check.Run(ctx)
+	if result.Status != StatusWarning || len(result.Details) != 1 || !strings.Contains(result.Details[0], "settings.json.bak") {
+		t.Fatalf("expected a backup warning after fix, got %v: %v", result.Status, result.Details)
+	}
+	if err := check.Fix(ctx); err != nil {
+		t.Fatalf("Fix failed: %v", err)
+	}
+	if _, err := os.Stat(mayorSettings + ".bak"); err != nil {
+		t.Fatalf("Fix without RemoveBackups deleted the backup: %v", err)
+	}
+
+	ctx.RemoveBackups = true
+	if err := check.Fix(ctx); err != nil {
+		t.Fatalf("Fix failed: %v", err)
+	}
+	if result := check.Run(ctx); result.Status != StatusOK {
+		t.Errorf("expected StatusOK after removing backups, got %v: %v", result.Status, result.Details)
+	}
+}
+
+func TestClaudeSettingsCheck_BackupKeepsOriginal(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	mayorSettings := filepath.Join(tmpDir, "mayor", ".claude", "settings.json")
+	createValidSettings(t, mayorSettings)
+	duplicateStopHook(t, mayorSettings)
+	original, err := os.ReadFile(mayorSettings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := removeDuplicateHooks(mayorSettings); err != nil {
+		t.Fatal(err)
+	}
+	backup, err := os.ReadFile(mayorSettings + ".bak")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(backup, original) {
+		t.Error("backup does not match the file before the fix")
+	}
+
+	// A second rewrite keeps the oldest, unreviewed backup
+	duplicateStopHook(t, mayorSettings)
+	if err := removeDuplicateHooks(mayorSettings); err != nil {
+		t.Fatal(err)
+	}
+	if backup, _ := os.ReadFile(mayorSettings + ".bak"); !bytes.Equal(backup, original) {
+		t.Error("second fix overwrote the existing backup")
+	}
+}
+
+func TestDedupeHooks_KeepsFirstInOrder(t *testing.T) {
+	hook := func(cmd string) any {
+		return map[string]any{"type": "command", "command": cmd}
+	}
+	hooks := map[string]any{
+		"SessionStart": []any{
+			map[string]any{"matcher": "", "hooks": []any{hook("a"), hook("b"), hook("a")}},
+			map[string]any{"matcher": "", "hooks": []any{hook("b")}},
+			map[string]any{"matcher": "", "hooks": []any{hook("c")}},
+		},
+	}
+
+	removed := dedupeHooks(hooks)
+	if len(removed) != 2 {
+		t.Errorf("expected 2 removed entries, got %v", removed)
+	}
+
+	entries := hooks["SessionStart"].([]any)
+	if len(entries) != 2 {
+		t.Fatalf("expected emptied matcher entry to be dropped, got %d entries", len(entries))
+	}
+	var cmds []string
+	for _, e := range entries {
+		for _, h := range e.(map[string]any)["hooks"].([]any) {
+			cmds = append(cmds, h.(map[string]any)["command"].(string))
+		}
+	}
+	if strings.Join(cmds, ",") != "a,b,c" {
+		t.Errorf("expected commands a,b,c in order, got %v", cmds)
+	}
+}
+
 func TestClaudeSettingsCheck_SkipsNonRigDirectories(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -560,6 +786,7 @@ func TestClaudeSettingsCheck_MixedValidAndStale(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for mixed valid/stale, got %v", result.Status)
@@ -586,6 +813,7 @@ func TestClaudeSettingsCheck_WrongLocationCrew(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for wrong location, got %v", result.Status)
@@ -615,6 +843,7 @@ func TestClaudeSettingsCheck_WrongLocationPolecat(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for wrong location, got %v", result.Status)
@@ -689,6 +918,7 @@ func TestClaudeSettingsCheck_GitStatusUntracked(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for wrong location, got %v", result.Status)
@@ -726,6 +956,7 @@ func TestClaudeSettingsCheck_GitStatusTrackedClean(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	// Tracked settings.json in a worktree is the customer's legitimate project config.
 	// It should NOT be flagged as stale or wrong-location.
@@ -762,6 +993,7 @@ func TestClaudeSettingsCheck_GitStatusTrackedModified(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	// Tracked settings.json (even modified) in a worktree is the customer's project config.
 	// It should NOT be flagged as stale or wrong-location.
@@ -828,6 +1060,7 @@ func TestClaudeSettingsCheck_FixDeletesUntrackedFiles(t *testing.T) {
 
 	// Run to detect
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 	if result.Status != StatusError {
 		t.Fatalf("expected StatusError before fix, got %v", result.Status)
 	}
@@ -905,6 +1138,7 @@ func TestClaudeSettingsCheck_GitIgnoredFilesNotFlagged(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	// Should pass because the file is properly gitignored
 	if result.Status != StatusOK {
@@ -946,6 +1180,7 @@ func TestClaudeSettingsCheck_TownRootSettingsWarnsInsteadOfKilling(t *testing.T)
 
 	// Run to detect
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 	if result.Status != StatusError {
 		t.Fatalf("expected StatusError for town root settings, got %v", result.Status)
 	}
@@ -997,6 +1232,7 @@ func TestClaudeSettingsCheck_MissingWitnessSettings(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for missing witness settings, got %v", result.Status)
@@ -1052,6 +1288,7 @@ func TestClaudeSettingsCheck_MissingRefinerySettings(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for missing refinery settings, got %v", result.Status)
@@ -1088,6 +1325,7 @@ func TestClaudeSettingsCheck_MissingCrewSettings(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for missing crew settings, got %v", result.Status)
@@ -1119,6 +1357,7 @@ func TestClaudeSettingsCheck_MissingPolecatSettings(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for missing polecat settings, got %v", result.Status)
@@ -1156,6 +1395,7 @@ func TestClaudeSettingsCheck_MissingMultipleAgentSettings(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for missing settings, got %v", result.Status)
@@ -1201,6 +1441,7 @@ func TestClaudeSettingsCheck_MixedMissingAndStale(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for mixed issues, got %v", result.Status)
@@ -1246,6 +1487,7 @@ func TestClaudeSettingsCheck_MissingFileOnlyMessage(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	if result.Status != StatusError {
 		t.Errorf("expected StatusError for missing settings, got %v", result.Status)
@@ -1277,6 +1519,7 @@ func TestClaudeSettingsCheck_NoMissingFileWhenDirNotExists(t *testing.T) {
 	ctx := &CheckContext{TownRoot: tmpDir}
 
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 
 	// Should be OK - no settings issues if witness directory doesn't exist
 	if result.Status != StatusOK {
@@ -1299,6 +1542,7 @@ func TestClaudeSettingsCheck_FixDoesNotDeleteMissingFiles(t *testing.T) {
 
 	// Run to detect
 	result := check.Run(ctx)
+	assertDetailPaths(t, ctx.TownRoot, result)
 	if result.Status != StatusError {
 		t.Fatalf("expected StatusError before fix, got %v", result.Status)
 	}
@@ -1314,3 +1558,251 @@ func TestClaudeSettingsCheck_FixDoesNotDeleteMissingFiles(t *testing.T) {
 		t.Error("expected witness directory to still exist after fix")
 	}
 }
+
+// assertDetailPaths checks that every detail is prefixed with the path of the
+// offending file, relative to the town root.
+func assertDetailPaths(t *testing.T, townRoot string, result *CheckResult) {
+	t.Helper()
+	for _, d := range result.Details {
+		path, _, ok := strings.Cut(d, ": ")
+		if !ok || path == "" {
+			t.Errorf("detail %q has no file path prefix", d)
+			continue
+		}
+		if filepath.IsAbs(path) || strings.HasPrefix(path, "..") || strings.Contains(path, townRoot) {
+			t.Errorf("detail %q: path %q is not relative to the town root", d, path)
+		}
+	}
+}
+
+func TestClaudeSettingsCheck_UnknownPluginWarns(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	mayorSettings := filepath.Join(tmpDir, "mayor", ".claude", "settings.json")
+	createValidSettings(t, mayorSettings)
+	data, err := os.ReadFile(mayorSettings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var settings map[string]any
+	if err := json.Unmarshal(data, &settings); err != nil {
+		t.Fatal(err)
+	}
+	settings["enabledPlugins"] = map[string]any{"beads@beads-marketplace": false, "myplugin": true}
+	data, err = json.Marshal(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(mayorSettings, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	check := NewClaudeSettingsCheck()
+	result := check.Run(&CheckContext{TownRoot: tmpDir})
+	assertDetailPaths(t, tmpDir, result)
+
+	if result.Status != StatusWarning {
+		t.Fatalf("expected StatusWarning for unknown plugin, got %v: %s", result.Status, result.Message)
+	}
+	want := "enabledPlugins: unknown plugin 'myplugin' (known: beads@beads-marketplace)"
+	if len(result.Details) != 1 || !strings.Contains(result.Details[0], want) {
+		t.Errorf("expected detail %q, got %v", want, result.Details)
+	}
+
+	// A town allowlist makes the plugin known
+	allowlist := filepath.Join(tmpDir, "settings", "known-plugins.json")
+	if err := os.MkdirAll(filepath.Dir(allowlist), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(allowlist, []byte(`["beads@beads-marketplace", "myplugin"]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	result = check.Run(&CheckContext{TownRoot: tmpDir})
+	if result.Status != StatusOK {
+		t.Errorf("expected StatusOK with allowlisted plugin, got %v: %v", result.Status, result.Details)
+	}
+}
+
+func TestClaudeSettingsCheck_UnknownKeysWarn(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	mayorSettings := filepath.Join(tmpDir, "mayor", ".claude", "settings.json")
+	createValidSettings(t, mayorSettings)
+	setSettingsKeys(t, mayorSettings, map[string]any{"hookz": map[string]any{}, "permissions": map[string]any{}, "futureKey": true})
+
+	check := NewClaudeSettingsCheck()
+	result := check.Run(&CheckContext{TownRoot: tmpDir})
+	assertDetailPaths(t, tmpDir, result)
+
+	if result.Status != StatusWarning {
+		t.Fatalf("expected StatusWarning for unknown keys, got %v: %s", result.Status, result.Message)
+	}
+	want := "unknown top-level key(s): futureKey, hookz"
+	if len(result.Details) != 1 || !strings.HasSuffix(result.Details[0], want) {
+		t.Errorf("expected detail ending %q, got %v", want, result.Details)
+	}
+}
+
+func TestClaudeSettingsCheck_SchemaVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		version any
+		want    CheckStatus
+	}{
+		{"supported", minSettingsSchemaVersion, StatusOK},
+		{"newer", minSettingsSchemaVersion + 1, StatusOK},
+		{"too old", minSettingsSchemaVersion - 1, StatusError},
+		{"not a number", "v1", StatusError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			mayorSettings := filepath.Join(tmpDir, "mayor", ".claude", "settings.json")
+			createValidSettings(t, mayorSettings)
+			setSettingsKeys(t, mayorSettings, map[string]any{"schemaVersion": tt.version})
+
+			check := NewClaudeSettingsCheck()
+			result := check.Run(&CheckContext{TownRoot: tmpDir})
+			if result.Status != tt.want {
+				t.Fatalf("status = %v, want %v: %s %v", result.Status, tt.want, result.Message, result.Details)
+			}
+			if tt.want == StatusError {
+				if len(result.Details) != 1 || !strings.Contains(result.Details[0], "schemaVersion") {
+					t.Errorf("expected a schemaVersion detail, got %v", result.Details)
+				}
+				if len(check.staleSettings) != 1 || check.staleSettings[0].schemaProblem == "" {
+					t.Errorf("expected the file to be marked stale for Fix, got %+v", check.staleSettings)
+				}
+			}
+		})
+	}
+}
+
+func TestClaudeSettingsCheck_SessionStartedNudgeFrom(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		want    CheckStatus
+	}{
+		{"without --from", "gt nudge deacon session-started", StatusWarning},
+		{"with --from", `gt nudge deacon session-started --from "$GT_ROLE"`, StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			mayorSettings := filepath.Join(tmpDir, "mayor", ".claude", "settings.json")
+			createValidSettings(t, mayorSettings)
+			command := func(cmd string) map[string]any {
+				return map[string]any{"matcher": "**", "hooks": []any{map[string]any{"type": "command", "command": cmd}}}
+			}
+			setSettingsKeys(t, mayorSettings, map[string]any{"hooks": map[string]any{
+				"SessionStart": []any{command("export PATH=/usr/local/bin:$PATH"), command(tt.command)},
+				"Stop":         []any{command("gt costs record --session $CLAUDE_SESSION_ID")},
+			}})
+
+			check := NewClaudeSettingsCheck()
+			result := check.Run(&CheckContext{TownRoot: tmpDir})
+			if result.Status != tt.want {
+				t.Fatalf("status = %v, want %v: %s %v", result.Status, tt.want, result.Message, result.Details)
+			}
+			if tt.want == StatusWarning {
+				assertDetailPaths(t, tmpDir, result)
+				want := "SessionStart hook nudges session-started without --from"
+				if len(result.Details) != 1 || !strings.HasSuffix(result.Details[0], want) {
+					t.Errorf("expected detail ending %q, got %v", want, result.Details)
+				}
+			}
+		})
+	}
+}
+
+func TestClaudeSettingsCheck_MalformedHookEntries(t *testing.T) {
+	tmpDir := t.TempDir()
+	mayorSettings := filepath.Join(tmpDir, "mayor", ".claude", "settings.json")
+	createValidSettings(t, mayorSettings)
+	command := func(cmd string) []any {
+		return []any{map[string]any{"type": "command", "command": cmd}}
+	}
+	setSettingsKeys(t, mayorSettings, map[string]any{"hooks": map[string]any{
+		"SessionStart": []any{
+			map[string]any{"matcher": "", "hooks": command("export PATH=/usr/local/bin:$PATH")},
+			map[string]any{"hooks": command("gt prime")},
+		},
+		"Stop": []any{
+			map[string]any{"matcher": "", "hooks": command("gt costs record --session $CLAUDE_SESSION_ID")},
+			map[string]any{"type": "command"},
+		},
+	}})
+
+	check := NewClaudeSettingsCheck()
+	result := check.Run(&CheckContext{TownRoot: tmpDir})
+	if result.Status != StatusError {
+		t.Fatalf("status = %v, want StatusError: %s %v", result.Status, result.Message, result.Details)
+	}
+	assertDetailPaths(t, tmpDir, result)
+	want := []string{"hooks.SessionStart[1]: missing matcher", "hooks.Stop[1]: missing matcher and hooks"}
+	if len(result.Details) != len(want) {
+		t.Fatalf("details = %v, want %d entries", result.Details, len(want))
+	}
+	for i, w := range want {
+		if !strings.HasSuffix(result.Details[i], w) {
+			t.Errorf("detail %d = %q, want suffix %q", i, result.Details[i], w)
+		}
+	}
+}
+
+// setSettingsKeys sets top-level keys in a settings file.
+func setSettingsKeys(t *testing.T, path string, keys map[string]any) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var settings map[string]any
+	if err := json.Unmarshal(data, &settings); err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range keys {
+		settings[k] = v
+	}
+	data, err = json.Marshal(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClaudeSettingsCheck_LocalSettingsShadowHooks(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	createValidSettings(t, filepath.Join(tmpDir, "mayor", ".claude", "settings.json"))
+	createStaleSettings(t, filepath.Join(tmpDir, "mayor", ".claude", "settings.local.json"), "Stop")
+
+	// Without a settings.json beside it, the local file must be complete
+	createStaleSettings(t, filepath.Join(tmpDir, "deacon", ".claude", "settings.local.json"), "PATH")
+
+	check := NewClaudeSettingsCheck()
+	result := check.Run(&CheckContext{TownRoot: tmpDir})
+	assertDetailPaths(t, tmpDir, result)
+
+	if result.Status != StatusError {
+		t.Fatalf("expected StatusError, got %v: %s", result.Status, result.Message)
+	}
+	var mayorDetail, deaconDetail string
+	for _, d := range result.Details {
+		switch {
+		case strings.HasPrefix(d, filepath.Join("mayor", ".claude", "settings.local.json")):
+			mayorDetail = d
+		case strings.HasPrefix(d, filepath.Join("deacon", ".claude", "settings.local.json")):
+			deaconDetail = d
+		}
+	}
+	if !strings.HasSuffix(mayorDetail, "; shadows Stop hook from settings.json") {
+		t.Errorf("mayor detail = %q, want it to report the shadowed Stop hook", mayorDetail)
+	}
+	if !strings.HasSuffix(deaconDetail, "; missing PATH export") {
+		t.Errorf("deacon detail = %q, want it to report the missing PATH export", deaconDetail)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/steveyegge/gastown/internal/constants"
+	"github.com/steveyegge/gastown/internal/rig"
 )
 
 // SettingsCheck verifies each rig has a settings/ directory.
@@ -33,7 +34,7 @@ func NewSettingsCheck() *SettingsCheck {
 
 // Run checks if all rigs have a settings/ directory.
 func (c *SettingsCheck) Run(ctx *CheckContext) *CheckResult {
-	rigs := c.findRigs(ctx.TownRoot)
+	rigs := rigsInScope(ctx)
 	if len(rigs) == 0 {
 		return &CheckResult{
 			Name:    c.Name(),
@@ -123,7 +124,7 @@ func (c *RuntimeGitignoreCheck) Run(ctx *CheckContext) *CheckResult {
 	}
 
 	// Check each rig's .gitignore (in their git worktrees)
-	rigs := c.findRigs(ctx.TownRoot)
+	rigs := rigsInScope(ctx)
 	for _, rig := range rigs {
 		// Check crew members
 		crewPath := filepath.Join(rig, "crew")
@@ -179,11 +180,6 @@ func (c *RuntimeGitignoreCheck) containsPattern(gitignorePath, pattern string) b
 	return false
 }
 
-// findRigs returns rig directories within the town.
-func (c *RuntimeGitignoreCheck) findRigs(townRoot string) []string {
-	return findAllRigs(townRoot)
-}
-
 // LegacyGastownCheck warns if old .gastown/ directories still exist.
 type LegacyGastownCheck struct {
 	FixableCheck
@@ -214,7 +210,7 @@ func (c *LegacyGastownCheck) Run(ctx *CheckContext) *CheckResult {
 	}
 
 	// Check each rig for .gastown/
-	rigs := c.findRigs(ctx.TownRoot)
+	rigs := rigsInScope(ctx)
 	for _, rig := range rigs {
 		rigGastown := filepath.Join(rig, ".gastown")
 		if info, err := os.Stat(rigGastown); err == nil && info.IsDir() {
@@ -262,16 +258,6 @@ func (c *LegacyGastownCheck) Fix(ctx *CheckContext) error {
 	return nil
 }
 
-// findRigs returns rig directories within the town.
-func (c *LegacyGastownCheck) findRigs(townRoot string) []string {
-	return findAllRigs(townRoot)
-}
-
-// findRigs returns rig directories within the town.
-func (c *SettingsCheck) findRigs(townRoot string) []string {
-	return findAllRigs(townRoot)
-}
-
 // SessionHookCheck verifies settings.json files use proper session_id passthrough.
 // Valid options: session-start.sh wrapper OR 'gt prime --hook'.
 // Without proper config, gt seance cannot discover sessions.
@@ -558,34 +544,30 @@ func (c *SessionHookCheck) findSettingsFiles(townRoot string) []string {
 func findAllRigs(townRoot string) []string {
 	var rigs []string
 
-	entries, err := os.ReadDir(townRoot)
+	found, err := rig.Discover(townRoot)
 	if err != nil {
 		return rigs
 	}
+	for _, r := range found {
+		rigs = append(rigs, r.Path)
+	}
 
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
-		}
-		// Skip non-rig directories
-		name := entry.Name()
-		if name == "mayor" || name == ".beads" || strings.HasPrefix(name, ".") {
-			continue
-		}
-
-		rigPath := filepath.Join(townRoot, name)
+	return rigs
+}
 
-		// Check if this looks like a rig (has crew/, polecats/, witness/, or refinery/)
-		markers := []string{"crew", "polecats", "witness", "refinery"}
-		for _, marker := range markers {
-			if _, err := os.Stat(filepath.Join(rigPath, marker)); err == nil {
-				rigs = append(rigs, rigPath)
-				break
-			}
+// rigsInScope returns the rig directories a check should examine: every rig
+// in the town, or only the --rig rig when one is set.
+func rigsInScope(ctx *CheckContext) []string {
+	rigs := findAllRigs(ctx.TownRoot)
+	if ctx.RigName == "" {
+		return rigs
+	}
+	for _, rigPath := range rigs {
+		if filepath.Base(rigPath) == ctx.RigName {
+			return []string{rigPath}
 		}
 	}
-
-	return rigs
+	return nil
 }
 
 func containsFlag(s, flag string) bool {
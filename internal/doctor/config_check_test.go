@@ -450,3 +450,25 @@ func TestCustomTypesCheck_ParsesOutputWithNotePrefix(t *testing.T) {
 		t.Errorf("After parsing, missing types: %v", missing)
 	}
 }
+
+func TestRigsInScope(t *testing.T) {
+	townRoot := t.TempDir()
+	for _, rig := range []string{"gastown", "beads"} {
+		if err := os.MkdirAll(filepath.Join(townRoot, rig, "polecats"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got := rigsInScope(&CheckContext{TownRoot: townRoot}); len(got) != 2 {
+		t.Errorf("without --rig got %v, want both rigs", got)
+	}
+
+	got := rigsInScope(&CheckContext{TownRoot: townRoot, RigName: "beads"})
+	if len(got) != 1 || got[0] != filepath.Join(townRoot, "beads") {
+		t.Errorf("with --rig beads got %v, want only beads", got)
+	}
+
+	if got := rigsInScope(&CheckContext{TownRoot: townRoot, RigName: "missing"}); len(got) != 0 {
+		t.Errorf("with unknown --rig got %v, want none", got)
+	}
+}
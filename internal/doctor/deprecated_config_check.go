@@ -33,7 +33,7 @@ func NewDeprecatedMergeQueueKeysCheck() *DeprecatedMergeQueueKeysCheck {
 
 // Run scans all rigs for deprecated merge_queue keys in settings/config.json.
 func (c *DeprecatedMergeQueueKeysCheck) Run(ctx *CheckContext) *CheckResult {
-	rigs := findAllRigs(ctx.TownRoot)
+	rigs := rigsInScope(ctx)
 	if len(rigs) == 0 {
 		return &CheckResult{
 			Name:    c.Name(),
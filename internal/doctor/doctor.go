@@ -3,6 +3,7 @@ package doctor
 import (
 	"fmt"
 	"io"
+	"sort"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/ui"
@@ -35,6 +36,46 @@ func (d *Doctor) Checks() []Check {
 	return d.checks
 }
 
+// sortedChecks returns the registered checks ordered by priority, keeping
+// registration order among checks of equal priority.
+func (d *Doctor) sortedChecks() []Check {
+	checks := make([]Check, len(d.checks))
+	copy(checks, d.checks)
+	sort.SliceStable(checks, func(i, j int) bool {
+		return checks[i].Priority() < checks[j].Priority()
+	})
+	return checks
+}
+
+// supportsDryRun reports whether check's Fix can be called under
+// ctx.DryRun.
+func supportsDryRun(check Check) bool {
+	dr, ok := check.(DryRunFixer)
+	return ok && dr.SupportsDryRun()
+}
+
+// skippedResult is recorded for a check that didn't run because the fatal
+// check it depends on failed.
+func skippedResult(check, fatal Check) *CheckResult {
+	result := &CheckResult{
+		Name:    check.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("skipped (%s failed)", fatal.Name()),
+	}
+	if cg, ok := check.(categoryGetter); ok {
+		result.Category = cg.Category()
+	}
+	return result
+}
+
+// printSkipped streams the line for a skipped check.
+func printSkipped(w io.Writer, result *CheckResult) {
+	if w == nil {
+		return
+	}
+	fmt.Fprintf(w, "  %s  %s%s\n", ui.RenderMuted("○"), result.Name, ui.RenderMuted(" "+result.Message))
+}
+
 // categoryGetter interface for checks that provide a category
 type categoryGetter interface {
 	Category() string
@@ -46,29 +87,28 @@ func (d *Doctor) Run(ctx *CheckContext) *Report {
 }
 
 // RunStreaming executes all registered checks with optional real-time output.
+// Checks run in priority order; once a fatal check errors, checks with a
+// lower priority are reported as skipped instead of run.
 // If w is non-nil, prints each check name as it starts and result when done.
 // If slowThreshold > 0, shows hourglass icon for slow checks.
 func (d *Doctor) RunStreaming(ctx *CheckContext, w io.Writer, slowThreshold time.Duration) *Report {
 	report := NewReport()
 
-	for _, check := range d.checks {
+	var fatal Check
+	for _, check := range d.sortedChecks() {
+		if fatal != nil && check.Priority() > fatal.Priority() {
+			result := skippedResult(check, fatal)
+			printSkipped(w, result)
+			report.Add(result)
+			continue
+		}
+
 		// Stream: print check name before running
 		if w != nil {
 			fmt.Fprintf(w, "  %s  %s...", ui.RenderMuted("○"), check.Name())
 		}
 
-		start := time.Now()
-		result := check.Run(ctx)
-		result.Elapsed = time.Since(start)
-
-		// Ensure check name is populated
-		if result.Name == "" {
-			result.Name = check.Name()
-		}
-		// Set category from check if available
-		if cg, ok := check.(categoryGetter); ok && result.Category == "" {
-			result.Category = cg.Category()
-		}
+		result := runCheck(check, ctx)
 
 		// Stream: overwrite line with result
 		if w != nil {
@@ -99,6 +139,9 @@ func (d *Doctor) RunStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 		}
 
 		report.Add(result)
+		if fatal == nil && check.IsFatal() && result.Status == StatusError {
+			fatal = check
+		}
 	}
 
 	return report
@@ -111,12 +154,22 @@ func (d *Doctor) Fix(ctx *CheckContext) *Report {
 }
 
 // FixStreaming runs all checks with auto-fix and optional real-time output.
+// Ordering and fatal-check skipping follow RunStreaming; a fatal check only
+// stops the run if it still errors after its fix is attempted.
 // If w is non-nil, prints each check name as it starts and result when done.
 // If slowThreshold > 0, shows hourglass icon for slow checks.
 func (d *Doctor) FixStreaming(ctx *CheckContext, w io.Writer, slowThreshold time.Duration) *Report {
 	report := NewReport()
 
-	for _, check := range d.checks {
+	var fatal Check
+	for _, check := range d.sortedChecks() {
+		if fatal != nil && check.Priority() > fatal.Priority() {
+			result := skippedResult(check, fatal)
+			printSkipped(w, result)
+			report.Add(result)
+			continue
+		}
+
 		// Stream: print check name before running
 		if w != nil {
 			fmt.Fprintf(w, "  %s  %s...", ui.RenderMuted("○"), check.Name())
@@ -147,11 +200,23 @@ func (d *Doctor) FixStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 				if result.Message != "" {
 					fmt.Fprintf(w, "%s", ui.RenderMuted(" "+result.Message))
 				}
-				fmt.Fprintf(w, "%s", ui.RenderMuted(" (fixing)..."))
+				if ctx.DryRun {
+					fmt.Fprintf(w, "%s", ui.RenderMuted(" (dry run)..."))
+				} else {
+					fmt.Fprintf(w, "%s", ui.RenderMuted(" (fixing)..."))
+				}
 			}
 
-			err := check.Fix(ctx)
-			if err == nil {
+			if ctx.DryRun && !supportsDryRun(check) {
+				// Fix would make changes; report it rather than calling it
+				result.Message += " (would fix)"
+			} else if err := check.Fix(ctx); ctx.DryRun {
+				if err != nil {
+					result.Details = append(result.Details, "Dry run failed: "+err.Error())
+				} else {
+					result.Message += " (would fix)"
+				}
+			} else if err == nil {
 				// Re-run check to verify fix worked
 				result = check.Run(ctx)
 				if result.Name == "" {
@@ -212,17 +277,38 @@ func (d *Doctor) FixStreaming(ctx *CheckContext, w io.Writer, slowThreshold time
 		}
 
 		report.Add(result)
+		if fatal == nil && check.IsFatal() && result.Status == StatusError {
+			fatal = check
+		}
 	}
 
 	return report
 }
 
+// DefaultCheckPriority is the priority of checks that don't set one.
+const DefaultCheckPriority = 50
+
 // BaseCheck provides a base implementation for checks that don't support auto-fix.
 // Embed this in custom checks to get default CanFix() and Fix() implementations.
 type BaseCheck struct {
 	CheckName        string
 	CheckDescription string
 	CheckCategory    string // Category for grouping (e.g., CategoryCore)
+	CheckPriority    int    // Lower runs first; 0 means DefaultCheckPriority
+	CheckFatal       bool   // Skip lower-priority checks if this one errors
+}
+
+// Priority returns the check's run order, defaulting to DefaultCheckPriority.
+func (b *BaseCheck) Priority() int {
+	if b.CheckPriority == 0 {
+		return DefaultCheckPriority
+	}
+	return b.CheckPriority
+}
+
+// IsFatal returns true if lower-priority checks depend on this check.
+func (b *BaseCheck) IsFatal() bool {
+	return b.CheckFatal
 }
 
 // Category returns the check's category for grouping in output.
@@ -2,6 +2,8 @@ package doctor
 
 import (
 	"bytes"
+	"encoding/json"
+	"strings"
 	"testing"
 )
 
@@ -228,6 +230,50 @@ func TestReport_Print(t *testing.T) {
 	}
 }
 
+func TestReport_WriteJSON(t *testing.T) {
+	r := NewReport()
+	r.Add(&CheckResult{
+		Name:    "ok-check",
+		Status:  StatusOK,
+		Message: "All good",
+	})
+	r.Add(&CheckResult{
+		Name:    "error-check",
+		Status:  StatusError,
+		Message: "Broken",
+		Details: []string{"first", "second"},
+		FixHint: "not included in JSON",
+	})
+
+	var buf bytes.Buffer
+	if err := r.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON() error = %v", err)
+	}
+
+	var got []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("output is not a JSON array: %v\n%s", err, buf.String())
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d entries, want 2", len(got))
+	}
+	if got[0]["name"] != "ok-check" || got[0]["status"] != "ok" || got[0]["message"] != "All good" {
+		t.Errorf("first entry = %v", got[0])
+	}
+	if details, ok := got[0]["details"].([]any); !ok || len(details) != 0 {
+		t.Errorf("first entry details = %#v, want empty array", got[0]["details"])
+	}
+	if got[1]["status"] != "error" {
+		t.Errorf("second entry status = %v, want error", got[1]["status"])
+	}
+	if details, ok := got[1]["details"].([]any); !ok || len(details) != 2 {
+		t.Errorf("second entry details = %#v, want 2 entries", got[1]["details"])
+	}
+	if _, ok := got[1]["fix_hint"]; ok {
+		t.Error("unexpected fix_hint key in JSON output")
+	}
+}
+
 func TestNewDoctor(t *testing.T) {
 	d := NewDoctor()
 	if d == nil {
@@ -330,6 +376,105 @@ func TestDoctor_Fix(t *testing.T) {
 	}
 }
 
+// dryRunMockCheck is a mockCheck whose Fix honors ctx.DryRun.
+type dryRunMockCheck struct {
+	*mockCheck
+}
+
+func (m dryRunMockCheck) SupportsDryRun() bool { return true }
+
+func TestDoctor_FixDryRun(t *testing.T) {
+	d := NewDoctor()
+
+	plainCheck := newMockCheck("plain", StatusError)
+	plainCheck.fixable = true
+	d.Register(plainCheck)
+
+	optedIn := dryRunMockCheck{newMockCheck("opted-in", StatusError)}
+	optedIn.fixable = true
+	d.Register(optedIn)
+
+	report := d.Fix(&CheckContext{TownRoot: "/test", DryRun: true})
+
+	if plainCheck.fixCount != 0 {
+		t.Errorf("Fix called %d time(s) under dry run on a check without DryRunFixer", plainCheck.fixCount)
+	}
+	if optedIn.fixCount != 1 {
+		t.Errorf("DryRunFixer check Fix called %d time(s), want 1", optedIn.fixCount)
+	}
+	for _, r := range report.Checks {
+		if r.Fixed || !strings.HasSuffix(r.Message, "(would fix)") {
+			t.Errorf("%s: Message = %q, Fixed = %v; want \"(would fix)\", not fixed", r.Name, r.Message, r.Fixed)
+		}
+	}
+}
+
+func TestDoctor_RunPriority(t *testing.T) {
+	d := NewDoctor()
+
+	late := newMockCheck("late", StatusOK)
+	late.CheckPriority = 90
+	d.Register(late)
+	d.Register(newMockCheck("default", StatusOK))
+	early := newMockCheck("early", StatusOK)
+	early.CheckPriority = 10
+	d.Register(early)
+
+	report := d.Run(&CheckContext{TownRoot: "/test"})
+
+	var got []string
+	for _, r := range report.Checks {
+		got = append(got, r.Name)
+	}
+	want := []string{"early", "default", "late"}
+	if len(got) != len(want) {
+		t.Fatalf("Run() checks = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Run() checks = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestDoctor_RunFatalSkipsLowerPriority(t *testing.T) {
+	d := NewDoctor()
+
+	fatal := newMockCheck("fatal", StatusError)
+	fatal.CheckPriority = 10
+	fatal.CheckFatal = true
+	d.Register(fatal)
+
+	peer := newMockCheck("peer", StatusOK)
+	peer.CheckPriority = 10
+	d.Register(peer)
+
+	dependent := newMockCheck("dependent", StatusOK)
+	dependent.fixable = true
+	d.Register(dependent)
+
+	report := d.Run(&CheckContext{TownRoot: "/test"})
+
+	if len(report.Checks) != 3 {
+		t.Fatalf("Run() returned %d results, want 3", len(report.Checks))
+	}
+	// Checks at the same priority as the fatal check still run
+	if report.Checks[1].Name != "peer" || report.Checks[1].Status != StatusOK {
+		t.Errorf("peer result = %+v, want OK", report.Checks[1])
+	}
+	skipped := report.Checks[2]
+	if skipped.Name != "dependent" || skipped.Status != StatusWarning || skipped.Message != "skipped (fatal failed)" {
+		t.Errorf("dependent result = %+v, want skipped warning", skipped)
+	}
+
+	// A fatal check that is fixed doesn't stop the run
+	fatal.fixable = true
+	report = d.Fix(&CheckContext{TownRoot: "/test"})
+	if report.Checks[2].Message != "mock result" {
+		t.Errorf("dependent result after fix = %+v, want it to run", report.Checks[2])
+	}
+}
+
 func TestBaseCheck(t *testing.T) {
 	b := &BaseCheck{
 		CheckName:        "test",
@@ -348,6 +493,12 @@ func TestBaseCheck(t *testing.T) {
 	if err := b.Fix(nil); err != ErrCannotFix {
 		t.Errorf("BaseCheck.Fix() should return ErrCannotFix, got %v", err)
 	}
+	if b.Priority() != DefaultCheckPriority {
+		t.Errorf("Priority() = %d, want %d", b.Priority(), DefaultCheckPriority)
+	}
+	if b.IsFatal() {
+		t.Error("BaseCheck.IsFatal() should return false")
+	}
 }
 
 func TestFixableCheck(t *testing.T) {
@@ -31,7 +31,7 @@ func NewHooksPathAllRigsCheck() *HooksPathAllRigsCheck {
 
 // Run checks all clones in all rigs for core.hooksPath configuration.
 func (c *HooksPathAllRigsCheck) Run(ctx *CheckContext) *CheckResult {
-	rigs := findAllRigs(ctx.TownRoot)
+	rigs := rigsInScope(ctx)
 	if len(rigs) == 0 {
 		return &CheckResult{
 			Name:    c.Name(),
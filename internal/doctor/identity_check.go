@@ -160,7 +160,7 @@ func (c *IdentityCollisionCheck) Fix(ctx *CheckContext) error {
 	}
 
 	if cleaned > 0 {
-		fmt.Printf("  Cleaned %d stale lock(s)\n", cleaned)
+		fmt.Fprintf(ctx.Output(), "  Cleaned %d stale lock(s)\n", cleaned)
 	}
 
 	return nil
@@ -32,7 +32,7 @@ func NewLandWorktreeGitignoreCheck() *LandWorktreeGitignoreCheck {
 
 // Run checks each rig's .gitignore for the .land-worktree/ entry.
 func (c *LandWorktreeGitignoreCheck) Run(ctx *CheckContext) *CheckResult {
-	rigs := findAllRigs(ctx.TownRoot)
+	rigs := rigsInScope(ctx)
 	if len(rigs) == 0 {
 		return &CheckResult{
 			Name:    c.Name(),
@@ -0,0 +1,90 @@
+package doctor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// MayorSettingsCheck validates mayor-specific invariants in mayor/.claude/settings.json
+// that the shared claude-settings check does not cover. The mayor is an interactive
+// role: it must pick up mail on each user prompt and re-prime after compaction,
+// and must not carry the autonomous template's SessionStart mail injection.
+// Missing or unparseable files are left to claude-settings to report.
+type MayorSettingsCheck struct {
+	BaseCheck
+}
+
+// NewMayorSettingsCheck creates a new mayor settings check.
+func NewMayorSettingsCheck() *MayorSettingsCheck {
+	return &MayorSettingsCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "mayor-settings",
+			CheckDescription: "Verify mayor settings.json has the interactive-role hooks",
+			CheckCategory:    CategoryConfig,
+		},
+	}
+}
+
+// Run checks the mayor's settings.json against the interactive-role requirements.
+func (c *MayorSettingsCheck) Run(ctx *CheckContext) *CheckResult {
+	path := filepath.Join(ctx.TownRoot, "mayor", ".claude", "settings.json")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No mayor settings.json (see claude-settings)",
+		}
+	}
+	var settings map[string]any
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "Mayor settings.json is not valid JSON (see claude-settings)",
+		}
+	}
+
+	problems := mayorSettingsProblems(settings)
+	if len(problems) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "Mayor settings.json has the interactive-role hooks",
+		}
+	}
+
+	relPath := townRelPath(ctx.TownRoot, path)
+	details := make([]string, len(problems))
+	for i, p := range problems {
+		details[i] = fmt.Sprintf("%s: %s", relPath, p)
+	}
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("Mayor settings.json has %d mayor-specific issue(s)", len(problems)),
+		Details: details,
+		FixHint: fmt.Sprintf("Delete %s and run 'gt mayor restart' to reinstall the interactive template", relPath),
+	}
+}
+
+// mayorSettingsProblems returns a description of each mayor invariant the
+// parsed settings violate.
+func mayorSettingsProblems(settings map[string]any) []string {
+	hooks, _ := settings["hooks"].(map[string]any)
+
+	var problems []string
+	if !hookCommandContains(hooks, "UserPromptSubmit", "gt mail check --inject") {
+		problems = append(problems, "UserPromptSubmit hook does not run 'gt mail check --inject' (mayor will not see new mail)")
+	}
+	if !hookCommandContains(hooks, "PreCompact", "gt prime") {
+		problems = append(problems, "PreCompact hook does not run 'gt prime' (mayor loses its role after compaction)")
+	}
+	if hookCommandContains(hooks, "SessionStart", "gt mail check --inject") {
+		problems = append(problems, "SessionStart hook injects mail (autonomous template; mayor is interactive)")
+	}
+	return problems
+}
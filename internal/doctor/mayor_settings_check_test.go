@@ -0,0 +1,61 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/claude"
+)
+
+func TestMayorSettingsCheck_InteractiveTemplate(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := claude.EnsureSettingsForRole(filepath.Join(townRoot, "mayor"), "mayor"); err != nil {
+		t.Fatal(err)
+	}
+
+	result := NewMayorSettingsCheck().Run(&CheckContext{TownRoot: townRoot})
+	if result.Status != StatusOK {
+		t.Errorf("Status = %v, want OK; details: %v", result.Status, result.Details)
+	}
+}
+
+func TestMayorSettingsCheck_AutonomousTemplate(t *testing.T) {
+	townRoot := t.TempDir()
+	mayorDir := filepath.Join(townRoot, "mayor")
+	if err := claude.EnsureSettings(mayorDir, claude.Autonomous); err != nil {
+		t.Fatal(err)
+	}
+
+	result := NewMayorSettingsCheck().Run(&CheckContext{TownRoot: townRoot})
+	if result.Status != StatusWarning {
+		t.Fatalf("Status = %v, want Warning", result.Status)
+	}
+	want := filepath.Join("mayor", ".claude", "settings.json") + ": SessionStart hook injects mail"
+	if len(result.Details) != 1 || !strings.HasPrefix(result.Details[0], want) {
+		t.Errorf("Details = %v, want single %q problem", result.Details, want)
+	}
+}
+
+func TestMayorSettingsProblems_MissingHooks(t *testing.T) {
+	problems := mayorSettingsProblems(map[string]any{})
+	if len(problems) != 2 {
+		t.Fatalf("problems = %v, want UserPromptSubmit and PreCompact", problems)
+	}
+	if !strings.HasPrefix(problems[0], "UserPromptSubmit") || !strings.HasPrefix(problems[1], "PreCompact") {
+		t.Errorf("problems = %v", problems)
+	}
+}
+
+func TestMayorSettingsCheck_MissingFile(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(townRoot, "mayor"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	result := NewMayorSettingsCheck().Run(&CheckContext{TownRoot: townRoot})
+	if result.Status != StatusOK {
+		t.Errorf("Status = %v, want OK (missing file is reported by claude-settings)", result.Status)
+	}
+}
@@ -0,0 +1,129 @@
+package doctor
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// DefaultPolecatStaleDuration is how long a working polecat can go without
+// committing before PolecatStateCheck warns about it.
+const DefaultPolecatStaleDuration = 24 * time.Hour
+
+// PolecatStateCheck detects polecats that are still "working" but haven't
+// committed in a long time, which usually means they are stuck.
+// Paused polecats are expected to sit idle and are not reported.
+type PolecatStateCheck struct {
+	BaseCheck
+	staleDuration time.Duration
+
+	// listPolecats returns the polecats to inspect; replaceable in tests.
+	listPolecats func(ctx *CheckContext) ([]*polecat.Polecat, error)
+	now          func() time.Time
+}
+
+// NewPolecatStateCheck creates a check that warns about working polecats
+// whose last commit is older than staleDuration.
+func NewPolecatStateCheck(staleDuration time.Duration) *PolecatStateCheck {
+	return &PolecatStateCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "polecat-state",
+			CheckDescription: "Detect working polecats with no recent commits",
+			CheckCategory:    CategoryRig,
+		},
+		staleDuration: staleDuration,
+		listPolecats:  listTownPolecats,
+		now:           time.Now,
+	}
+}
+
+// Run checks the last commit time of every working polecat.
+func (c *PolecatStateCheck) Run(ctx *CheckContext) *CheckResult {
+	polecats, err := c.listPolecats(ctx)
+	if err != nil {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: "Could not list polecats",
+			Details: []string{err.Error()},
+		}
+	}
+
+	now := c.now()
+	working := 0
+	var details []string
+	for _, p := range polecats {
+		if p.State != polecat.StateWorking {
+			continue
+		}
+		working++
+
+		entries, err := git.NewGit(p.ClonePath).Log("HEAD", 1)
+		if err != nil || len(entries) == 0 {
+			continue // No commits to judge by
+		}
+		last := entries[0].Date
+		age := now.Sub(last)
+		if age <= c.staleDuration {
+			continue
+		}
+		details = append(details, fmt.Sprintf("%s/%s: last commit %s (%s ago)",
+			p.Rig, p.Name, last.Local().Format("2006-01-02 15:04"), formatDuration(age.Truncate(time.Minute))))
+	}
+
+	if len(details) > 0 {
+		sort.Strings(details)
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("%d working polecat(s) with no commits in %s", len(details), formatDuration(c.staleDuration)),
+			Details: details,
+			FixHint: "Check on them with 'gt polecat log', or nudge them with 'gt nudge <rig>/<name>'",
+		}
+	}
+
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusOK,
+		Message: fmt.Sprintf("%d working polecat(s) committed recently", working),
+	}
+}
+
+// listTownPolecats returns the polecats of every rig in the town, or of
+// ctx.RigName only when it is set.
+func listTownPolecats(ctx *CheckContext) ([]*polecat.Polecat, error) {
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(ctx.TownRoot, "mayor", "rigs.json"))
+	if err != nil {
+		return nil, fmt.Errorf("loading rigs config: %w", err)
+	}
+
+	rigMgr := rig.NewManager(ctx.TownRoot, rigsConfig, git.NewGit(ctx.TownRoot))
+	var rigs []*rig.Rig
+	if ctx.RigName != "" {
+		r, err := rigMgr.GetRig(ctx.RigName)
+		if err != nil {
+			return nil, err
+		}
+		rigs = []*rig.Rig{r}
+	} else if rigs, err = rigMgr.DiscoverRigs(); err != nil {
+		return nil, err
+	}
+
+	t := tmux.NewTmux()
+	var polecats []*polecat.Polecat
+	for _, r := range rigs {
+		list, err := polecat.NewManager(r, git.NewGit(r.Path), t).List()
+		if err != nil {
+			return nil, fmt.Errorf("listing polecats in %s: %w", r.Name, err)
+		}
+		polecats = append(polecats, list...)
+	}
+	return polecats, nil
+}
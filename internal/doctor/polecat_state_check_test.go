@@ -0,0 +1,64 @@
+package doctor
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/polecat"
+)
+
+// commitAt creates a repo at dir with one commit authored at when.
+func commitAt(t *testing.T, dir string, when time.Time) {
+	t.Helper()
+	date := when.Format(time.RFC3339)
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"-c", "user.name=t", "-c", "user.email=t@t", "commit", "-q", "--allow-empty", "-m", "work"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_DATE="+date, "GIT_COMMITTER_DATE="+date)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+}
+
+func TestPolecatStateCheck(t *testing.T) {
+	last := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	toast := filepath.Join(t.TempDir(), "toast")
+	nux := filepath.Join(t.TempDir(), "nux")
+	for _, dir := range []string{toast, nux} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		commitAt(t, dir, last)
+	}
+
+	check := NewPolecatStateCheck(24 * time.Hour)
+	check.listPolecats = func(*CheckContext) ([]*polecat.Polecat, error) {
+		return []*polecat.Polecat{
+			{Name: "toast", Rig: "gastown", State: polecat.StateWorking, ClonePath: toast},
+			{Name: "nux", Rig: "gastown", State: polecat.StateDone, ClonePath: nux}, // not working: ignored
+			{Name: "slit", Rig: "gastown", State: polecat.StatePaused, ClonePath: nux}, // paused on purpose: ignored
+		}, nil
+	}
+
+	check.now = func() time.Time { return last.Add(2 * time.Hour) }
+	if result := check.Run(&CheckContext{}); result.Status != StatusOK {
+		t.Errorf("recent commit: status = %v, want OK (%s)", result.Status, result.Message)
+	}
+
+	check.now = func() time.Time { return last.Add(36 * time.Hour) }
+	result := check.Run(&CheckContext{})
+	if result.Status != StatusWarning {
+		t.Fatalf("stale commit: status = %v, want Warning (%s)", result.Status, result.Message)
+	}
+	if len(result.Details) != 1 || !strings.HasPrefix(result.Details[0], "gastown/toast: last commit ") || !strings.Contains(result.Details[0], "(36h ago)") {
+		t.Errorf("details = %v, want one entry for gastown/toast 36h ago", result.Details)
+	}
+}
@@ -1251,8 +1251,7 @@ func (c *DefaultBranchExistsCheck) Run(ctx *CheckContext) *CheckResult {
 	}
 
 	ref := fmt.Sprintf("refs/remotes/origin/%s", cfg.DefaultBranch)
-	cmd := exec.Command("git", "-C", bareRepoPath, "rev-parse", "--verify", ref)
-	if err := cmd.Run(); err != nil {
+	if exists, err := git.NewGitWithDir(bareRepoPath, "").RemoteTrackingBranchExists("origin", cfg.DefaultBranch); err != nil || !exists {
 		return &CheckResult{
 			Name:    c.Name(),
 			Status:  StatusError,
@@ -1336,9 +1335,7 @@ func (c *DefaultBranchAllRigsCheck) Run(ctx *CheckContext) *CheckResult {
 			continue // No bare repo, skip
 		}
 
-		ref := fmt.Sprintf("refs/remotes/origin/%s", cfg.DefaultBranch)
-		cmd := exec.Command("git", "-C", bareRepoPath, "rev-parse", "--verify", ref)
-		if err := cmd.Run(); err != nil {
+		if exists, err := git.NewGitWithDir(bareRepoPath, "").RemoteTrackingBranchExists("origin", cfg.DefaultBranch); err != nil || !exists {
 			errors = append(errors, fmt.Sprintf("%s: default_branch %q not found on remote", entry.Name(), cfg.DefaultBranch))
 		}
 	}
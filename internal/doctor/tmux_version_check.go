@@ -0,0 +1,78 @@
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/deps"
+)
+
+// TmuxVersionCheck verifies that tmux is installed and meets deps.MinTmuxVersion.
+// Older tmux releases lack features that session management relies on.
+// There is no auto-fix — the user must install or upgrade tmux manually.
+// It runs first and is fatal: session checks are meaningless without tmux.
+type TmuxVersionCheck struct {
+	BaseCheck
+}
+
+// NewTmuxVersionCheck creates a new tmux version check.
+func NewTmuxVersionCheck() *TmuxVersionCheck {
+	return &TmuxVersionCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "tmux-version",
+			CheckDescription: "Check that tmux is installed and meets minimum version",
+			CheckCategory:    CategoryInfrastructure,
+			CheckPriority:    10,
+			CheckFatal:       true,
+		},
+	}
+}
+
+// Run checks if tmux is available in PATH and reports its version status.
+func (c *TmuxVersionCheck) Run(ctx *CheckContext) *CheckResult {
+	status, version := deps.CheckTmux()
+
+	switch status {
+	case deps.TmuxOK:
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("tmux %s", version),
+		}
+
+	case deps.TmuxNotFound:
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: "tmux not found in PATH",
+			Details: []string{
+				"Gas Town runs every agent in a tmux session",
+			},
+			FixHint: fmt.Sprintf("Install tmux %s or newer with your package manager", deps.MinTmuxVersion),
+		}
+
+	case deps.TmuxTooOld:
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: fmt.Sprintf("tmux %s is too old (minimum: %s)", version, deps.MinTmuxVersion),
+			Details: []string{
+				fmt.Sprintf("Installed version %s does not meet the minimum requirement of %s", version, deps.MinTmuxVersion),
+			},
+			FixHint: fmt.Sprintf("Upgrade tmux to %s or newer with your package manager", deps.MinTmuxVersion),
+		}
+
+	case deps.TmuxUnknown:
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: "tmux found but version could not be determined",
+			FixHint: fmt.Sprintf("Check that 'tmux -V' reports %s or newer", deps.MinTmuxVersion),
+		}
+	}
+
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusOK,
+		Message: "tmux available",
+	}
+}
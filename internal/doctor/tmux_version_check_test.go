@@ -0,0 +1,78 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// writeFakeTmux creates a fake "tmux" executable in dir that prints output for -V.
+func writeFakeTmux(t *testing.T, dir, output string) {
+	t.Helper()
+	script := "#!/bin/sh\necho '" + output + "'\n"
+	if err := os.WriteFile(filepath.Join(dir, "tmux"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTmuxVersionCheck_Metadata(t *testing.T) {
+	check := NewTmuxVersionCheck()
+
+	if check.Name() != "tmux-version" {
+		t.Errorf("Name() = %q, want %q", check.Name(), "tmux-version")
+	}
+	if check.Category() != CategoryInfrastructure {
+		t.Errorf("Category() = %q, want %q", check.Category(), CategoryInfrastructure)
+	}
+	if check.CanFix() {
+		t.Error("CanFix() should return false (user must install/upgrade tmux manually)")
+	}
+}
+
+func TestTmuxVersionCheck_Versions(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake tmux script requires a POSIX shell")
+	}
+
+	tests := []struct {
+		name    string
+		output  string
+		status  CheckStatus
+		message string
+	}{
+		{"current", "tmux 3.4", StatusOK, "tmux 3.4"},
+		{"patch suffix", "tmux 3.2a", StatusOK, "tmux 3.2"},
+		{"too old", "tmux 3.1c", StatusError, "too old"},
+		{"unparseable", "tmux master", StatusWarning, "could not be determined"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeDir := t.TempDir()
+			writeFakeTmux(t, fakeDir, tt.output)
+			t.Setenv("PATH", fakeDir)
+
+			result := NewTmuxVersionCheck().Run(&CheckContext{TownRoot: t.TempDir()})
+			if result.Status != tt.status {
+				t.Errorf("status = %v, want %v: %s", result.Status, tt.status, result.Message)
+			}
+			if !strings.Contains(result.Message, tt.message) {
+				t.Errorf("message = %q, want it to contain %q", result.Message, tt.message)
+			}
+		})
+	}
+}
+
+func TestTmuxVersionCheck_NotInPath(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	result := NewTmuxVersionCheck().Run(&CheckContext{TownRoot: t.TempDir()})
+	if result.Status != StatusError {
+		t.Errorf("expected StatusError when tmux is not in PATH, got %v: %s", result.Status, result.Message)
+	}
+	if result.FixHint == "" {
+		t.Error("expected a fix hint with install instructions")
+	}
+}
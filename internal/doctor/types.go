@@ -2,8 +2,12 @@
 package doctor
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/steveyegge/gastown/internal/ui"
@@ -63,6 +67,34 @@ type CheckContext struct {
 	RigName         string // Rig name (empty for town-level checks)
 	Verbose         bool   // Enable verbose output
 	RestartSessions bool   // Restart patrol sessions when fixing (requires explicit --restart-sessions flag)
+	DryRun          bool   // Report what Fix would change without modifying anything (--fix --dry-run)
+	RemoveBackups   bool   // Delete settings backups left by earlier fixes (requires explicit --remove-backups flag)
+
+	// Logger receives diagnostic logs from checks (e.g. progress through
+	// slow git operations). Nil means slog.Default(); use Log to read it.
+	Logger *slog.Logger
+
+	// Out receives the messages checks print for the user, such as what
+	// Fix changed. Nil means os.Stdout; use Output to read it.
+	Out io.Writer
+}
+
+// Log returns the logger checks should use for diagnostic output,
+// falling back to slog.Default() when Logger is nil.
+func (ctx *CheckContext) Log() *slog.Logger {
+	if ctx.Logger == nil {
+		return slog.Default()
+	}
+	return ctx.Logger
+}
+
+// Output returns the writer checks should print user-facing messages to,
+// falling back to os.Stdout when Out is nil.
+func (ctx *CheckContext) Output() io.Writer {
+	if ctx.Out == nil {
+		return os.Stdout
+	}
+	return ctx.Out
 }
 
 // RigPath returns the full path to the rig directory.
@@ -102,10 +134,28 @@ type Check interface {
 
 	// Fix attempts to automatically fix the issue.
 	// Should only be called if CanFix() returns true.
+	// Under ctx.DryRun, Fix is only called for checks that implement
+	// DryRunFixer.
 	Fix(ctx *CheckContext) error
 
 	// CanFix returns true if this check can automatically fix issues.
 	CanFix() bool
+
+	// Priority orders checks within a run; lower values run first.
+	// BaseCheck defaults to DefaultCheckPriority.
+	Priority() int
+
+	// IsFatal returns true if every lower-priority check depends on this
+	// one passing. When a fatal check errors, those checks are skipped.
+	IsFatal() bool
+}
+
+// DryRunFixer is implemented by checks whose Fix respects ctx.DryRun:
+// when it is set, Fix prints what would be changed and returns without
+// touching files, sessions, or state. With --fix --dry-run, the Fix of
+// other checks is not called at all.
+type DryRunFixer interface {
+	SupportsDryRun() bool
 }
 
 // ReportSummary summarizes the results of all checks.
@@ -208,6 +258,40 @@ func (r *Report) PrintSummaryOnly(w io.Writer, verbose bool, slowThreshold time.
 	}
 }
 
+// CheckResultJSON is the machine-readable form of a CheckResult,
+// as emitted by gt doctor --json.
+type CheckResultJSON struct {
+	Name    string   `json:"name"`
+	Status  string   `json:"status"` // "ok", "warning", or "error"
+	Message string   `json:"message"`
+	Details []string `json:"details"`
+}
+
+// JSONResults converts the report's checks to their JSON form, in run order.
+func (r *Report) JSONResults() []CheckResultJSON {
+	results := make([]CheckResultJSON, 0, len(r.Checks))
+	for _, check := range r.Checks {
+		details := check.Details
+		if details == nil {
+			details = []string{}
+		}
+		results = append(results, CheckResultJSON{
+			Name:    check.Name,
+			Status:  strings.ToLower(check.Status.String()),
+			Message: check.Message,
+			Details: details,
+		})
+	}
+	return results
+}
+
+// WriteJSON writes the report as a JSON array with one object per check.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r.JSONResults())
+}
+
 // Print outputs the report to the given writer.
 // Matches bd doctor UX: grouped by category, semantic icons, warnings section.
 // If slowThreshold > 0, displays elapsed time for checks exceeding the threshold.
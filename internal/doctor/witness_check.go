@@ -0,0 +1,116 @@
+package doctor
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// WitnessSessionProber abstracts tmux witness session inspection for testing.
+// *tmux.Tmux satisfies this interface.
+type WitnessSessionProber interface {
+	HasSession(name string) (bool, error)
+	IsAgentAlive(session string) bool
+}
+
+// WitnessCheck verifies that a witness agent is running for each rig.
+// ClaudeSettingsCheck validates witness config files; this checks the
+// process is actually alive.
+type WitnessCheck struct {
+	BaseCheck
+	prober WitnessSessionProber // nil means use real tmux
+}
+
+// NewWitnessCheck creates a new witness liveness check.
+func NewWitnessCheck() *WitnessCheck {
+	return &WitnessCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "witness-running",
+			CheckDescription: "Verify a witness session is running for each rig",
+			CheckCategory:    CategoryPatrol,
+		},
+	}
+}
+
+// NewWitnessCheckWithProber creates a check with a custom prober (for testing).
+func NewWitnessCheckWithProber(prober WitnessSessionProber) *WitnessCheck {
+	c := NewWitnessCheck()
+	c.prober = prober
+	return c
+}
+
+// Run checks each rig's witness tmux session.
+// A missing session is a warning (witness not started); a session whose
+// agent process has died is an error.
+func (c *WitnessCheck) Run(ctx *CheckContext) *CheckResult {
+	prober := c.prober
+	if prober == nil {
+		prober = tmux.NewTmux()
+	}
+
+	var rigPaths []string
+	if ctx.RigName != "" {
+		rigPaths = []string{ctx.RigPath()}
+	} else {
+		rigPaths = findAllRigs(ctx.TownRoot)
+	}
+
+	if len(rigPaths) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: "No rigs found",
+		}
+	}
+
+	var notRunning, dead []string
+	var running int
+
+	for _, rigPath := range rigPaths {
+		rigName := filepath.Base(rigPath)
+		sessionName := session.WitnessSessionName(session.PrefixFor(rigName))
+
+		exists, err := prober.HasSession(sessionName)
+		if err != nil || !exists {
+			notRunning = append(notRunning, fmt.Sprintf("%s: witness not running (%s)", rigName, sessionName))
+			continue
+		}
+		if !prober.IsAgentAlive(sessionName) {
+			dead = append(dead, fmt.Sprintf("%s: witness session %s exists but agent is dead", rigName, sessionName))
+			continue
+		}
+		running++
+	}
+
+	if len(notRunning) == 0 && len(dead) == 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusOK,
+			Message: fmt.Sprintf("Witness running for all %d rig(s)", running),
+		}
+	}
+
+	details := make([]string, 0, len(dead)+len(notRunning))
+	details = append(details, dead...)
+	details = append(details, notRunning...)
+
+	if len(dead) > 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusError,
+			Message: fmt.Sprintf("%d witness session(s) failed, %d not running", len(dead), len(notRunning)),
+			Details: details,
+			FixHint: "Restart the witness with 'gt witness restart <rig>'",
+		}
+	}
+
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusWarning,
+		Message: fmt.Sprintf("%d rig(s) without a running witness", len(notRunning)),
+		Details: details,
+		FixHint: "Start the witness with 'gt witness start <rig>' or 'gt up'",
+	}
+}
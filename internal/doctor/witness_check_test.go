@@ -0,0 +1,105 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+// mockWitnessProber reports fixed session existence and agent liveness.
+type mockWitnessProber struct {
+	sessions map[string]bool // session name -> agent alive
+}
+
+func (m *mockWitnessProber) HasSession(name string) (bool, error) {
+	_, ok := m.sessions[name]
+	return ok, nil
+}
+
+func (m *mockWitnessProber) IsAgentAlive(name string) bool {
+	return m.sessions[name]
+}
+
+func makeWitnessRig(t *testing.T, townRoot, rigName string) string {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Join(townRoot, rigName, "witness"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return session.WitnessSessionName(session.PrefixFor(rigName))
+}
+
+func TestNewWitnessCheck(t *testing.T) {
+	check := NewWitnessCheck()
+
+	if check.Name() != "witness-running" {
+		t.Errorf("expected name 'witness-running', got %q", check.Name())
+	}
+	if check.CanFix() {
+		t.Error("expected CanFix to return false")
+	}
+	if check.Category() != CategoryPatrol {
+		t.Errorf("expected category %q, got %q", CategoryPatrol, check.Category())
+	}
+}
+
+func TestWitnessCheck_AllRunning(t *testing.T) {
+	townRoot := t.TempDir()
+	sess := makeWitnessRig(t, townRoot, "alpha")
+
+	check := NewWitnessCheckWithProber(&mockWitnessProber{sessions: map[string]bool{sess: true}})
+	result := check.Run(&CheckContext{TownRoot: townRoot})
+
+	if result.Status != StatusOK {
+		t.Errorf("expected StatusOK, got %v: %s %v", result.Status, result.Message, result.Details)
+	}
+}
+
+func TestWitnessCheck_MissingSessionWarns(t *testing.T) {
+	townRoot := t.TempDir()
+	makeWitnessRig(t, townRoot, "alpha")
+
+	check := NewWitnessCheckWithProber(&mockWitnessProber{sessions: map[string]bool{}})
+	result := check.Run(&CheckContext{TownRoot: townRoot})
+
+	if result.Status != StatusWarning {
+		t.Errorf("expected StatusWarning, got %v: %s", result.Status, result.Message)
+	}
+	if len(result.Details) != 1 {
+		t.Errorf("expected 1 detail, got %v", result.Details)
+	}
+}
+
+func TestWitnessCheck_DeadAgentErrors(t *testing.T) {
+	townRoot := t.TempDir()
+	alive := makeWitnessRig(t, townRoot, "alpha")
+	dead := makeWitnessRig(t, townRoot, "beta")
+	makeWitnessRig(t, townRoot, "gamma") // not started
+
+	check := NewWitnessCheckWithProber(&mockWitnessProber{sessions: map[string]bool{
+		alive: true,
+		dead:  false,
+	}})
+	result := check.Run(&CheckContext{TownRoot: townRoot})
+
+	if result.Status != StatusError {
+		t.Errorf("expected StatusError, got %v: %s", result.Status, result.Message)
+	}
+	if len(result.Details) != 2 {
+		t.Errorf("expected 2 details (dead + not running), got %v", result.Details)
+	}
+}
+
+func TestWitnessCheck_ScopedToRig(t *testing.T) {
+	townRoot := t.TempDir()
+	alive := makeWitnessRig(t, townRoot, "alpha")
+	makeWitnessRig(t, townRoot, "beta") // not started, but out of scope
+
+	check := NewWitnessCheckWithProber(&mockWitnessProber{sessions: map[string]bool{alive: true}})
+	result := check.Run(&CheckContext{TownRoot: townRoot, RigName: "alpha"})
+
+	if result.Status != StatusOK {
+		t.Errorf("expected StatusOK for --rig alpha, got %v: %v", result.Status, result.Details)
+	}
+}
@@ -251,6 +251,7 @@ func (c *WorktreeGitdirCheck) Fix(ctx *CheckContext) error {
 		}
 
 		// Re-create the worktree
+		ctx.Log().Debug("re-creating worktree", "path", bw.worktreePath, "branch", branch)
 		cmd = exec.Command("git", "-C", bw.bareRepoPath, "worktree", "add", bw.worktreePath, branch)
 		if output, err := cmd.CombinedOutput(); err != nil {
 			lastErr = fmt.Errorf("%s: failed to re-create worktree: %v (%s)",
@@ -608,7 +608,7 @@ func (m *Manager) cleanupStaleBranchesForRig(repoGit *git.Git, rigName string) (
 		if currentBranches[branch] {
 			continue
 		}
-		if err := repoGit.DeleteBranch(branch, true); err != nil {
+		if err := repoGit.DeleteBranch(branch, git.BranchDeleteOptions{Force: true}); err != nil {
 			style.PrintWarning("could not delete branch %s: %v", branch, err)
 			continue
 		}
@@ -3,13 +3,17 @@ package git
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // GitError contains raw output from a git command for agent observation.
@@ -57,7 +61,8 @@ func moveDir(src, dest string) error {
 // Git wraps git operations for a working directory.
 type Git struct {
 	workDir string
-	gitDir  string // Optional: explicit git directory (for bare repos)
+	gitDir  string       // Optional: explicit git directory (for bare repos)
+	logger  *slog.Logger // Optional: debug logging of commands and decisions
 }
 
 // NewGit creates a new Git wrapper for the given directory.
@@ -72,6 +77,20 @@ func NewGitWithDir(gitDir, workDir string) *Git {
 	return &Git{gitDir: gitDir, workDir: workDir}
 }
 
+// SetLogger enables debug logging of every git command run and of branch
+// decisions made by higher-level operations such as PruneStaleBranches.
+// A nil logger disables logging.
+func (g *Git) SetLogger(logger *slog.Logger) {
+	g.logger = logger
+}
+
+// debug logs at debug level if a logger is set.
+func (g *Git) debug(msg string, args ...any) {
+	if g.logger != nil {
+		g.logger.Debug(msg, args...)
+	}
+}
+
 // WorkDir returns the working directory for this Git instance.
 func (g *Git) WorkDir() string {
 	return g.workDir
@@ -85,12 +104,18 @@ func (g *Git) IsRepo() bool {
 
 // run executes a git command and returns stdout.
 func (g *Git) run(args ...string) (string, error) {
+	return g.runContext(context.Background(), args...)
+}
+
+// runContext executes a git command that is killed if ctx is done.
+// If the command is killed, the returned GitError wraps ctx.Err().
+func (g *Git) runContext(ctx context.Context, args ...string) (string, error) {
 	// If gitDir is set (bare repo), prepend --git-dir flag
 	if g.gitDir != "" {
 		args = append([]string{"--git-dir=" + g.gitDir}, args...)
 	}
 
-	cmd := exec.Command("git", args...)
+	cmd := exec.CommandContext(ctx, "git", args...)
 	if g.workDir != "" {
 		cmd.Dir = g.workDir
 	}
@@ -100,7 +125,11 @@ func (g *Git) run(args ...string) (string, error) {
 	cmd.Stderr = &stderr
 
 	err := cmd.Run()
+	g.debug("git", "args", args, "dir", g.workDir, "err", err)
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = ctxErr
+		}
 		return "", g.wrapError(err, stdout.String(), stderr.String(), args)
 	}
 
@@ -123,6 +152,7 @@ func (g *Git) runWithEnv(args []string, extraEnv []string) (string, error) {
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 	err := cmd.Run()
+	g.debug("git", "args", args, "dir", g.workDir, "err", err)
 	if err != nil {
 		return "", g.wrapError(err, stdout.String(), stderr.String(), args)
 	}
@@ -133,12 +163,23 @@ func (g *Git) runWithEnv(args []string, extraEnv []string) (string, error) {
 // ZFC: Returns GitError with raw output for agent observation.
 // Does not detect or interpret error types - agents should observe and decide.
 func (g *Git) wrapError(err error, stdout, stderr string, args []string) error {
+	return newGitError(err, stdout, stderr, args)
+}
+
+// newGitError builds the *GitError for a failed git command run with args.
+func newGitError(err error, stdout, stderr string, args []string) *GitError {
 	stdout = strings.TrimSpace(stdout)
 	stderr = strings.TrimSpace(stderr)
 
-	// Determine command name (first arg, or first non-flag arg)
+	// Determine command name: the first arg that is neither a global flag
+	// nor the value of one (as in "-C <path>")
 	command := ""
-	for _, arg := range args {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "-C" || arg == "-c" || arg == "--git-dir" || arg == "--work-tree" {
+			i++
+			continue
+		}
 		if !strings.HasPrefix(arg, "-") {
 			command = arg
 			break
@@ -157,6 +198,20 @@ func (g *Git) wrapError(err error, stdout, stderr string, args []string) error {
 	}
 }
 
+// execGit runs a git command that isn't tied to a Git's working directory
+// (pass -C to choose one) and returns its trimmed stdout. A failure is
+// returned as a *GitError carrying git's stderr.
+func execGit(args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", newGitError(err, stdout.String(), stderr.String(), args)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
 // Clone clones a repository to the destination.
 func (g *Git) Clone(url, dest string) error {
 	// Ensure destination directory's parent exists
@@ -286,11 +341,8 @@ func configureHooksPath(repoPath string) error {
 		return nil
 	}
 
-	cmd := exec.Command("git", "-C", repoPath, "config", "core.hooksPath", ".githooks")
-	var stderr bytes.Buffer
-	cmd.Stderr = &stderr
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("configuring hooks path: %s", strings.TrimSpace(stderr.String()))
+	if _, err := execGit("-C", repoPath, "config", "core.hooksPath", ".githooks"); err != nil {
+		return fmt.Errorf("configuring hooks path: %w", err)
 	}
 	return nil
 }
@@ -312,17 +364,12 @@ func configureRefspec(repoPath string) error {
 	}
 	gitDir = filepath.Clean(gitDir)
 
-	var stderr bytes.Buffer
-	configCmd := exec.Command("git", "--git-dir", gitDir, "config", "remote.origin.fetch", "+refs/heads/*:refs/remotes/origin/*")
-	configCmd.Stderr = &stderr
-	if err := configCmd.Run(); err != nil {
-		return fmt.Errorf("configuring refspec: %s", strings.TrimSpace(stderr.String()))
+	if _, err := execGit("--git-dir", gitDir, "config", "remote.origin.fetch", "+refs/heads/*:refs/remotes/origin/*"); err != nil {
+		return fmt.Errorf("configuring refspec: %w", err)
 	}
 
-	fetchCmd := exec.Command("git", "--git-dir", gitDir, "fetch", "origin")
-	fetchCmd.Stderr = &stderr
-	if err := fetchCmd.Run(); err != nil {
-		return fmt.Errorf("fetching origin: %s", strings.TrimSpace(stderr.String()))
+	if _, err := execGit("--git-dir", gitDir, "fetch", "origin"); err != nil {
+		return fmt.Errorf("fetching origin: %w", err)
 	}
 
 	return nil
@@ -375,10 +422,15 @@ func (g *Git) Fetch(remote string) error {
 	return err
 }
 
+// DefaultFetchTimeout bounds FetchPrune calls so an unreachable remote
+// can't hang a command indefinitely.
+const DefaultFetchTimeout = 30 * time.Second
+
 // FetchPrune fetches from the remote and prunes stale remote-tracking refs.
 // This removes remote-tracking branches for branches that no longer exist on the remote.
-func (g *Git) FetchPrune(remote string) error {
-	_, err := g.run("fetch", "--prune", remote)
+// The fetch is killed when ctx is done, so callers can bound an unreachable remote.
+func (g *Git) FetchPrune(ctx context.Context, remote string) error {
+	_, err := g.runContext(ctx, "fetch", "--prune", remote)
 	return err
 }
 
@@ -455,6 +507,14 @@ func (g *Git) PushWithEnv(remote, branch string, force bool, env []string) error
 	return err
 }
 
+// PushWithLease force-pushes a branch with --force-with-lease, which
+// refuses to overwrite the remote branch if it has moved since it was last
+// fetched.
+func (g *Git) PushWithLease(remote, branch string) error {
+	_, err := g.run("push", "--force-with-lease", remote, branch)
+	return err
+}
+
 // Add stages files for commit.
 func (g *Git) Add(paths ...string) error {
 	args := append([]string{"add"}, paths...)
@@ -577,7 +637,11 @@ func (g *Git) HasUncommittedChanges() (bool, error) {
 
 // RemoteURL returns the URL for the given remote.
 func (g *Git) RemoteURL(remote string) (string, error) {
-	return g.run("remote", "get-url", remote)
+	url, err := g.run("remote", "get-url", remote)
+	if err != nil {
+		return "", fmt.Errorf("getting URL of remote %s: %w", remote, err)
+	}
+	return url, nil
 }
 
 // AddRemote adds a new remote with the given name and URL.
@@ -586,8 +650,11 @@ func (g *Git) AddRemote(name, url string) (string, error) {
 }
 
 // SetRemoteURL updates the URL for an existing remote.
-func (g *Git) SetRemoteURL(name, url string) (string, error) {
-	return g.run("remote", "set-url", name, url)
+func (g *Git) SetRemoteURL(remote, url string) error {
+	if _, err := g.run("remote", "set-url", remote, url); err != nil {
+		return fmt.Errorf("setting URL of remote %s: %w", remote, err)
+	}
+	return nil
 }
 
 // Remotes returns the list of configured remote names.
@@ -602,6 +669,58 @@ func (g *Git) Remotes() ([]string, error) {
 	return strings.Split(out, "\n"), nil
 }
 
+// ObjectStats is the object database size reported by git count-objects.
+type ObjectStats struct {
+	LooseObjects  int   // Unpacked objects
+	LooseKiB      int64 // Disk space used by loose objects
+	PackedObjects int   // Objects in packs
+	Packs         int   // Number of pack files
+	PackKiB       int64 // Disk space used by pack files
+}
+
+// CountObjects reports the repository's loose and packed object sizes.
+func (g *Git) CountObjects() (*ObjectStats, error) {
+	out, err := g.run("count-objects", "-v")
+	if err != nil {
+		return nil, err
+	}
+	stats := &ObjectStats{}
+	for _, line := range strings.Split(out, "\n") {
+		key, value, ok := strings.Cut(line, ": ")
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			continue
+		}
+		switch key {
+		case "count":
+			stats.LooseObjects = int(n)
+		case "size":
+			stats.LooseKiB = n
+		case "in-pack":
+			stats.PackedObjects = int(n)
+		case "packs":
+			stats.Packs = int(n)
+		case "size-pack":
+			stats.PackKiB = n
+		}
+	}
+	return stats, nil
+}
+
+// GC runs git gc, packing loose objects and pruning unreachable ones.
+// aggressive trades a much slower run for tighter packs.
+func (g *Git) GC(aggressive bool) error {
+	args := []string{"gc", "--quiet"}
+	if aggressive {
+		args = append(args, "--aggressive")
+	}
+	_, err := g.run(args...)
+	return err
+}
+
 // ConfigGet returns the value of a git config key.
 // Returns empty string if the key is not set.
 func (g *Git) ConfigGet(key string) (string, error) {
@@ -646,12 +765,128 @@ func (g *Git) GetBranchCommitMessage(branch string) (string, error) {
 	return g.run("log", "-1", "--format=%B", branch)
 }
 
+// LogEntry is one commit from Log.
+type LogEntry struct {
+	Hash    string
+	Author  string
+	Date    time.Time // Author date
+	Subject string
+}
+
+// Log returns up to n commits reachable from rev, newest first. rev may be a
+// range such as "origin/main..polecat/toast". n <= 0 means no limit.
+func (g *Git) Log(rev string, n int) ([]LogEntry, error) {
+	args := []string{"log", "--format=%H%x00%an%x00%aI%x00%s"}
+	if n > 0 {
+		args = append(args, fmt.Sprintf("-n%d", n))
+	}
+	out, err := g.run(append(args, rev, "--")...)
+	if err != nil {
+		return nil, err
+	}
+	if out == "" {
+		return nil, nil
+	}
+
+	var entries []LogEntry
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.SplitN(line, "\x00", 4)
+		if len(fields) != 4 {
+			continue
+		}
+		date, _ := time.Parse(time.RFC3339, fields[2])
+		entries = append(entries, LogEntry{
+			Hash:    fields[0],
+			Author:  fields[1],
+			Date:    date,
+			Subject: fields[3],
+		})
+	}
+	return entries, nil
+}
+
+// LastCommitTime returns the committer time of the most recent commit on ref.
+func (g *Git) LastCommitTime(ref string) (time.Time, error) {
+	out, err := g.run("log", "-1", "--format=%cI", ref)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, out)
+}
+
 // DeleteRemoteBranch deletes a branch on the remote.
 func (g *Git) DeleteRemoteBranch(remote, branch string) error {
 	_, err := g.run("push", remote, "--delete", branch)
 	return err
 }
 
+// TagOptions controls how TagWithOptions creates a tag.
+// The zero value creates an annotated tag with an empty message.
+type TagOptions struct {
+	// Message is the annotated tag's message (git tag -a -m).
+	Message string
+
+	// Lightweight creates a plain ref to the commit instead of a tag object;
+	// Message is ignored.
+	Lightweight bool
+}
+
+// Tag creates an annotated tag name at commit (git tag -a <name> -m <message> <commit>).
+// An empty commit tags HEAD.
+func (g *Git) Tag(name, message, commit string) error {
+	return g.TagWithOptions(name, commit, TagOptions{Message: message})
+}
+
+// TagWithOptions creates a tag name at commit, or at HEAD if commit is empty.
+// It fails if the tag already exists.
+func (g *Git) TagWithOptions(name, commit string, opts TagOptions) error {
+	args := []string{"tag"}
+	if !opts.Lightweight {
+		args = append(args, "-a", "-m", opts.Message)
+	}
+	args = append(args, name)
+	if commit != "" {
+		args = append(args, commit)
+	}
+	_, err := g.run(args...)
+	return err
+}
+
+// DeleteTag deletes a local tag.
+func (g *Git) DeleteTag(name string) error {
+	_, err := g.run("tag", "-d", name)
+	return err
+}
+
+// PushTag pushes a single tag to the remote.
+func (g *Git) PushTag(remote, name string) error {
+	_, err := g.run("push", remote, "refs/tags/"+name)
+	return err
+}
+
+// ListRemoteBranches returns the names of the branches on remote that start
+// with prefix (e.g. "polecat/"), queried live with ls-remote. Names are
+// returned without refs/heads/ (polecat/furiosa-abc123) and each appears
+// once. ls-remote patterns match the end of a ref, so refs outside
+// refs/heads/ that happen to end in refs/heads/<prefix>... are dropped.
+func (g *Git) ListRemoteBranches(remote, prefix string) ([]string, error) {
+	refs, err := g.ListRemoteRefs(remote, "refs/heads/"+prefix)
+	if err != nil {
+		return nil, err
+	}
+	var branches []string
+	seen := make(map[string]bool)
+	for _, ref := range refs {
+		branch, ok := strings.CutPrefix(ref, "refs/heads/")
+		if !ok || !strings.HasPrefix(branch, prefix) || seen[branch] {
+			continue
+		}
+		seen[branch] = true
+		branches = append(branches, branch)
+	}
+	return branches, nil
+}
+
 // ListRemoteRefs returns remote ref names matching a prefix using ls-remote.
 // The prefix filters refs (e.g., "refs/heads/polecat/" for all polecat branches).
 // Returns full ref names like "refs/heads/polecat/furiosa-abc123".
@@ -771,12 +1006,167 @@ func (g *Git) GetConflictingFiles() ([]string, error) {
 	return result, nil
 }
 
+// CherryPickConflictError is returned by CherryPick when a commit doesn't
+// apply cleanly. The cherry-pick is left in progress: resolve Files and run
+// 'git cherry-pick --continue', or call CherryPickAbort.
+type CherryPickConflictError struct {
+	Commit string   // The commit that failed to apply, if git reported it
+	Files  []string // Unmerged paths from git status --porcelain
+	Err    error    // The underlying GitError
+}
+
+func (e *CherryPickConflictError) Error() string {
+	if e.Commit != "" {
+		return fmt.Sprintf("cherry-pick of %s conflicts in %s", e.Commit, strings.Join(e.Files, ", "))
+	}
+	return fmt.Sprintf("cherry-pick conflicts in %s", strings.Join(e.Files, ", "))
+}
+
+func (e *CherryPickConflictError) Unwrap() error {
+	return e.Err
+}
+
+// CherryPick applies the given commits onto the current branch, in order.
+// Returns a *CherryPickConflictError if a commit conflicts.
+func (g *Git) CherryPick(commits ...string) error {
+	if len(commits) == 0 {
+		return fmt.Errorf("cherry-pick: no commits given")
+	}
+	_, err := g.run(append([]string{"cherry-pick"}, commits...)...)
+	if err == nil {
+		return nil
+	}
+
+	files, statusErr := g.unmergedFiles()
+	if statusErr != nil || len(files) == 0 {
+		return err
+	}
+	conflict := &CherryPickConflictError{Files: files, Err: err}
+	if head, headErr := g.run("rev-parse", "--verify", "-q", "CHERRY_PICK_HEAD"); headErr == nil {
+		conflict.Commit = head
+	}
+	return conflict
+}
+
+// CherryPickAbort abandons a cherry-pick in progress, restoring the branch
+// to its state before CherryPick.
+func (g *Git) CherryPickAbort() error {
+	_, err := g.run("cherry-pick", "--abort")
+	return err
+}
+
+// unmergedFiles returns the paths git status --porcelain reports as unmerged.
+func (g *Git) unmergedFiles() ([]string, error) {
+	out, err := g.run("status", "--porcelain")
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, line := range strings.Split(out, "\n") {
+		if len(line) < 4 {
+			continue
+		}
+		// Unmerged entries: both sides touched the path (DD, AU, UD, UA, DU, AA, UU)
+		switch line[:2] {
+		case "DD", "AU", "UD", "UA", "DU", "AA", "UU":
+			files = append(files, line[3:])
+		}
+	}
+	return files, nil
+}
+
 // AbortRebase aborts a rebase in progress.
 func (g *Git) AbortRebase() error {
 	_, err := g.run("rebase", "--abort")
 	return err
 }
 
+// RebaseInProgress reports whether a rebase is stopped in this working tree,
+// e.g. waiting for conflicts to be resolved. Linked worktrees are checked in
+// their own git dir, not the shared repository's. When workDir is the top of
+// the working tree this is only a few stats, with no git subprocess, since
+// polecat.Manager.Get calls it for every polecat it lists.
+func (g *Git) RebaseInProgress() (bool, error) {
+	if gitDir := g.localGitDir(); gitDir != "" {
+		for _, name := range []string{"rebase-merge", "rebase-apply"} {
+			if _, err := os.Stat(filepath.Join(gitDir, name)); err == nil {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	for _, name := range []string{"rebase-merge", "rebase-apply"} {
+		path, err := g.run("rev-parse", "--git-path", name)
+		if err != nil {
+			return false, err
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(g.workDir, path)
+		}
+		if _, err := os.Stat(path); err == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// localGitDir returns the git dir of the working tree rooted at workDir,
+// read from workDir/.git: the directory itself or, for a linked worktree,
+// the "gitdir:" path in the .git file. Returns "" if there is no usable .git
+// in workDir (e.g. workDir is a subdirectory).
+func (g *Git) localGitDir() string {
+	dotGit := filepath.Join(g.workDir, ".git")
+	info, err := os.Stat(dotGit)
+	if err != nil {
+		return ""
+	}
+	if info.IsDir() {
+		return dotGit
+	}
+	data, err := os.ReadFile(dotGit)
+	if err != nil {
+		return ""
+	}
+	gitDir, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir: ")
+	if !ok {
+		return ""
+	}
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(g.workDir, gitDir)
+	}
+	return gitDir
+}
+
+// RebaseConflicts predicts which files would conflict if HEAD were rebased
+// onto onto, without touching the working tree or index. It merges the two
+// tips with 'git merge-tree --write-tree' (git 2.38+), so it reports the
+// conflicts of the combined change rather than of each replayed commit.
+// Returns nil if the rebase would apply cleanly.
+func (g *Git) RebaseConflicts(onto string) ([]string, error) {
+	_, err := g.run("merge-tree", "--write-tree", "--name-only", "--no-messages", onto, "HEAD")
+	if err == nil {
+		return nil, nil
+	}
+
+	// Exit status 1 with output means conflicts: stdout is the tree OID
+	// followed by the conflicted paths. Bad refs also exit 1, but print
+	// nothing on stdout.
+	var gitErr *GitError
+	var exitErr *exec.ExitError
+	if !errors.As(err, &gitErr) || !errors.As(err, &exitErr) || exitErr.ExitCode() != 1 || gitErr.Stdout == "" {
+		return nil, err
+	}
+	lines := strings.Split(strings.TrimSpace(gitErr.Stdout), "\n")
+	var files []string
+	for _, line := range lines[1:] {
+		if line != "" {
+			files = append(files, line)
+		}
+	}
+	return files, nil
+}
+
 // CreateBranch creates a new branch.
 func (g *Git) CreateBranch(name string) error {
 	_, err := g.run("branch", name)
@@ -870,13 +1260,53 @@ func (g *Git) RemoteTrackingBranchExists(remote, branch string) (bool, error) {
 	return true, nil
 }
 
+// BranchDeleteOptions controls how DeleteBranch removes a local branch.
+// The zero value is a safe delete (git branch -d).
+type BranchDeleteOptions struct {
+	// Force deletes the branch even if git considers it unmerged (git branch -D).
+	Force bool
+
+	// MustBeMerged refuses to delete the branch unless it is fully merged into
+	// the remote default branch (origin/<default>, or the local default branch
+	// when there is no origin). It applies even with Force, and unlike -d it
+	// does not depend on what HEAD happens to point at.
+	MustBeMerged bool
+}
+
+// ErrBranchNotMerged is returned by DeleteBranch when MustBeMerged is set and
+// the branch is not merged into the default branch.
+var ErrBranchNotMerged = errors.New("branch is not merged into the default branch")
+
+// ErrBranchNotFullyMerged is returned by DeleteBranch when a safe delete
+// (git branch -d) refuses a branch that isn't merged into its upstream or
+// HEAD.
+var ErrBranchNotFullyMerged = errors.New("branch is not fully merged")
+
 // DeleteBranch deletes a local branch.
-func (g *Git) DeleteBranch(name string, force bool) error {
+func (g *Git) DeleteBranch(name string, opts BranchDeleteOptions) error {
+	if opts.MustBeMerged {
+		target := "origin/" + g.RemoteDefaultBranch()
+		if _, err := g.run("rev-parse", "--verify", "--quiet", target); err != nil {
+			target = g.DefaultBranch()
+		}
+		merged, err := g.IsAncestor(name, target)
+		if err != nil {
+			return fmt.Errorf("checking whether %s is merged into %s: %w", name, target, err)
+		}
+		if !merged {
+			return fmt.Errorf("%s into %s: %w", name, target, ErrBranchNotMerged)
+		}
+	}
+
 	flag := "-d"
-	if force {
+	if opts.Force {
 		flag = "-D"
 	}
 	_, err := g.run("branch", flag, name)
+	var gitErr *GitError
+	if errors.As(err, &gitErr) && strings.Contains(gitErr.Stderr, "not fully merged") {
+		return fmt.Errorf("%s: %w", name, ErrBranchNotFullyMerged)
+	}
 	return err
 }
 
@@ -884,10 +1314,44 @@ func (g *Git) DeleteBranch(name string, force bool) error {
 // Pattern uses git's pattern matching (e.g., "polecat/*" matches all polecat branches).
 // Returns branch names without the refs/heads/ prefix.
 func (g *Git) ListBranches(pattern string) ([]string, error) {
-	args := []string{"branch", "--list", "--format=%(refname:short)"}
+	var patterns []string
 	if pattern != "" {
-		args = append(args, pattern)
+		patterns = []string{pattern}
+	}
+	return g.listBranches(patterns)
+}
+
+// ListBranchesExcluding returns the local branches matching any include
+// pattern (all branches if there are none) and no exclude pattern, e.g.
+// polecat/* without polecat/base. Patterns use git's matching, in which *
+// also matches /.
+func (g *Git) ListBranchesExcluding(include, exclude []string) ([]string, error) {
+	branches, err := g.listBranches(include)
+	if err != nil || len(exclude) == 0 || len(branches) == 0 {
+		return branches, err
+	}
+	// git branch has no --exclude, so list the excluded branches separately
+	excluded, err := g.listBranches(exclude)
+	if err != nil {
+		return nil, err
+	}
+	skip := make(map[string]bool, len(excluded))
+	for _, b := range excluded {
+		skip[b] = true
+	}
+	var kept []string
+	for _, b := range branches {
+		if !skip[b] {
+			kept = append(kept, b)
+		}
 	}
+	return kept, nil
+}
+
+// listBranches lists local branches matching any of patterns, or all
+// branches if there are none.
+func (g *Git) listBranches(patterns []string) ([]string, error) {
+	args := append([]string{"branch", "--list", "--format=%(refname:short)"}, patterns...)
 	out, err := g.run(args...)
 	if err != nil {
 		return nil, err
@@ -987,11 +1451,8 @@ func IsSparseCheckoutConfigured(repoPath string) bool {
 // This is used by doctor to clean up legacy sparse checkout configurations.
 func RemoveSparseCheckout(repoPath string) error {
 	// Use git sparse-checkout disable which properly restores hidden files
-	cmd := exec.Command("git", "-C", repoPath, "sparse-checkout", "disable")
-	var stderr bytes.Buffer
-	cmd.Stderr = &stderr
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("disabling sparse checkout: %s", strings.TrimSpace(stderr.String()))
+	if _, err := execGit("-C", repoPath, "sparse-checkout", "disable"); err != nil {
+		return fmt.Errorf("disabling sparse checkout: %w", err)
 	}
 	return nil
 }
@@ -1012,11 +1473,21 @@ func (g *Git) WorktreePrune() error {
 	return err
 }
 
+// WorktreeRepair re-links worktrees after they (or this repository) have moved.
+// paths are the new locations of linked worktrees.
+func (g *Git) WorktreeRepair(paths ...string) error {
+	args := append([]string{"worktree", "repair"}, paths...)
+	_, err := g.run(args...)
+	return err
+}
+
 // Worktree represents a git worktree.
 type Worktree struct {
-	Path   string
-	Branch string
-	Commit string
+	Path     string
+	Branch   string // Checked-out branch, without refs/heads/ (empty if detached or bare)
+	Commit   string // HEAD commit (empty for a bare repository)
+	Bare     bool   // The main worktree of a bare repository
+	Detached bool   // HEAD is detached
 }
 
 // WorktreeList returns all worktrees for this repository.
@@ -1045,6 +1516,10 @@ func (g *Git) WorktreeList() ([]Worktree, error) {
 			current.Commit = strings.TrimPrefix(line, "HEAD ")
 		case strings.HasPrefix(line, "branch "):
 			current.Branch = strings.TrimPrefix(line, "branch refs/heads/")
+		case line == "bare":
+			current.Bare = true
+		case line == "detached":
+			current.Detached = true
 		}
 	}
 
@@ -1176,6 +1651,88 @@ func (g *Git) StashCount() (int, error) {
 	return count, nil
 }
 
+// ErrNothingToStash is returned by Stash when there are no local changes to save.
+var ErrNothingToStash = errors.New("no local changes to stash")
+
+// ErrNoStash is returned by StashPop when the stash is empty.
+var ErrNoStash = errors.New("no stash entries")
+
+// StashEntry is a single entry from git stash list.
+type StashEntry struct {
+	Index   int    // N in stash@{N}
+	Message string // Stash message (or "<hash> <subject>" for WIP stashes)
+	Branch  string // Branch the stash was taken on
+}
+
+// Stash saves local changes (including staged changes) with the given message.
+// Returns ErrNothingToStash if the working tree is clean.
+func (g *Git) Stash(message string) error {
+	out, err := g.run("stash", "push", "-m", message)
+	if err != nil {
+		return err
+	}
+	// git exits 0 when there is nothing to stash
+	if strings.Contains(out, "No local changes to save") {
+		return ErrNothingToStash
+	}
+	return nil
+}
+
+// StashPop applies the most recent stash and removes it from the stash list.
+// Returns ErrNoStash if there is nothing to pop.
+func (g *Git) StashPop() error {
+	_, err := g.run("stash", "pop")
+	var gitErr *GitError
+	if errors.As(err, &gitErr) && strings.Contains(gitErr.Stderr, "No stash entries found") {
+		return ErrNoStash
+	}
+	return err
+}
+
+// StashList returns all stash entries, most recent first.
+// Unlike StashCount, entries are not filtered by the current branch.
+func (g *Git) StashList() ([]StashEntry, error) {
+	out, err := g.run("stash", "list", "--format=%gd%x00%gs")
+	if err != nil {
+		return nil, err
+	}
+	if out == "" {
+		return nil, nil
+	}
+
+	var entries []StashEntry
+	for _, line := range strings.Split(out, "\n") {
+		ref, subject, ok := strings.Cut(line, "\x00")
+		if !ok {
+			continue
+		}
+		entry, ok := parseStashEntry(ref, subject)
+		if ok {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// parseStashEntry parses a stash ref ("stash@{N}") and reflog subject
+// ("On <branch>: <message>" or "WIP on <branch>: <hash> <subject>").
+func parseStashEntry(ref, subject string) (StashEntry, bool) {
+	var entry StashEntry
+	if _, err := fmt.Sscanf(ref, "stash@{%d}", &entry.Index); err != nil {
+		return entry, false
+	}
+	rest, found := strings.CutPrefix(subject, "WIP on ")
+	if !found {
+		rest, found = strings.CutPrefix(subject, "On ")
+	}
+	if !found {
+		entry.Message = subject
+		return entry, true
+	}
+	entry.Branch, entry.Message, _ = strings.Cut(rest, ": ")
+	return entry, true
+}
+
 // UnpushedCommits returns the number of commits that are not pushed to the remote.
 // It checks if the current branch has an upstream and counts commits ahead.
 // Returns 0 if there is no upstream configured.
@@ -1411,19 +1968,25 @@ func (g *Git) PruneStaleBranches(pattern string, dryRun bool) ([]PrunedBranch, e
 	var pruned []PrunedBranch
 	for _, branch := range branches {
 		branch = strings.TrimSpace(branch)
-		if branch == "" || branch == currentBranch || branch == defaultBranch {
+		if branch == "" {
+			continue
+		}
+		if branch == currentBranch || branch == defaultBranch {
+			g.debug("keep branch", "branch", branch, "reason", "current or default branch")
 			continue
 		}
 
 		// Check if the remote tracking branch still exists
 		hasRemote, err := g.RemoteTrackingBranchExists("origin", branch)
 		if err != nil {
+			g.debug("keep branch", "branch", branch, "reason", "remote check failed", "err", err)
 			continue // Skip on error, don't fail the whole operation
 		}
 
 		// Check if the branch is merged to the default branch
 		merged, err := g.IsAncestor(branch, "origin/"+defaultBranch)
 		if err != nil {
+			g.debug("keep branch", "branch", branch, "reason", "merge status unknown", "err", err)
 			// If we can't determine merge status, only prune if remote is gone
 			if hasRemote {
 				continue
@@ -1431,6 +1994,7 @@ func (g *Git) PruneStaleBranches(pattern string, dryRun bool) ([]PrunedBranch, e
 			// Remote gone and can't check merge status — skip to be safe
 			continue
 		}
+		g.debug("evaluate branch", "branch", branch, "has_remote", hasRemote, "merged", merged)
 
 		var reason string
 		if merged && !hasRemote {
@@ -1440,17 +2004,20 @@ func (g *Git) PruneStaleBranches(pattern string, dryRun bool) ([]PrunedBranch, e
 		} else if !hasRemote {
 			reason = "no-remote"
 		} else {
+			g.debug("keep branch", "branch", branch, "reason", "has remote and not merged")
 			continue // Branch has remote and is not merged — keep it
 		}
 
 		if !dryRun {
 			// Use -d (not -D) for safety — only deletes fully merged branches.
 			// For "no-remote" branches that aren't merged, -d will fail safely.
-			if err := g.DeleteBranch(branch, false); err != nil {
+			if err := g.DeleteBranch(branch, BranchDeleteOptions{}); err != nil {
 				// If -d fails (not merged), skip this branch
+				g.debug("keep branch", "branch", branch, "reason", "not fully merged", "err", err)
 				continue
 			}
 		}
+		g.debug("prune branch", "branch", branch, "reason", reason, "dry_run", dryRun)
 
 		pruned = append(pruned, PrunedBranch{
 			Name:   branch,
@@ -1476,15 +2043,81 @@ func InitSubmodules(repoPath string) error {
 	if _, err := os.Stat(gitmodules); os.IsNotExist(err) {
 		return nil
 	}
-	cmd := exec.Command("git", "-C", repoPath, "submodule", "update", "--init", "--recursive")
-	var stderr bytes.Buffer
-	cmd.Stderr = &stderr
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("initializing submodules: %s", strings.TrimSpace(stderr.String()))
+	if _, err := execGit("-C", repoPath, "submodule", "update", "--init", "--recursive"); err != nil {
+		return fmt.Errorf("initializing submodules: %w", err)
 	}
 	return nil
 }
 
+// Diff returns the output of git diff base..head. With stat, only the
+// diffstat is returned. An empty string means the refs have no differences.
+func (g *Git) Diff(base, head string, stat bool) (string, error) {
+	args := []string{"diff"}
+	if stat {
+		args = append(args, "--stat")
+	}
+	args = append(args, base+".."+head)
+	return g.run(args...)
+}
+
+// FormatPatch writes one patch file per commit in base..head into outDir
+// (git format-patch -o) and returns the paths of the files written.
+func (g *Git) FormatPatch(base, head, outDir string) ([]string, error) {
+	out, err := g.run("format-patch", "-o", outDir, base+".."+head)
+	if err != nil {
+		return nil, err
+	}
+	if out == "" {
+		return nil, nil
+	}
+	return strings.Split(out, "\n"), nil
+}
+
+// FormatPatchStdout returns the commits in base..head as a single mbox-style
+// patch stream (git format-patch --stdout).
+func (g *Git) FormatPatchStdout(base, head string) (string, error) {
+	return g.run("format-patch", "--stdout", base+".."+head)
+}
+
+// BundleCreate writes a git bundle containing the commits in base..head to
+// path. head must be a ref (e.g. a branch name) so the bundle can be fetched from.
+func (g *Git) BundleCreate(path, base, head string) error {
+	_, err := g.run("bundle", "create", path, base+".."+head)
+	return err
+}
+
+// Am applies mbox patches (git format-patch output) as commits with
+// 'git am --3way'. If a patch doesn't apply, the am session is left in
+// progress with conflicts to resolve (git am --continue / --abort).
+func (g *Git) Am(patches ...string) error {
+	_, err := g.run(append([]string{"am", "--3way"}, patches...)...)
+	return err
+}
+
+// Apply applies a plain diff to the working tree and index with
+// 'git apply --3way', without committing. Hunks that don't apply cleanly
+// are left as conflicts in the affected files.
+func (g *Git) Apply(patch string) error {
+	_, err := g.run("apply", "--3way", patch)
+	return err
+}
+
+// BundleUnbundle stores the objects from a git bundle in the repository and
+// returns the commit each of the bundle's refs points to, in bundle order.
+func (g *Git) BundleUnbundle(path string) ([]string, error) {
+	out, err := g.run("bundle", "unbundle", path)
+	if err != nil {
+		return nil, err
+	}
+	var heads []string
+	for _, line := range strings.Split(out, "\n") {
+		if sha, _, ok := strings.Cut(line, " "); ok {
+			heads = append(heads, sha)
+		}
+	}
+	return heads, nil
+}
+
 // SubmoduleChanges detects submodule pointer changes between two refs.
 // Returns nil if no submodules changed or if the repo has no submodules.
 func (g *Git) SubmoduleChanges(base, head string) ([]SubmoduleChange, error) {
@@ -1565,15 +2198,13 @@ func (g *Git) submoduleURL(ref, submodulePath string) (string, error) {
 	tmpFile.Close()
 
 	// List all submodule.<name>.path entries to find the section matching our path
-	cmd := exec.Command("git", "config", "-f", tmpFile.Name(), "--get-regexp", `^submodule\..*\.path$`)
-	var stdout bytes.Buffer
-	cmd.Stdout = &stdout
-	if err := cmd.Run(); err != nil {
+	paths, err := execGit("config", "-f", tmpFile.Name(), "--get-regexp", `^submodule\..*\.path$`)
+	if err != nil {
 		return "", fmt.Errorf("reading submodule paths from .gitmodules: %w", err)
 	}
 
 	var sectionName string
-	for _, line := range strings.Split(stdout.String(), "\n") {
+	for _, line := range strings.Split(paths, "\n") {
 		line = strings.TrimSpace(line)
 		if line == "" {
 			continue
@@ -1593,13 +2224,10 @@ func (g *Git) submoduleURL(ref, submodulePath string) (string, error) {
 	}
 
 	// Get the URL for this section
-	urlCmd := exec.Command("git", "config", "-f", tmpFile.Name(), "--get", "submodule."+sectionName+".url")
-	var urlOut bytes.Buffer
-	urlCmd.Stdout = &urlOut
-	if err := urlCmd.Run(); err != nil {
+	url, err := execGit("config", "-f", tmpFile.Name(), "--get", "submodule."+sectionName+".url")
+	if err != nil {
 		return "", fmt.Errorf("reading URL for submodule %s: %w", sectionName, err)
 	}
-	url := strings.TrimSpace(urlOut.String())
 	if url == "" {
 		return "", fmt.Errorf("submodule URL not found for path %s", submodulePath)
 	}
@@ -1616,11 +2244,8 @@ func (g *Git) PushSubmoduleCommit(submodulePath, sha, remote string) error {
 	if err != nil {
 		return fmt.Errorf("detecting default branch for submodule %s: %w", submodulePath, err)
 	}
-	cmd := exec.Command("git", "-C", absPath, "push", remote, sha+":refs/heads/"+defaultBranch)
-	var stderr bytes.Buffer
-	cmd.Stderr = &stderr
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("pushing submodule %s commit %s: %s", submodulePath, sha[:8], strings.TrimSpace(stderr.String()))
+	if _, err := execGit("-C", absPath, "push", remote, sha+":refs/heads/"+defaultBranch); err != nil {
+		return fmt.Errorf("pushing submodule %s commit %s: %w", submodulePath, sha[:8], err)
 	}
 	return nil
 }
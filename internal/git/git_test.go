@@ -1,11 +1,19 @@
 package git
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 )
 
 func initTestRepo(t *testing.T) string {
@@ -283,6 +291,26 @@ func TestNotARepo(t *testing.T) {
 	}
 }
 
+func TestDirectGitErrorsKeepStderr(t *testing.T) {
+	dir := t.TempDir() // Not a git repo
+
+	err := RemoveSparseCheckout(dir)
+	var gitErr *GitError
+	if !errors.As(err, &gitErr) {
+		t.Fatalf("RemoveSparseCheckout error = %v (%T), want wrapped GitError", err, err)
+	}
+	if gitErr.Command != "sparse-checkout" {
+		t.Errorf("Command = %q, want sparse-checkout (not the -C path)", gitErr.Command)
+	}
+	if gitErr.Stderr == "" || !strings.Contains(err.Error(), gitErr.Stderr) {
+		t.Errorf("error %q should include git's stderr %q", err, gitErr.Stderr)
+	}
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		t.Errorf("error %v should unwrap to the exit status", err)
+	}
+}
+
 func TestRev(t *testing.T) {
 	dir := initTestRepo(t)
 	g := NewGit(dir)
@@ -734,6 +762,32 @@ func initTestRepoWithRemote(t *testing.T) (string, string, string) {
 	return localDir, remoteDir, mainBranch
 }
 
+func TestListBranchesExcluding(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+	for _, b := range []string{"polecat/Toast-1", "polecat/Nux-2", "polecat/base", "polecat/template", "feature/x"} {
+		if err := g.CreateBranch(b); err != nil {
+			t.Fatalf("CreateBranch(%s): %v", b, err)
+		}
+	}
+
+	got, err := g.ListBranchesExcluding([]string{"polecat/*"}, []string{"polecat/base", "polecat/temp*"})
+	if err != nil {
+		t.Fatalf("ListBranchesExcluding: %v", err)
+	}
+	if want := []string{"polecat/Nux-2", "polecat/Toast-1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ListBranchesExcluding = %v, want %v", got, want)
+	}
+
+	got, err = g.ListBranchesExcluding([]string{"polecat/Toast-*", "feature/*"}, nil)
+	if err != nil {
+		t.Fatalf("ListBranchesExcluding: %v", err)
+	}
+	if want := []string{"feature/x", "polecat/Toast-1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ListBranchesExcluding with no excludes = %v, want %v", got, want)
+	}
+}
+
 func TestPruneStaleBranches_MergedBranch(t *testing.T) {
 	localDir, _, mainBranch := initTestRepoWithRemote(t)
 	g := NewGit(localDir)
@@ -785,7 +839,7 @@ func TestPruneStaleBranches_MergedBranch(t *testing.T) {
 	}
 
 	// Fetch --prune to remove remote tracking ref
-	if err := g.FetchPrune("origin"); err != nil {
+	if err := g.FetchPrune(context.Background(), "origin"); err != nil {
 		t.Fatalf("FetchPrune: %v", err)
 	}
 
@@ -940,6 +994,59 @@ func TestPruneStaleBranches_SkipsUnmerged(t *testing.T) {
 	}
 }
 
+func TestPruneStaleBranches_DebugLogging(t *testing.T) {
+	localDir, _, mainBranch := initTestRepoWithRemote(t)
+	g := NewGit(localDir)
+
+	// An unmerged local branch with no remote: evaluated, then kept by git branch -d
+	if err := g.CreateBranch("polecat/logged"); err != nil {
+		t.Fatalf("CreateBranch: %v", err)
+	}
+	if err := g.Checkout("polecat/logged"); err != nil {
+		t.Fatalf("Checkout: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(localDir, "logged.txt"), []byte("x"), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := g.Add("logged.txt"); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := g.Commit("logged work"); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	if err := g.Checkout(mainBranch); err != nil {
+		t.Fatalf("Checkout main: %v", err)
+	}
+
+	var buf bytes.Buffer
+	g.SetLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	if _, err := g.PruneStaleBranches("polecat/*", false); err != nil {
+		t.Fatalf("PruneStaleBranches: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		`msg="evaluate branch" branch=polecat/logged`,
+		`msg="keep branch" branch=polecat/logged reason="not fully merged"`,
+		`msg=git args="[branch -d polecat/logged]"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("debug log missing %q\n%s", want, out)
+		}
+	}
+
+	// Without a logger nothing is written
+	g.SetLogger(nil)
+	buf.Reset()
+	if _, err := g.PruneStaleBranches("polecat/*", true); err != nil {
+		t.Fatalf("PruneStaleBranches: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected no output with nil logger, got %q", buf.String())
+	}
+}
+
 func TestPushWithEnv(t *testing.T) {
 	localDir, _, mainBranch := initTestRepoWithRemote(t)
 	g := NewGit(localDir)
@@ -985,6 +1092,49 @@ exit 0
 	}
 }
 
+func TestPushWithLease(t *testing.T) {
+	localDir, remoteDir, mainBranch := initTestRepoWithRemote(t)
+	g := NewGit(localDir)
+
+	// Rewriting history that matches origin is allowed
+	runGit(t, localDir, "commit", "--amend", "-m", "rewritten")
+	if err := g.PushWithLease("origin", mainBranch); err != nil {
+		t.Fatalf("PushWithLease after amend: %v", err)
+	}
+
+	// Someone else pushes; our remote-tracking ref is now stale
+	otherDir := filepath.Join(t.TempDir(), "other")
+	runGit(t, filepath.Dir(otherDir), "clone", remoteDir, otherDir)
+	runGit(t, otherDir, "-c", "user.email=o@test.com", "-c", "user.name=Other", "commit", "--allow-empty", "-m", "other")
+	runGit(t, otherDir, "push", "origin", mainBranch)
+
+	runGit(t, localDir, "commit", "--amend", "-m", "rewritten again")
+	if err := g.PushWithLease("origin", mainBranch); err == nil {
+		t.Fatal("expected PushWithLease to refuse to overwrite a remote that moved")
+	}
+}
+
+func TestListRemoteBranches(t *testing.T) {
+	localDir, _, mainBranch := initTestRepoWithRemote(t)
+	g := NewGit(localDir)
+
+	runGit(t, localDir, "push", "origin", mainBranch+":polecat/toast-1")
+	runGit(t, localDir, "push", "origin", mainBranch+":polecat/nux-2")
+	runGit(t, localDir, "push", "origin", mainBranch+":feature/x")
+	// Ends in refs/heads/polecat/..., so ls-remote's tail matching returns it
+	runGit(t, localDir, "push", "origin", mainBranch+":refs/remotes/mirror/refs/heads/polecat/toast-1")
+
+	branches, err := g.ListRemoteBranches("origin", "polecat/")
+	if err != nil {
+		t.Fatalf("ListRemoteBranches: %v", err)
+	}
+	sort.Strings(branches)
+	want := []string{"polecat/nux-2", "polecat/toast-1"}
+	if !reflect.DeepEqual(branches, want) {
+		t.Errorf("ListRemoteBranches = %v, want %v", branches, want)
+	}
+}
+
 func TestFetchPrune(t *testing.T) {
 	localDir, _, mainBranch := initTestRepoWithRemote(t)
 	g := NewGit(localDir)
@@ -1019,7 +1169,7 @@ func TestFetchPrune(t *testing.T) {
 	}
 
 	// FetchPrune should remove the stale tracking ref
-	if err := g.FetchPrune("origin"); err != nil {
+	if err := g.FetchPrune(context.Background(), "origin"); err != nil {
 		t.Fatalf("FetchPrune: %v", err)
 	}
 
@@ -1032,6 +1182,18 @@ func TestFetchPrune(t *testing.T) {
 	}
 }
 
+func TestFetchPruneCanceled(t *testing.T) {
+	localDir, _, _ := initTestRepoWithRemote(t)
+	g := NewGit(localDir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := g.FetchPrune(ctx, "origin")
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("FetchPrune with canceled context = %v, want context.Canceled", err)
+	}
+}
+
 // initTestRepoWithSubmodule creates a parent repo with a submodule for testing.
 // Returns parentDir, submoduleRemoteDir (bare).
 func initTestRepoWithSubmodule(t *testing.T) (string, string) {
@@ -1311,6 +1473,59 @@ func TestGetPushURL_NoPushURL(t *testing.T) {
 	}
 }
 
+func TestRemoteURL(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	if _, err := g.AddRemote("origin", "https://github.com/upstream/repo.git"); err != nil {
+		t.Fatalf("AddRemote: %v", err)
+	}
+	if err := g.SetRemoteURL("origin", "https://github.com/moved/repo.git"); err != nil {
+		t.Fatalf("SetRemoteURL: %v", err)
+	}
+	got, err := g.RemoteURL("origin")
+	if err != nil {
+		t.Fatalf("RemoteURL: %v", err)
+	}
+	if got != "https://github.com/moved/repo.git" {
+		t.Errorf("RemoteURL = %q, want the updated URL", got)
+	}
+
+	// Errors name the remote and still unwrap to the GitError
+	_, err = g.RemoteURL("missing")
+	var gitErr *GitError
+	if err == nil || !strings.Contains(err.Error(), "remote missing") || !errors.As(err, &gitErr) {
+		t.Errorf("RemoteURL(missing) error = %v, want wrapped GitError naming the remote", err)
+	}
+	if err := g.SetRemoteURL("missing", "https://example.com/x.git"); err == nil || !strings.Contains(err.Error(), "remote missing") {
+		t.Errorf("SetRemoteURL(missing) error = %v, want error naming the remote", err)
+	}
+}
+
+func TestGCPacksLooseObjects(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	before, err := g.CountObjects()
+	if err != nil {
+		t.Fatalf("CountObjects: %v", err)
+	}
+	if before.LooseObjects == 0 {
+		t.Fatalf("CountObjects = %+v, want loose objects from the initial commit", before)
+	}
+
+	if err := g.GC(false); err != nil {
+		t.Fatalf("GC: %v", err)
+	}
+	after, err := g.CountObjects()
+	if err != nil {
+		t.Fatalf("CountObjects: %v", err)
+	}
+	if after.LooseObjects != 0 || after.Packs == 0 || after.PackedObjects < before.LooseObjects {
+		t.Errorf("after GC = %+v, want everything packed (before %+v)", after, before)
+	}
+}
+
 // TestStashCount_FiltersByBranch verifies that StashCount only counts stashes
 // belonging to the current branch, not stashes from other worktrees/branches.
 // Git stashes are repo-wide (stored in .git/refs/stash), so without filtering
@@ -1554,3 +1769,625 @@ func TestClearPushURL(t *testing.T) {
 		t.Errorf("ClearPushURL (idempotent) should not error, got: %v", err)
 	}
 }
+
+func TestLastCommitTime(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	got, err := g.LastCommitTime("HEAD")
+	if err != nil {
+		t.Fatalf("LastCommitTime: %v", err)
+	}
+	if age := time.Since(got); age < 0 || age > time.Hour {
+		t.Errorf("LastCommitTime = %v, want a time within the last hour", got)
+	}
+
+	if _, err := g.LastCommitTime("no-such-branch"); err == nil {
+		t.Error("expected error for unknown ref")
+	}
+}
+
+func TestStashAndPop(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	if err := g.Stash("empty"); !errors.Is(err, ErrNothingToStash) {
+		t.Fatalf("Stash on clean tree = %v, want ErrNothingToStash", err)
+	}
+	if err := g.StashPop(); !errors.Is(err, ErrNoStash) {
+		t.Fatalf("StashPop with no stash = %v, want ErrNoStash", err)
+	}
+
+	readme := filepath.Join(dir, "README.md")
+	if err := os.WriteFile(readme, []byte("# Changed\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Stash("before rebase"); err != nil {
+		t.Fatalf("Stash: %v", err)
+	}
+
+	entries, err := g.StashList()
+	if err != nil {
+		t.Fatalf("StashList: %v", err)
+	}
+	branch, _ := g.CurrentBranch()
+	if len(entries) != 1 {
+		t.Fatalf("StashList returned %d entries, want 1: %+v", len(entries), entries)
+	}
+	if want := (StashEntry{Index: 0, Message: "before rebase", Branch: branch}); entries[0] != want {
+		t.Errorf("StashList()[0] = %+v, want %+v", entries[0], want)
+	}
+
+	if err := g.StashPop(); err != nil {
+		t.Fatalf("StashPop: %v", err)
+	}
+	data, _ := os.ReadFile(readme)
+	if string(data) != "# Changed\n" {
+		t.Errorf("README after pop = %q, want stashed change restored", data)
+	}
+	if entries, _ := g.StashList(); len(entries) != 0 {
+		t.Errorf("StashList after pop = %+v, want empty", entries)
+	}
+}
+
+func TestParseStashEntry(t *testing.T) {
+	tests := []struct {
+		ref, subject string
+		want         StashEntry
+		ok           bool
+	}{
+		{"stash@{0}", "On main: save work", StashEntry{0, "save work", "main"}, true},
+		{"stash@{3}", "WIP on polecat/toast-1: abc123 fix: thing", StashEntry{3, "abc123 fix: thing", "polecat/toast-1"}, true},
+		{"stash@{1}", "autostash", StashEntry{1, "autostash", ""}, true},
+		{"bogus", "On main: x", StashEntry{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseStashEntry(tt.ref, tt.subject)
+		if ok != tt.ok || (ok && got != tt.want) {
+			t.Errorf("parseStashEntry(%q, %q) = %+v, %v; want %+v, %v", tt.ref, tt.subject, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	base, _ := g.CurrentBranch()
+	if err := g.CreateBranch("feature"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Checkout("feature"); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "new.txt"), []byte("hello\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Add("new.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Commit("add new.txt"); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := g.Diff(base, "feature", false)
+	if err != nil {
+		t.Fatalf("Diff: %v", err)
+	}
+	if !strings.Contains(diff, "+hello") {
+		t.Errorf("Diff missing added line:\n%s", diff)
+	}
+
+	stat, err := g.Diff(base, "feature", true)
+	if err != nil {
+		t.Fatalf("Diff --stat: %v", err)
+	}
+	if !strings.Contains(stat, "new.txt") || strings.Contains(stat, "+hello") {
+		t.Errorf("Diff --stat = %q, want diffstat only", stat)
+	}
+
+	if empty, err := g.Diff("feature", "feature", false); err != nil || empty != "" {
+		t.Errorf("Diff of identical refs = %q, %v; want empty", empty, err)
+	}
+	if _, err := g.Diff(base, "no-such-branch", false); err == nil {
+		t.Error("expected error for unknown ref")
+	}
+}
+
+func TestDeleteBranch_Options(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	base, _ := g.CurrentBranch()
+	commitOnBranch := func(branch string) {
+		t.Helper()
+		if err := g.CreateBranch(branch); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Checkout(branch); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, branch+".txt"), []byte(branch+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Add(branch + ".txt"); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Commit("work on " + branch); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Checkout(base); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Safe delete refuses an unmerged branch; force deletes it
+	commitOnBranch("unmerged")
+	if err := g.DeleteBranch("unmerged", BranchDeleteOptions{}); !errors.Is(err, ErrBranchNotFullyMerged) {
+		t.Errorf("safe delete of unmerged branch = %v, want ErrBranchNotFullyMerged", err)
+	}
+	if err := g.DeleteBranch("unmerged", BranchDeleteOptions{Force: true}); err != nil {
+		t.Errorf("force delete: %v", err)
+	}
+
+	// MustBeMerged refuses an unmerged branch even with Force
+	commitOnBranch("wip")
+	err := g.DeleteBranch("wip", BranchDeleteOptions{Force: true, MustBeMerged: true})
+	if !errors.Is(err, ErrBranchNotMerged) {
+		t.Errorf("MustBeMerged on unmerged branch = %v, want ErrBranchNotMerged", err)
+	}
+	if exists, _ := g.BranchExists("wip"); !exists {
+		t.Error("wip should not have been deleted")
+	}
+
+	// A branch merged into the default branch passes MustBeMerged
+	if err := g.CreateBranch("merged"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.DeleteBranch("merged", BranchDeleteOptions{MustBeMerged: true}); err != nil {
+		t.Errorf("MustBeMerged on merged branch: %v", err)
+	}
+}
+
+func TestWorktreeList_BareAndDetached(t *testing.T) {
+	dir := initTestRepo(t)
+	bareDir := filepath.Join(t.TempDir(), "repo.git")
+	cmd := exec.Command("git", "clone", "--bare", dir, bareDir)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("clone --bare: %v\n%s", err, out)
+	}
+	bare := NewGitWithDir(bareDir, "")
+
+	head, err := NewGit(dir).Rev("HEAD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wtPath := filepath.Join(t.TempDir(), "detached")
+	if err := bare.WorktreeAddDetached(wtPath, head); err != nil {
+		t.Fatalf("WorktreeAddDetached: %v", err)
+	}
+
+	list, err := bare.WorktreeList()
+	if err != nil {
+		t.Fatalf("WorktreeList: %v", err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("WorktreeList = %+v, want bare repo and one worktree", list)
+	}
+	if !list[0].Bare || list[0].Detached {
+		t.Errorf("main entry = %+v, want Bare", list[0])
+	}
+	if !list[1].Detached || list[1].Bare || list[1].Branch != "" || list[1].Commit != head {
+		t.Errorf("worktree entry = %+v, want Detached at %s", list[1], head)
+	}
+
+	if err := bare.WorktreeRemove(wtPath, false); err != nil {
+		t.Fatalf("WorktreeRemove: %v", err)
+	}
+	if list, _ := bare.WorktreeList(); len(list) != 1 {
+		t.Errorf("after remove WorktreeList = %+v, want only the bare repo", list)
+	}
+}
+
+func TestFormatPatchAndBundle(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	base, _ := g.CurrentBranch()
+	if err := g.CreateBranch("feature"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Checkout("feature"); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"a.txt", "b.txt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(name+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Add(name); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Commit("add " + name); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	outDir := t.TempDir()
+	patches, err := g.FormatPatch(base, "feature", outDir)
+	if err != nil {
+		t.Fatalf("FormatPatch: %v", err)
+	}
+	if len(patches) != 2 {
+		t.Fatalf("FormatPatch wrote %v, want 2 patches", patches)
+	}
+	for _, p := range patches {
+		if _, err := os.Stat(p); err != nil {
+			t.Errorf("patch %s: %v", p, err)
+		}
+	}
+
+	stream, err := g.FormatPatchStdout(base, "feature")
+	if err != nil {
+		t.Fatalf("FormatPatchStdout: %v", err)
+	}
+	if strings.Count(stream, "Subject: [PATCH") != 2 {
+		t.Errorf("FormatPatchStdout has wrong patch count:\n%s", stream)
+	}
+
+	bundle := filepath.Join(t.TempDir(), "feature.bundle")
+	if err := g.BundleCreate(bundle, base, "feature"); err != nil {
+		t.Fatalf("BundleCreate: %v", err)
+	}
+	if _, err := g.run("bundle", "verify", bundle); err != nil {
+		t.Errorf("bundle verify: %v", err)
+	}
+}
+
+func TestRebaseConflictsAndInProgress(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	commitFile := func(name, content, msg string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Add(name); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Commit(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	base, _ := g.CurrentBranch()
+	if err := g.CreateBranch("feature"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Base advances with a change to README.md and an unrelated file
+	commitFile("README.md", "# Base\n", "base readme")
+	commitFile("other.txt", "other\n", "base other")
+
+	if err := g.Checkout("feature"); err != nil {
+		t.Fatal(err)
+	}
+	commitFile("feature.txt", "feature\n", "feature file")
+
+	if files, err := g.RebaseConflicts(base); err != nil || files != nil {
+		t.Fatalf("RebaseConflicts (clean) = %v, %v; want nil, nil", files, err)
+	}
+
+	commitFile("README.md", "# Feature\n", "feature readme")
+	files, err := g.RebaseConflicts(base)
+	if err != nil {
+		t.Fatalf("RebaseConflicts: %v", err)
+	}
+	if len(files) != 1 || files[0] != "README.md" {
+		t.Errorf("RebaseConflicts = %v, want [README.md]", files)
+	}
+	if _, err := g.RebaseConflicts("no-such-ref"); err == nil {
+		t.Error("expected error for unknown ref")
+	}
+
+	if inProgress, err := g.RebaseInProgress(); err != nil || inProgress {
+		t.Fatalf("RebaseInProgress before rebase = %v, %v", inProgress, err)
+	}
+	if err := g.Rebase(base); err == nil {
+		t.Fatal("expected rebase to stop on conflict")
+	}
+	if inProgress, err := g.RebaseInProgress(); err != nil || !inProgress {
+		t.Errorf("RebaseInProgress during conflict = %v, %v; want true", inProgress, err)
+	}
+	if err := g.AbortRebase(); err != nil {
+		t.Fatal(err)
+	}
+	if inProgress, _ := g.RebaseInProgress(); inProgress {
+		t.Error("RebaseInProgress after abort = true")
+	}
+
+	// A linked worktree keeps its rebase state in its own git dir
+	if err := g.Checkout(base); err != nil {
+		t.Fatal(err)
+	}
+	wtDir := filepath.Join(t.TempDir(), "wt")
+	if out, err := exec.Command("git", "-C", dir, "worktree", "add", wtDir, "feature").CombinedOutput(); err != nil {
+		t.Fatalf("worktree add: %v\n%s", err, out)
+	}
+	wt := NewGit(wtDir)
+	if err := wt.Rebase(base); err == nil {
+		t.Fatal("expected worktree rebase to stop on conflict")
+	}
+	if inProgress, err := wt.RebaseInProgress(); err != nil || !inProgress {
+		t.Errorf("worktree RebaseInProgress during conflict = %v, %v; want true", inProgress, err)
+	}
+	if inProgress, _ := g.RebaseInProgress(); inProgress {
+		t.Error("main tree RebaseInProgress = true while only the worktree is rebasing")
+	}
+}
+
+func TestCherryPick(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	commitFile := func(name, content, msg string) string {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Add(name); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Commit(msg); err != nil {
+			t.Fatal(err)
+		}
+		sha, err := g.Rev("HEAD")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sha
+	}
+
+	base, _ := g.CurrentBranch()
+	if err := g.CreateBranch("polecat/toast"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Checkout("polecat/toast"); err != nil {
+		t.Fatal(err)
+	}
+	clean := commitFile("feature.txt", "feature\n", "feature file")
+	conflicting := commitFile("README.md", "# Polecat\n", "polecat readme")
+
+	if err := g.Checkout(base); err != nil {
+		t.Fatal(err)
+	}
+	commitFile("README.md", "# Base\n", "base readme")
+
+	if err := g.CherryPick(clean); err != nil {
+		t.Fatalf("CherryPick (clean): %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "feature.txt")); err != nil {
+		t.Errorf("feature.txt not picked: %v", err)
+	}
+
+	before, _ := g.Rev("HEAD")
+	err := g.CherryPick(conflicting)
+	var conflict *CherryPickConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("CherryPick (conflict) = %v, want *CherryPickConflictError", err)
+	}
+	if len(conflict.Files) != 1 || conflict.Files[0] != "README.md" {
+		t.Errorf("conflict files = %v, want [README.md]", conflict.Files)
+	}
+	if conflict.Commit != conflicting {
+		t.Errorf("conflict commit = %q, want %q", conflict.Commit, conflicting)
+	}
+
+	if err := g.CherryPickAbort(); err != nil {
+		t.Fatalf("CherryPickAbort: %v", err)
+	}
+	if after, _ := g.Rev("HEAD"); after != before {
+		t.Errorf("HEAD after abort = %s, want %s", after, before)
+	}
+	if dirty, _ := g.HasUncommittedChanges(); dirty {
+		t.Error("worktree dirty after abort")
+	}
+}
+
+func TestLog(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+
+	base, _ := g.CurrentBranch()
+	if err := g.CreateBranch("polecat/toast"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Checkout("polecat/toast"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 3; i++ {
+		name := fmt.Sprintf("file%d.txt", i)
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Add(name); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Commit(fmt.Sprintf("add file %d", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := g.Log(base+"..polecat/toast", 2)
+	if err != nil {
+		t.Fatalf("Log: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Log returned %d entries, want 2", len(entries))
+	}
+	if entries[0].Subject != "add file 3" || entries[1].Subject != "add file 2" {
+		t.Errorf("subjects = %q, %q; want newest first", entries[0].Subject, entries[1].Subject)
+	}
+	if len(entries[0].Hash) != 40 || entries[0].Author == "" || entries[0].Date.IsZero() {
+		t.Errorf("entry = %+v", entries[0])
+	}
+
+	if entries, err := g.Log("polecat/toast.."+base, 0); err != nil || entries != nil {
+		t.Errorf("Log of an empty range = %v, %v; want nil, nil", entries, err)
+	}
+}
+
+func TestBranchExists(t *testing.T) {
+	localDir, _, mainBranch := initTestRepoWithRemote(t)
+	g := NewGit(localDir)
+
+	for _, tt := range []struct {
+		branch string
+		want   bool
+	}{
+		{mainBranch, true},
+		{"polecat/missing", false},
+	} {
+		if got, err := g.BranchExists(tt.branch); err != nil || got != tt.want {
+			t.Errorf("BranchExists(%q) = %v, %v; want %v", tt.branch, got, err, tt.want)
+		}
+		if got, err := g.RemoteBranchExists("origin", tt.branch); err != nil || got != tt.want {
+			t.Errorf("RemoteBranchExists(origin, %q) = %v, %v; want %v", tt.branch, got, err, tt.want)
+		}
+		if got, err := g.RemoteTrackingBranchExists("origin", tt.branch); err != nil || got != tt.want {
+			t.Errorf("RemoteTrackingBranchExists(origin, %q) = %v, %v; want %v", tt.branch, got, err, tt.want)
+		}
+	}
+}
+
+func TestImportPatchesAndBundles(t *testing.T) {
+	dir := initTestRepo(t)
+	g := NewGit(dir)
+	base, _ := g.CurrentBranch()
+
+	// polecat/toast: one commit adding work.txt
+	if err := g.CreateBranch("polecat/toast"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Checkout("polecat/toast"); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "work.txt"), []byte("work\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Add("work.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Commit("add work"); err != nil {
+		t.Fatal(err)
+	}
+	tip, _ := g.Rev("polecat/toast")
+
+	exportDir := t.TempDir()
+	patches, err := g.FormatPatch(base, "polecat/toast", exportDir)
+	if err != nil || len(patches) != 1 {
+		t.Fatalf("FormatPatch = %v, %v", patches, err)
+	}
+	bundle := filepath.Join(exportDir, "toast.bundle")
+	if err := g.BundleCreate(bundle, base, "polecat/toast"); err != nil {
+		t.Fatal(err)
+	}
+	diff, err := g.Diff(base, "polecat/toast", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	diffFile := filepath.Join(exportDir, "work.diff")
+	if err := os.WriteFile(diffFile, []byte(diff+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fresh := func(name string) *Git {
+		t.Helper()
+		if err := g.Checkout(base); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.CreateBranch(name); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Checkout(name); err != nil {
+			t.Fatal(err)
+		}
+		return g
+	}
+
+	// git am recreates the commit
+	if err := fresh("import/am").Am(patches...); err != nil {
+		t.Fatalf("Am: %v", err)
+	}
+	if entries, _ := g.Log(base+"..import/am", 0); len(entries) != 1 || entries[0].Subject != "add work" {
+		t.Errorf("after Am, log = %+v; want the imported commit", entries)
+	}
+
+	// git apply leaves the change uncommitted
+	if err := fresh("import/apply").Apply(diffFile); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if dirty, _ := g.HasUncommittedChanges(); !dirty {
+		t.Error("after Apply, expected uncommitted changes")
+	}
+	if _, err := g.run("reset", "--hard"); err != nil {
+		t.Fatal(err)
+	}
+
+	// git bundle unbundle returns the bundled tip
+	heads, err := fresh("import/bundle").BundleUnbundle(bundle)
+	if err != nil {
+		t.Fatalf("BundleUnbundle: %v", err)
+	}
+	if len(heads) != 1 || heads[0] != tip {
+		t.Errorf("BundleUnbundle heads = %v, want [%s]", heads, tip)
+	}
+}
+
+func TestTags(t *testing.T) {
+	localDir, remoteDir, _ := initTestRepoWithRemote(t)
+	g := NewGit(localDir)
+
+	head, err := g.Rev("HEAD")
+	if err != nil {
+		t.Fatalf("Rev: %v", err)
+	}
+
+	if err := g.Tag("v1.0.0", "first release", head); err != nil {
+		t.Fatalf("Tag: %v", err)
+	}
+	if err := g.Tag("v1.0.0", "again", ""); err == nil {
+		t.Error("Tag with an existing name should fail")
+	}
+	if typ, _ := g.run("cat-file", "-t", "v1.0.0"); typ != "tag" {
+		t.Errorf("v1.0.0 is a %q, want an annotated tag object", typ)
+	}
+	if msg, _ := g.run("tag", "-l", "--format=%(contents:subject)", "v1.0.0"); msg != "first release" {
+		t.Errorf("tag message = %q, want %q", msg, "first release")
+	}
+
+	if err := g.TagWithOptions("checkpoint", "", TagOptions{Lightweight: true}); err != nil {
+		t.Fatalf("TagWithOptions lightweight: %v", err)
+	}
+	if typ, _ := g.run("cat-file", "-t", "checkpoint"); typ != "commit" {
+		t.Errorf("checkpoint is a %q, want a lightweight tag pointing at a commit", typ)
+	}
+
+	if err := g.PushTag("origin", "v1.0.0"); err != nil {
+		t.Fatalf("PushTag: %v", err)
+	}
+	remote := NewGitWithDir(remoteDir, "")
+	if _, err := remote.run("rev-parse", "--verify", "refs/tags/v1.0.0"); err != nil {
+		t.Errorf("remote is missing v1.0.0 after PushTag: %v", err)
+	}
+
+	if err := g.DeleteTag("checkpoint"); err != nil {
+		t.Fatalf("DeleteTag: %v", err)
+	}
+	if _, err := g.run("rev-parse", "--verify", "refs/tags/checkpoint"); err == nil {
+		t.Error("checkpoint tag still exists after DeleteTag")
+	}
+	if err := g.DeleteTag("checkpoint"); err == nil {
+		t.Error("DeleteTag of a missing tag should fail")
+	}
+}
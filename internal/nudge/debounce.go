@@ -0,0 +1,89 @@
+package nudge
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// debounceDir returns the directory holding debounce markers.
+// Path: <townRoot>/.runtime/nudge-debounce/
+func debounceDir(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "nudge-debounce")
+}
+
+// debounceKey identifies a (session, message) pair. The message is hashed so
+// arbitrary text can be used as a filename.
+func debounceKey(session, message string) string {
+	sum := sha256.Sum256([]byte(session + "\x00" + message))
+	return hex.EncodeToString(sum[:16])
+}
+
+// debounceSweepAge is the minimum age at which Debounce removes other
+// markers, so a short window can't clear a marker that a caller with a
+// longer window still relies on.
+const debounceSweepAge = time.Hour
+
+// Debounce reports whether a nudge with the same message was already sent to
+// the session within window. If not, it records this send so that repeats
+// within the window are suppressed.
+//
+// State is kept as marker files (mtime = last send) so that separate gt
+// processes, e.g. many polecats starting at once and each nudging the deacon,
+// share the same window. The check and the update happen under a flock on
+// the marker directory, so of several processes that see the same expired
+// marker only one sends. Each call also removes markers older than both
+// window and debounceSweepAge, so the directory doesn't grow without bound.
+// A window <= 0 disables debouncing.
+func Debounce(townRoot, session, message string, window time.Duration) (bool, error) {
+	if window <= 0 {
+		return false, nil
+	}
+
+	dir := debounceDir(townRoot)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return false, fmt.Errorf("creating nudge debounce dir: %w", err)
+	}
+
+	fl := flock.New(filepath.Join(dir, ".lock"))
+	if err := fl.Lock(); err != nil {
+		return false, fmt.Errorf("locking nudge debounce dir: %w", err)
+	}
+	defer fl.Unlock() //nolint:errcheck // best-effort unlock
+
+	sweepDebounceMarkers(dir, max(window, debounceSweepAge))
+
+	path := filepath.Join(dir, debounceKey(session, message))
+	if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) < window {
+		return true, nil
+	}
+	// Rewriting the marker resets its mtime, starting a new window
+	if err := os.WriteFile(path, []byte(session+"\n"), 0644); err != nil {
+		return false, fmt.Errorf("writing nudge debounce marker: %w", err)
+	}
+	return false, nil
+}
+
+// sweepDebounceMarkers removes markers in dir last written more than maxAge
+// ago. Errors are ignored: a marker left behind only costs disk space.
+func sweepDebounceMarkers(dir string, maxAge time.Duration) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		if info, err := e.Info(); err == nil && time.Since(info.ModTime()) > maxAge {
+			_ = os.Remove(filepath.Join(dir, e.Name()))
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package nudge
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDebounce_SuppressesRepeatWithinWindow(t *testing.T) {
+	townRoot := t.TempDir()
+	session := "hq-deacon"
+
+	dropped, err := Debounce(townRoot, session, "session-started", time.Minute)
+	if err != nil {
+		t.Fatalf("Debounce: %v", err)
+	}
+	if dropped {
+		t.Fatal("first send should not be debounced")
+	}
+
+	dropped, err = Debounce(townRoot, session, "session-started", time.Minute)
+	if err != nil {
+		t.Fatalf("Debounce: %v", err)
+	}
+	if !dropped {
+		t.Error("repeat send within window should be debounced")
+	}
+}
+
+func TestDebounce_DistinctMessagesAndTargets(t *testing.T) {
+	townRoot := t.TempDir()
+
+	if dropped, _ := Debounce(townRoot, "hq-deacon", "session-started", time.Minute); dropped {
+		t.Fatal("first send should not be debounced")
+	}
+	if dropped, _ := Debounce(townRoot, "hq-deacon", "other message", time.Minute); dropped {
+		t.Error("different message should not be debounced")
+	}
+	if dropped, _ := Debounce(townRoot, "hq-mayor", "session-started", time.Minute); dropped {
+		t.Error("different target should not be debounced")
+	}
+}
+
+func TestDebounce_WindowExpires(t *testing.T) {
+	townRoot := t.TempDir()
+	session := "hq-deacon"
+
+	if dropped, _ := Debounce(townRoot, session, "session-started", time.Minute); dropped {
+		t.Fatal("first send should not be debounced")
+	}
+
+	// Age the marker past the window
+	marker := filepath.Join(debounceDir(townRoot), debounceKey(session, "session-started"))
+	old := time.Now().Add(-2 * time.Minute)
+	if err := os.Chtimes(marker, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	dropped, err := Debounce(townRoot, session, "session-started", time.Minute)
+	if err != nil {
+		t.Fatalf("Debounce: %v", err)
+	}
+	if dropped {
+		t.Error("send after window elapsed should not be debounced")
+	}
+}
+
+func TestDebounce_ZeroWindowDisabled(t *testing.T) {
+	townRoot := t.TempDir()
+
+	for i := 0; i < 2; i++ {
+		dropped, err := Debounce(townRoot, "hq-deacon", "session-started", 0)
+		if err != nil {
+			t.Fatalf("Debounce: %v", err)
+		}
+		if dropped {
+			t.Errorf("send %d debounced with zero window", i)
+		}
+	}
+	if _, err := os.Stat(debounceDir(townRoot)); !os.IsNotExist(err) {
+		t.Error("zero window should not create debounce state")
+	}
+}
+
+func TestDebounce_ConcurrentExpiredMarkerSendsOnce(t *testing.T) {
+	townRoot := t.TempDir()
+	session := "hq-deacon"
+
+	if dropped, _ := Debounce(townRoot, session, "session-started", time.Minute); dropped {
+		t.Fatal("first send should not be debounced")
+	}
+	marker := filepath.Join(debounceDir(townRoot), debounceKey(session, "session-started"))
+	old := time.Now().Add(-2 * time.Minute)
+	if err := os.Chtimes(marker, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	// Every caller sees the expired marker; only one may claim it
+	const callers = 20
+	var sent atomic.Int32
+	var wg sync.WaitGroup
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			dropped, err := Debounce(townRoot, session, "session-started", time.Minute)
+			if err != nil {
+				t.Errorf("Debounce: %v", err)
+			}
+			if !dropped {
+				sent.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := sent.Load(); n != 1 {
+		t.Errorf("%d callers sent, want 1", n)
+	}
+}
+
+func TestDebounce_SweepsOldMarkers(t *testing.T) {
+	townRoot := t.TempDir()
+
+	if dropped, _ := Debounce(townRoot, "hq-deacon", "old message", time.Minute); dropped {
+		t.Fatal("first send should not be debounced")
+	}
+	stale := filepath.Join(debounceDir(townRoot), debounceKey("hq-deacon", "old message"))
+	old := time.Now().Add(-2 * debounceSweepAge)
+	if err := os.Chtimes(stale, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Debounce(townRoot, "hq-deacon", "new message", time.Minute); err != nil {
+		t.Fatalf("Debounce: %v", err)
+	}
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Errorf("stale marker not swept: %v", err)
+	}
+}
@@ -0,0 +1,178 @@
+package nudge
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// If-fresh outcomes recorded in HistoryEntry.IfFresh.
+const (
+	IfFreshSent    = "sent"
+	IfFreshSkipped = "skipped"
+)
+
+// HistoryEntry is one nudge sent to an address.
+type HistoryEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Message   string    `json:"message"`
+	// Sender identifies the sending process as "hostname:pid".
+	Sender string `json:"sender"`
+	// IfFresh is the --if-fresh outcome, empty when the flag was not used.
+	IfFresh string `json:"if_fresh,omitempty"`
+}
+
+// HistoryDir returns the directory holding per-address nudge logs.
+// Path: <townRoot>/.runtime/nudge-log/
+func HistoryDir(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "nudge-log")
+}
+
+// historyPath returns the log file for an address. The address is
+// path-escaped so "gastown/crew/max" stays a single file name.
+func historyPath(townRoot, address string) string {
+	return filepath.Join(HistoryDir(townRoot), url.PathEscape(address)+".jsonl")
+}
+
+// HistorySender returns the "hostname:pid" sender for this process.
+func HistorySender() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s:%d", host, os.Getpid())
+}
+
+// AppendHistory appends an entry to the address's nudge log.
+// Each entry is a single write to an O_APPEND file, so concurrent gt
+// processes do not interleave lines.
+func AppendHistory(townRoot, address string, entry HistoryEntry) error {
+	if err := os.MkdirAll(HistoryDir(townRoot), 0755); err != nil {
+		return fmt.Errorf("creating nudge log dir: %w", err)
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("encoding nudge log entry: %w", err)
+	}
+
+	f, err := os.OpenFile(historyPath(townRoot, address), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("opening nudge log: %w", err)
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("writing nudge log: %w", err)
+	}
+	return f.Close()
+}
+
+// ReadHistory returns the last n entries logged for an address, oldest
+// first. A missing log yields no entries; malformed lines are skipped.
+func ReadHistory(townRoot, address string, n int) ([]HistoryEntry, error) {
+	f, err := os.Open(historyPath(townRoot, address))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("opening nudge log: %w", err)
+	}
+	defer f.Close()
+
+	var entries []HistoryEntry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var e HistoryEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading nudge log: %w", err)
+	}
+
+	if n > 0 && len(entries) > n {
+		entries = entries[len(entries)-n:]
+	}
+	return entries, nil
+}
+
+// HistoryFollower reads the entries appended to an address's nudge log
+// since it last looked, like tail -f.
+type HistoryFollower struct {
+	path    string
+	offset  int64  // Bytes of the log already consumed
+	partial []byte // Trailing line not yet terminated by a newline
+}
+
+// FollowHistory returns a follower positioned at the current end of the
+// address's nudge log, so Next returns only entries appended after this
+// call. The log need not exist yet.
+func FollowHistory(townRoot, address string) (*HistoryFollower, error) {
+	f := &HistoryFollower{path: historyPath(townRoot, address)}
+	info, err := os.Stat(f.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return f, nil
+		}
+		return nil, fmt.Errorf("opening nudge log: %w", err)
+	}
+	f.offset = info.Size()
+	return f, nil
+}
+
+// Next returns the complete entries appended since the previous call,
+// oldest first. A missing log yields no entries; if the log was truncated
+// or replaced by a shorter one, reading restarts from its beginning.
+// Malformed lines are skipped.
+func (f *HistoryFollower) Next() ([]HistoryEntry, error) {
+	file, err := os.OpenFile(f.path, os.O_RDONLY, 0)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("opening nudge log: %w", err)
+	}
+	defer file.Close()
+
+	end, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, fmt.Errorf("reading nudge log: %w", err)
+	}
+	if end < f.offset {
+		f.offset, f.partial = 0, nil
+	}
+	if _, err := file.Seek(f.offset, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("reading nudge log: %w", err)
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading nudge log: %w", err)
+	}
+	f.offset += int64(len(data))
+
+	data = append(f.partial, data...)
+	var entries []HistoryEntry
+	for {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			break
+		}
+		var e HistoryEntry
+		if err := json.Unmarshal(data[:i], &e); err == nil {
+			entries = append(entries, e)
+		}
+		data = data[i+1:]
+	}
+	f.partial = append([]byte(nil), data...)
+	return entries, nil
+}
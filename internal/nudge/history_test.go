@@ -0,0 +1,151 @@
+package nudge
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestHistoryAppendAndRead(t *testing.T) {
+	townRoot := t.TempDir()
+	const address = "gastown/crew/max"
+
+	if entries, err := ReadHistory(townRoot, address, 20); err != nil || entries != nil {
+		t.Fatalf("ReadHistory with no log = %v, %v; want nil, nil", entries, err)
+	}
+
+	base := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		entry := HistoryEntry{
+			Timestamp: base.Add(time.Duration(i) * time.Minute),
+			Message:   fmt.Sprintf("msg %d", i),
+			Sender:    HistorySender(),
+		}
+		if i == 4 {
+			entry.IfFresh = IfFreshSkipped
+		}
+		if err := AppendHistory(townRoot, address, entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := ReadHistory(townRoot, address, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("got %d entries, want 3", len(entries))
+	}
+	if entries[0].Message != "msg 2" || entries[2].Message != "msg 4" {
+		t.Errorf("entries = %+v, want the last three oldest first", entries)
+	}
+	if entries[2].IfFresh != IfFreshSkipped || entries[0].IfFresh != "" {
+		t.Errorf("if-fresh outcomes = %q, %q", entries[0].IfFresh, entries[2].IfFresh)
+	}
+	if !entries[2].Timestamp.Equal(base.Add(4 * time.Minute)) {
+		t.Errorf("timestamp = %v", entries[2].Timestamp)
+	}
+
+	// Addresses map to distinct files even when they share a prefix
+	if other, _ := ReadHistory(townRoot, "gastown/crew", 20); len(other) != 0 {
+		t.Errorf("unexpected entries for another address: %v", other)
+	}
+}
+
+func TestReadHistorySkipsMalformedLines(t *testing.T) {
+	townRoot := t.TempDir()
+	if err := AppendHistory(townRoot, "mayor", HistoryEntry{Message: "one"}); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.OpenFile(historyPath(townRoot, "mayor"), os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString("{truncated\n")
+	_ = f.Close()
+	if err := AppendHistory(townRoot, "mayor", HistoryEntry{Message: "two"}); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := ReadHistory(townRoot, "mayor", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[1].Message != "two" {
+		t.Errorf("entries = %+v, want one and two", entries)
+	}
+}
+
+func TestFollowHistory(t *testing.T) {
+	townRoot := t.TempDir()
+	const address = "gastown/alpha"
+
+	if err := AppendHistory(townRoot, address, HistoryEntry{Message: "before"}); err != nil {
+		t.Fatal(err)
+	}
+	f, err := FollowHistory(townRoot, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entries, err := f.Next(); err != nil || len(entries) != 0 {
+		t.Fatalf("Next before any append = %v, %v; want nothing", entries, err)
+	}
+
+	for _, msg := range []string{"one", "two"} {
+		if err := AppendHistory(townRoot, address, HistoryEntry{Message: msg}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	entries, err := f.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Message != "one" || entries[1].Message != "two" {
+		t.Fatalf("Next = %+v, want one and two", entries)
+	}
+
+	// A partly written line is held back until it is complete
+	file, err := os.OpenFile(historyPath(townRoot, address), os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	if _, err := file.WriteString(`{"message":"thr`); err != nil {
+		t.Fatal(err)
+	}
+	if entries, _ := f.Next(); len(entries) != 0 {
+		t.Errorf("Next with a partial line = %+v, want nothing", entries)
+	}
+	if _, err := file.WriteString("ee\"}\n"); err != nil {
+		t.Fatal(err)
+	}
+	if entries, _ := f.Next(); len(entries) != 1 || entries[0].Message != "three" {
+		t.Errorf("Next after completing the line = %+v, want three", entries)
+	}
+
+	// A truncated log is read again from the start
+	if err := os.WriteFile(historyPath(townRoot, address), []byte(`{"message":"fresh"}`+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if entries, _ := f.Next(); len(entries) != 1 || entries[0].Message != "fresh" {
+		t.Errorf("Next after truncation = %+v, want fresh", entries)
+	}
+}
+
+func TestFollowHistoryMissingLog(t *testing.T) {
+	townRoot := t.TempDir()
+	f, err := FollowHistory(townRoot, "mayor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entries, err := f.Next(); err != nil || len(entries) != 0 {
+		t.Fatalf("Next with no log = %v, %v", entries, err)
+	}
+	if err := AppendHistory(townRoot, "mayor", HistoryEntry{Message: "first"}); err != nil {
+		t.Fatal(err)
+	}
+	if entries, _ := f.Next(); len(entries) != 1 || entries[0].Message != "first" {
+		t.Errorf("Next = %+v, want first", entries)
+	}
+}
@@ -0,0 +1,93 @@
+package nudge
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// ErrReplyTimeout is returned by WaitReply when no reply arrives in time.
+var ErrReplyTimeout = errors.New("timed out waiting for reply")
+
+// replyPollInterval is how often WaitReply checks for a reply.
+// This is a var (not const) so tests can shorten it.
+var replyPollInterval = 200 * time.Millisecond
+
+// replyDir returns the directory holding pending replies.
+// Path: <townRoot>/.runtime/nudge-replies/
+func replyDir(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "nudge-replies")
+}
+
+// replyPath returns the reply file for an address. Slashes are flattened so
+// the address maps to a single file (gastown/crew/max → gastown_crew_max).
+func replyPath(townRoot, address string) string {
+	return filepath.Join(replyDir(townRoot), strings.ReplaceAll(address, "/", "_"))
+}
+
+// ExpectReply prepares to wait for a reply addressed to address by clearing
+// any stale reply left from an earlier exchange. Call it before sending the
+// nudge, so a fast reply is not mistaken for a stale one.
+func ExpectReply(townRoot, address string) error {
+	if err := os.MkdirAll(replyDir(townRoot), 0755); err != nil {
+		return fmt.Errorf("creating nudge reply dir: %w", err)
+	}
+	if err := os.Remove(replyPath(townRoot, address)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("clearing stale reply: %w", err)
+	}
+	return nil
+}
+
+// WriteReply delivers a reply to whoever is waiting on address.
+// The file is written to a temp name and renamed so readers never see a
+// partial reply.
+func WriteReply(townRoot, address, message string) error {
+	dir := replyDir(townRoot)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating nudge reply dir: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, ".reply-*")
+	if err != nil {
+		return fmt.Errorf("writing reply: %w", err)
+	}
+	if _, err := tmp.WriteString(message); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("writing reply: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("writing reply: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), replyPath(townRoot, address)); err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("writing reply: %w", err)
+	}
+	return nil
+}
+
+// WaitReply blocks until a reply addressed to address arrives or timeout
+// elapses. The reply is consumed (removed) when read.
+func WaitReply(townRoot, address string, timeout time.Duration) (string, error) {
+	path := replyPath(townRoot, address)
+	deadline := time.Now().Add(timeout)
+	for {
+		data, err := os.ReadFile(path)
+		if err == nil {
+			_ = os.Remove(path)
+			return string(data), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", fmt.Errorf("reading reply: %w", err)
+		}
+		if time.Now().After(deadline) {
+			return "", ErrReplyTimeout
+		}
+		time.Sleep(replyPollInterval)
+	}
+}
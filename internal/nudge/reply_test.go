@@ -0,0 +1,61 @@
+package nudge
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestWaitReply_ReceivesReply(t *testing.T) {
+	townRoot := t.TempDir()
+	replyPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { replyPollInterval = 200 * time.Millisecond })
+
+	if err := ExpectReply(townRoot, "gastown/crew/max"); err != nil {
+		t.Fatalf("ExpectReply: %v", err)
+	}
+
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		_ = WriteReply(townRoot, "gastown/crew/max", "done: 3 tests fixed")
+	}()
+
+	got, err := WaitReply(townRoot, "gastown/crew/max", 2*time.Second)
+	if err != nil {
+		t.Fatalf("WaitReply: %v", err)
+	}
+	if got != "done: 3 tests fixed" {
+		t.Errorf("WaitReply = %q, want %q", got, "done: 3 tests fixed")
+	}
+
+	// The reply is consumed
+	if _, err := os.Stat(replyPath(townRoot, "gastown/crew/max")); !os.IsNotExist(err) {
+		t.Errorf("reply file should be removed after reading, stat err = %v", err)
+	}
+}
+
+func TestWaitReply_Timeout(t *testing.T) {
+	townRoot := t.TempDir()
+	replyPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { replyPollInterval = 200 * time.Millisecond })
+
+	_, err := WaitReply(townRoot, "mayor", 50*time.Millisecond)
+	if !errors.Is(err, ErrReplyTimeout) {
+		t.Errorf("WaitReply error = %v, want ErrReplyTimeout", err)
+	}
+}
+
+func TestExpectReply_ClearsStaleReply(t *testing.T) {
+	townRoot := t.TempDir()
+
+	if err := WriteReply(townRoot, "mayor", "old answer"); err != nil {
+		t.Fatalf("WriteReply: %v", err)
+	}
+	if err := ExpectReply(townRoot, "mayor"); err != nil {
+		t.Fatalf("ExpectReply: %v", err)
+	}
+	if _, err := WaitReply(townRoot, "mayor", 0); !errors.Is(err, ErrReplyTimeout) {
+		t.Errorf("stale reply should be cleared, got err = %v", err)
+	}
+}
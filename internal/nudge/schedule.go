@@ -0,0 +1,162 @@
+package nudge
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// ErrScheduledNotFound is returned by CancelScheduled for an unknown ID.
+var ErrScheduledNotFound = errors.New("no scheduled nudge with that ID")
+
+// ScheduledNudge is a nudge held back until DeliverAt. The daemon delivers
+// due nudges by re-running 'gt nudge' with the recorded options.
+type ScheduledNudge struct {
+	ID        string    `json:"id"`
+	Target    string    `json:"target"` // Address as passed to gt nudge
+	Message   string    `json:"message"`
+	Sender    string    `json:"sender"`
+	Mode      string    `json:"mode,omitempty"`
+	Priority  string    `json:"priority,omitempty"`
+	Force     bool      `json:"force,omitempty"`
+	DeliverAt time.Time `json:"deliver_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ScheduleDir returns the directory holding scheduled nudges.
+// Path: <townRoot>/.runtime/scheduled-nudges/
+func ScheduleDir(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "scheduled-nudges")
+}
+
+// scheduledPath returns the file for a scheduled nudge.
+func scheduledPath(townRoot, id string) string {
+	return filepath.Join(ScheduleDir(townRoot), id+".json")
+}
+
+// Schedule stores n for later delivery, assigning its ID and CreatedAt.
+// It returns the stored nudge.
+func Schedule(townRoot string, n ScheduledNudge) (ScheduledNudge, error) {
+	if n.DeliverAt.IsZero() {
+		return n, fmt.Errorf("scheduled nudge needs a delivery time")
+	}
+	dir := ScheduleDir(townRoot)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return n, fmt.Errorf("creating scheduled nudge dir: %w", err)
+	}
+
+	if n.CreatedAt.IsZero() {
+		n.CreatedAt = time.Now()
+	}
+	n.ID = randomSuffix()
+
+	data, err := json.MarshalIndent(n, "", "  ")
+	if err != nil {
+		return n, fmt.Errorf("marshaling scheduled nudge: %w", err)
+	}
+	// O_EXCL so a (vanishingly unlikely) ID collision fails instead of
+	// overwriting another nudge.
+	f, err := os.OpenFile(scheduledPath(townRoot, n.ID), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return n, fmt.Errorf("writing scheduled nudge: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return n, fmt.Errorf("writing scheduled nudge: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return n, fmt.Errorf("writing scheduled nudge: %w", err)
+	}
+	return n, nil
+}
+
+// ListScheduled returns the pending scheduled nudges, soonest first.
+// Unreadable entries are skipped. A missing directory means none.
+func ListScheduled(townRoot string) ([]ScheduledNudge, error) {
+	entries, err := os.ReadDir(ScheduleDir(townRoot))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading scheduled nudges: %w", err)
+	}
+
+	var nudges []ScheduledNudge
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+		n, err := readScheduled(filepath.Join(ScheduleDir(townRoot), e.Name()))
+		if err != nil {
+			continue
+		}
+		nudges = append(nudges, n)
+	}
+	sort.SliceStable(nudges, func(i, j int) bool {
+		return nudges[i].DeliverAt.Before(nudges[j].DeliverAt)
+	})
+	return nudges, nil
+}
+
+// CancelScheduled removes a pending scheduled nudge.
+func CancelScheduled(townRoot, id string) error {
+	if id == "" || strings.ContainsAny(id, `/\.`) {
+		return ErrScheduledNotFound
+	}
+	if err := os.Remove(scheduledPath(townRoot, id)); err != nil {
+		if os.IsNotExist(err) {
+			return ErrScheduledNotFound
+		}
+		return fmt.Errorf("cancelling scheduled nudge: %w", err)
+	}
+	return nil
+}
+
+// ClaimDue removes and returns the scheduled nudges due at or before now,
+// soonest first. Each file is renamed before it is read, as in Drain, so
+// concurrent callers never claim the same nudge; delivery is at most once.
+func ClaimDue(townRoot string, now time.Time) ([]ScheduledNudge, error) {
+	pending, err := ListScheduled(townRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	var due []ScheduledNudge
+	for _, n := range pending {
+		if n.DeliverAt.After(now) {
+			break
+		}
+		path := scheduledPath(townRoot, n.ID)
+		claimed := path + ".claimed"
+		if err := os.Rename(path, claimed); err != nil {
+			continue // Cancelled, or claimed by someone else
+		}
+		claimedNudge, err := readScheduled(claimed)
+		_ = os.Remove(claimed)
+		if err != nil {
+			continue
+		}
+		due = append(due, claimedNudge)
+	}
+	return due, nil
+}
+
+// readScheduled reads one scheduled nudge file.
+func readScheduled(path string) (ScheduledNudge, error) {
+	var n ScheduledNudge
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return n, err
+	}
+	if err := json.Unmarshal(data, &n); err != nil {
+		return n, err
+	}
+	return n, nil
+}
@@ -0,0 +1,76 @@
+package nudge
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestScheduleListCancel(t *testing.T) {
+	townRoot := t.TempDir()
+	now := time.Date(2026, 3, 4, 9, 0, 0, 0, time.UTC)
+
+	late, err := Schedule(townRoot, ScheduledNudge{Target: "gastown/Toast", Message: "standup", DeliverAt: now.Add(2 * time.Hour)})
+	if err != nil {
+		t.Fatalf("Schedule: %v", err)
+	}
+	early, err := Schedule(townRoot, ScheduledNudge{Target: "mayor", Message: "review", DeliverAt: now.Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("Schedule: %v", err)
+	}
+	if late.ID == "" || late.ID == early.ID || late.CreatedAt.IsZero() {
+		t.Fatalf("Schedule assigned ID %q / %q, CreatedAt %v", late.ID, early.ID, late.CreatedAt)
+	}
+	if _, err := Schedule(townRoot, ScheduledNudge{Target: "mayor", Message: "no time"}); err == nil {
+		t.Error("Schedule without DeliverAt should fail")
+	}
+
+	list, err := ListScheduled(townRoot)
+	if err != nil {
+		t.Fatalf("ListScheduled: %v", err)
+	}
+	if len(list) != 2 || list[0].ID != early.ID || list[1].ID != late.ID {
+		t.Fatalf("ListScheduled = %+v, want early then late", list)
+	}
+
+	if err := CancelScheduled(townRoot, early.ID); err != nil {
+		t.Fatalf("CancelScheduled: %v", err)
+	}
+	if err := CancelScheduled(townRoot, early.ID); !errors.Is(err, ErrScheduledNotFound) {
+		t.Errorf("second CancelScheduled = %v, want ErrScheduledNotFound", err)
+	}
+	if err := CancelScheduled(townRoot, "../escape"); !errors.Is(err, ErrScheduledNotFound) {
+		t.Errorf("CancelScheduled with a path = %v, want ErrScheduledNotFound", err)
+	}
+	if list, _ := ListScheduled(townRoot); len(list) != 1 || list[0].ID != late.ID {
+		t.Errorf("after cancel, ListScheduled = %+v, want only %s", list, late.ID)
+	}
+}
+
+func TestClaimDue(t *testing.T) {
+	townRoot := t.TempDir()
+	now := time.Date(2026, 3, 4, 9, 0, 0, 0, time.UTC)
+
+	due, _ := Schedule(townRoot, ScheduledNudge{Target: "mayor", Message: "due", DeliverAt: now.Add(-time.Minute)})
+	future, _ := Schedule(townRoot, ScheduledNudge{Target: "mayor", Message: "later", DeliverAt: now.Add(time.Hour)})
+
+	claimed, err := ClaimDue(townRoot, now)
+	if err != nil {
+		t.Fatalf("ClaimDue: %v", err)
+	}
+	if len(claimed) != 1 || claimed[0].ID != due.ID || claimed[0].Message != "due" {
+		t.Fatalf("ClaimDue = %+v, want only %s", claimed, due.ID)
+	}
+
+	// Claimed nudges are gone; a second claim finds nothing
+	if again, _ := ClaimDue(townRoot, now); len(again) != 0 {
+		t.Errorf("second ClaimDue = %+v, want none", again)
+	}
+	if _, err := os.Stat(scheduledPath(townRoot, future.ID)); err != nil {
+		t.Errorf("future nudge should still be pending: %v", err)
+	}
+	if claimed, _ := ClaimDue(townRoot, now.Add(2*time.Hour)); len(claimed) != 1 || claimed[0].ID != future.ID {
+		t.Errorf("ClaimDue later = %+v, want %s", claimed, future.ID)
+	}
+}
@@ -0,0 +1,82 @@
+package nudge
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/steveyegge/gastown/internal/constants"
+)
+
+// templateExt is the file extension for nudge message templates.
+const templateExt = ".txt"
+
+// TemplateData is the data available to nudge message templates.
+type TemplateData struct {
+	RigName   string // Target's rig (empty for town-level agents)
+	AgentName string // Target's name (e.g. "toast", "witness", "mayor")
+	Timestamp string // Send time, RFC 3339
+}
+
+// TemplateDir returns the directory holding nudge message templates.
+// Path: <townRoot>/settings/nudge-templates/
+func TemplateDir(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirSettings, "nudge-templates")
+}
+
+// ListTemplates returns the names of the available templates, sorted.
+// A missing template directory is not an error.
+func ListTemplates(townRoot string) ([]string, error) {
+	entries, err := os.ReadDir(TemplateDir(townRoot))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading nudge templates: %w", err)
+	}
+
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasSuffix(e.Name(), templateExt) {
+			names = append(names, strings.TrimSuffix(e.Name(), templateExt))
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// RenderTemplate reads the named template and executes it with data.
+// Trailing newlines are trimmed, matching --file and --stdin.
+func RenderTemplate(townRoot, name string, data TemplateData) (string, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") {
+		return "", fmt.Errorf("invalid template name %q", name)
+	}
+
+	path := filepath.Join(TemplateDir(townRoot), name+templateExt)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("nudge template %q not found (expected %s)", name, path)
+		}
+		return "", fmt.Errorf("reading nudge template: %w", err)
+	}
+
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(string(content))
+	if err != nil {
+		return "", fmt.Errorf("parsing nudge template %q: %w", name, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("expanding nudge template %q: %w", name, err)
+	}
+
+	msg := strings.TrimRight(buf.String(), "\n")
+	if strings.TrimSpace(msg) == "" {
+		return "", fmt.Errorf("nudge template %q is empty", name)
+	}
+	return msg, nil
+}
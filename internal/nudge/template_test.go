@@ -0,0 +1,77 @@
+package nudge
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeTemplate(t *testing.T, townRoot, name, content string) {
+	t.Helper()
+	dir := TemplateDir(townRoot)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestListTemplates(t *testing.T) {
+	townRoot := t.TempDir()
+
+	if names, err := ListTemplates(townRoot); err != nil || names != nil {
+		t.Fatalf("ListTemplates with no dir = %v, %v; want nil, nil", names, err)
+	}
+
+	writeTemplate(t, townRoot, "review.txt", "review")
+	writeTemplate(t, townRoot, "commit.txt", "commit")
+	writeTemplate(t, townRoot, "notes.md", "ignored")
+
+	names, err := ListTemplates(townRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"commit", "review"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("ListTemplates = %v, want %v", names, want)
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	townRoot := t.TempDir()
+	writeTemplate(t, townRoot, "review.txt", "{{.AgentName}} in {{.RigName}}: please review and commit ({{.Timestamp}})\n\n")
+
+	msg, err := RenderTemplate(townRoot, "review", TemplateData{
+		RigName:   "gastown",
+		AgentName: "toast",
+		Timestamp: "2026-01-02T03:04:05Z",
+	})
+	if err != nil {
+		t.Fatalf("RenderTemplate: %v", err)
+	}
+	if want := "toast in gastown: please review and commit (2026-01-02T03:04:05Z)"; msg != want {
+		t.Errorf("RenderTemplate = %q, want %q", msg, want)
+	}
+}
+
+func TestRenderTemplate_Errors(t *testing.T) {
+	townRoot := t.TempDir()
+	writeTemplate(t, townRoot, "bad.txt", "{{.Unknown}}")
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"missing", "not found"},
+		{"../escape", "invalid template name"},
+		{"bad", "expanding"},
+	}
+	for _, tt := range tests {
+		_, err := RenderTemplate(townRoot, tt.name, TemplateData{})
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("RenderTemplate(%q) error = %v, want containing %q", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package polecat
+
+import (
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+func init() {
+	rig.RegisterPolecatCounter(func(r *rig.Rig) (int, int, error) {
+		polecats, err := NewManager(r, git.NewGit(r.Path), tmux.NewTmux()).List()
+		if err != nil {
+			return 0, 0, err
+		}
+		active, total := CountStates(polecats)
+		return active, total, nil
+	})
+}
+
+// CountStates returns how many of polecats are working, and how many there
+// are. This backs rig.Rig.PolecatCount.
+func CountStates(polecats []*Polecat) (active, total int) {
+	for _, p := range polecats {
+		if p.State == StateWorking {
+			active++
+		}
+	}
+	return active, len(polecats)
+}
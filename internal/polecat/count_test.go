@@ -0,0 +1,34 @@
+package polecat
+
+import (
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestCountStates(t *testing.T) {
+	polecats := []*Polecat{
+		{Name: "toast", State: StateWorking},
+		{Name: "nux", State: StateDone},
+		{Name: "rictus", State: StateWorking},
+		{Name: "slit", State: StatePaused},
+	}
+	if active, total := CountStates(polecats); active != 2 || total != 4 {
+		t.Errorf("CountStates = %d, %d; want 2, 4", active, total)
+	}
+	if active, total := CountStates(nil); active != 0 || total != 0 {
+		t.Errorf("CountStates(nil) = %d, %d; want 0, 0", active, total)
+	}
+}
+
+func TestPolecatCounterRegistered(t *testing.T) {
+	// A rig with no polecats directory lists no polecats
+	r := &rig.Rig{Name: "testrig", Path: t.TempDir()}
+	active, total, err := r.PolecatCount()
+	if err != nil {
+		t.Fatalf("PolecatCount: %v", err)
+	}
+	if active != 0 || total != 0 {
+		t.Errorf("PolecatCount = %d, %d; want 0, 0", active, total)
+	}
+}
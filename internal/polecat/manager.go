@@ -411,19 +411,7 @@ func (m *Manager) checkCleanupStatus(name string, status CleanupStatus, force bo
 // Prefers the shared bare repo (.repo.git) if it exists, otherwise falls back to mayor/rig.
 // The bare repo architecture allows all worktrees (refinery, polecats) to share branch visibility.
 func (m *Manager) repoBase() (*git.Git, error) {
-	// First check for shared bare repo (new architecture)
-	bareRepoPath := filepath.Join(m.rig.Path, ".repo.git")
-	if info, err := os.Stat(bareRepoPath); err == nil && info.IsDir() {
-		// Bare repo exists - use it
-		return git.NewGitWithDir(bareRepoPath, ""), nil
-	}
-
-	// Fall back to mayor/rig (legacy architecture)
-	mayorPath := filepath.Join(m.rig.Path, "mayor", "rig")
-	if _, err := os.Stat(mayorPath); os.IsNotExist(err) {
-		return nil, fmt.Errorf("no repo base found (neither .repo.git nor mayor/rig exists)")
-	}
-	return git.NewGit(mayorPath), nil
+	return m.rig.RepoBase()
 }
 
 // polecatDir returns the parent directory for a polecat.
@@ -589,7 +577,8 @@ func (m *Manager) buildBranchName(name, issue string) string {
 	return result
 }
 
-// Polecat state is derived from beads assignee field, not state.json.
+// Polecat state is derived from beads (see Get); state.json only holds
+// operator-managed metadata such as labels and pauses.
 //
 // Branch naming: Each polecat run gets a unique branch (polecat/<name>-<timestamp>).
 // This prevents drift issues from stale branches and ensures a clean starting state.
@@ -779,7 +768,8 @@ func (m *Manager) AddWithOptions(name string, opts AddOptions) (*Polecat, error)
 	}
 
 	// Return polecat with working state (transient model: polecats are spawned with work)
-	// State is derived from beads, not stored in state.json
+	// Later reads derive state from beads (see Get); a new polecat has no
+	// state.json overrides yet
 	now := time.Now()
 	polecat := &Polecat{
 		Name:      name,
@@ -1495,12 +1485,27 @@ func (m *Manager) List() ([]*Polecat, error) {
 // - If an issue is assigned to this polecat: StateWorking
 // - If no issue but tmux session is running: StateWorking (session alive = still working)
 // - If no issue and no tmux session: StateDone (ready for cleanup)
+// - If the worktree is stopped mid-rebase: StateConflict, whatever beads says
+// - If an operator paused it while working: StatePaused (see Pause)
+//
+// Besides the beads lookup, that costs a few stats for the rebase check and
+// a read of the polecat's state.json, once per polecat in List.
 func (m *Manager) Get(name string) (*Polecat, error) {
 	if !m.exists(name) {
 		return nil, ErrPolecatNotFound
 	}
 
-	return m.loadFromBeads(name)
+	p, err := m.loadFromBeads(name)
+	if err != nil {
+		return nil, err
+	}
+	if rebasing, _ := git.NewGit(p.ClonePath).RebaseInProgress(); rebasing {
+		p.State = StateConflict
+	}
+	if st, err := m.loadState(name); err == nil {
+		st.applyTo(p)
+	}
+	return p, nil
 }
 
 // SetState updates a polecat's state.
@@ -1515,7 +1520,7 @@ func (m *Manager) SetAgentState(name string, state string) error {
 	return m.beads.UpdateAgentState(agentID, state, nil)
 }
 
-// - StateDone: assignee cleared from issue (polecat ready for cleanup)
+// - StateDone: agent hook and issue assignee cleared (polecat ready for cleanup)
 // - StateStuck: issue status set to blocked (if supported)
 // If beads is not available, this is a no-op.
 func (m *Manager) SetState(name string, state State) error {
@@ -1541,6 +1546,14 @@ func (m *Manager) SetState(name string, state State) error {
 			}
 		}
 	case StateDone:
+		// The agent bead's hook outranks the assignee when state is derived
+		// (see loadFromBeads), so clear it too
+		agentID := m.agentBeadID(name)
+		if _, fields, err := m.beads.GetAgentBead(agentID); err == nil && fields != nil && fields.HookBead != "" {
+			if err := m.beads.ClearHookBead(agentID); err != nil {
+				return fmt.Errorf("clearing hook: %w", err)
+			}
+		}
 		// Clear assignment when done (polecat ready for cleanup)
 		if issue != nil {
 			empty := ""
@@ -1743,7 +1756,7 @@ func (m *Manager) CleanupStaleBranches() (int, error) {
 			continue // This branch is in use
 		}
 		// Delete orphaned branch
-		if err := repoGit.DeleteBranch(branch, true); err != nil {
+		if err := repoGit.DeleteBranch(branch, git.BranchDeleteOptions{Force: true}); err != nil {
 			// Log but continue - non-fatal
 			style.PrintWarning("could not delete branch %s: %v", branch, err)
 			continue
@@ -0,0 +1,206 @@
+package polecat
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// stateFileName is the per-polecat state file, in the polecat's home
+// directory (polecats/<name>/), next to the worktree.
+const stateFileName = "state.json"
+
+// polecatState is operator-managed polecat metadata that, unlike the
+// working state, isn't derived from beads or tmux.
+type polecatState struct {
+	Labels      []string `json:"labels,omitempty"`
+	AssignedTo  string   `json:"assigned_to,omitempty"` // Crew member responsible for the polecat
+	Paused      bool     `json:"paused,omitempty"`
+	PauseReason string   `json:"pause_reason,omitempty"`
+}
+
+// applyTo fills in p's operator-managed fields. Paused only overrides the
+// working state, so a paused polecat that finishes or hits a conflict
+// shows that instead.
+func (st *polecatState) applyTo(p *Polecat) {
+	p.Labels = st.Labels
+	p.AssignedTo = st.AssignedTo
+	if st.Paused && p.State == StateWorking {
+		p.State = StatePaused
+		p.PauseReason = st.PauseReason
+	}
+}
+
+// statePath returns the path of a polecat's state file.
+func (m *Manager) statePath(name string) string {
+	return filepath.Join(m.polecatDir(name), stateFileName)
+}
+
+// loadState reads a polecat's state file. A missing file is an empty state.
+func (m *Manager) loadState(name string) (*polecatState, error) {
+	data, err := os.ReadFile(m.statePath(name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &polecatState{}, nil
+		}
+		return nil, fmt.Errorf("reading polecat state: %w", err)
+	}
+	var st polecatState
+	if err := json.Unmarshal(data, &st); err != nil {
+		return nil, fmt.Errorf("parsing polecat state: %w", err)
+	}
+	return &st, nil
+}
+
+// saveState writes a polecat's state file.
+func (m *Manager) saveState(name string, st *polecatState) error {
+	data, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling polecat state: %w", err)
+	}
+	if err := os.WriteFile(m.statePath(name), append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing polecat state: %w", err)
+	}
+	return nil
+}
+
+// ValidateLabel checks that a label is non-empty and has no whitespace or
+// commas, so labels stay usable as --label values.
+func ValidateLabel(label string) error {
+	if label == "" {
+		return fmt.Errorf("label must not be empty")
+	}
+	if strings.ContainsAny(label, ", \t\r\n") {
+		return fmt.Errorf("invalid label %q: must not contain whitespace or commas", label)
+	}
+	return nil
+}
+
+// Labels returns a polecat's labels, sorted.
+func (m *Manager) Labels(name string) ([]string, error) {
+	if !m.exists(name) {
+		return nil, ErrPolecatNotFound
+	}
+	st, err := m.loadState(name)
+	if err != nil {
+		return nil, err
+	}
+	return st.Labels, nil
+}
+
+// AddLabels adds labels to a polecat. Labels it already has are ignored.
+// It returns the polecat's labels afterwards.
+func (m *Manager) AddLabels(name string, labels ...string) ([]string, error) {
+	for _, l := range labels {
+		if err := ValidateLabel(l); err != nil {
+			return nil, err
+		}
+	}
+	return m.updateLabels(name, func(set map[string]bool) {
+		for _, l := range labels {
+			set[l] = true
+		}
+	})
+}
+
+// RemoveLabels removes labels from a polecat. Labels it doesn't have are
+// ignored. It returns the polecat's labels afterwards.
+func (m *Manager) RemoveLabels(name string, labels ...string) ([]string, error) {
+	return m.updateLabels(name, func(set map[string]bool) {
+		for _, l := range labels {
+			delete(set, l)
+		}
+	})
+}
+
+// updateLabels applies fn to a polecat's label set and saves the result.
+func (m *Manager) updateLabels(name string, fn func(map[string]bool)) ([]string, error) {
+	var labels []string
+	err := m.updateState(name, func(st *polecatState) {
+		set := make(map[string]bool, len(st.Labels))
+		for _, l := range st.Labels {
+			set[l] = true
+		}
+		fn(set)
+
+		st.Labels = make([]string, 0, len(set))
+		for l := range set {
+			st.Labels = append(st.Labels, l)
+		}
+		sort.Strings(st.Labels)
+		if len(st.Labels) == 0 {
+			st.Labels = nil
+		}
+		labels = st.Labels
+	})
+	if err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// SetAssignedTo records the crew member responsible for a polecat. An empty
+// member clears the assignment. The member is not checked against the
+// rig's crew; callers do that.
+func (m *Manager) SetAssignedTo(name, member string) error {
+	return m.updateState(name, func(st *polecatState) {
+		st.AssignedTo = member
+	})
+}
+
+// Pause moves a working polecat to paused, recording an optional reason.
+// Its session is left running; the caller decides whether to tell it.
+func (m *Manager) Pause(name, reason string) error {
+	p, err := m.Get(name)
+	if err != nil {
+		return err
+	}
+	if p.State != StateWorking {
+		return fmt.Errorf("polecat %s is %s; only working polecats can be paused", name, p.State)
+	}
+	return m.setPaused(name, true, reason)
+}
+
+// Resume moves a paused polecat back to working.
+func (m *Manager) Resume(name string) error {
+	p, err := m.Get(name)
+	if err != nil {
+		return err
+	}
+	if p.State != StatePaused {
+		return fmt.Errorf("polecat %s is %s, not paused", name, p.State)
+	}
+	return m.setPaused(name, false, "")
+}
+
+// setPaused records whether a polecat is paused, and why.
+func (m *Manager) setPaused(name string, paused bool, reason string) error {
+	return m.updateState(name, func(st *polecatState) {
+		st.Paused = paused
+		st.PauseReason = reason
+	})
+}
+
+// updateState applies fn to a polecat's state under the polecat lock and
+// saves the result.
+func (m *Manager) updateState(name string, fn func(*polecatState)) error {
+	if !m.exists(name) {
+		return ErrPolecatNotFound
+	}
+
+	fl, err := m.lockPolecat(name)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fl.Unlock() }()
+
+	st, err := m.loadState(name)
+	if err != nil {
+		return err
+	}
+	fn(st)
+	return m.saveState(name, st)
+}
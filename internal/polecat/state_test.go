@@ -0,0 +1,134 @@
+package polecat
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestLabels(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "polecats", "Toast"), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	m := NewManager(&rig.Rig{Name: "test-rig", Path: root}, git.NewGit(root), nil)
+
+	if labels, err := m.Labels("Toast"); err != nil || len(labels) != 0 {
+		t.Fatalf("Labels on a new polecat = %v, %v; want none", labels, err)
+	}
+
+	labels, err := m.AddLabels("Toast", "frontend", "urgent", "frontend")
+	if err != nil {
+		t.Fatalf("AddLabels: %v", err)
+	}
+	if want := []string{"frontend", "urgent"}; !reflect.DeepEqual(labels, want) {
+		t.Errorf("AddLabels = %v, want %v", labels, want)
+	}
+	if _, err := m.AddLabels("Toast", "two words"); err == nil {
+		t.Error("AddLabels with whitespace should fail")
+	}
+
+	labels, err = m.RemoveLabels("Toast", "urgent", "missing")
+	if err != nil {
+		t.Fatalf("RemoveLabels: %v", err)
+	}
+	if want := []string{"frontend"}; !reflect.DeepEqual(labels, want) {
+		t.Errorf("RemoveLabels = %v, want %v", labels, want)
+	}
+
+	// Labels persist in the state file
+	m2 := NewManager(&rig.Rig{Name: "test-rig", Path: root}, git.NewGit(root), nil)
+	if labels, _ := m2.Labels("Toast"); !reflect.DeepEqual(labels, []string{"frontend"}) {
+		t.Errorf("Labels from a new manager = %v, want [frontend]", labels)
+	}
+
+	if _, err := m.AddLabels("Nobody", "x"); !errors.Is(err, ErrPolecatNotFound) {
+		t.Errorf("AddLabels on a missing polecat = %v, want ErrPolecatNotFound", err)
+	}
+}
+
+func TestSetAssignedTo(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "polecats", "Toast"), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	m := NewManager(&rig.Rig{Name: "test-rig", Path: root}, git.NewGit(root), nil)
+
+	if _, err := m.AddLabels("Toast", "frontend"); err != nil {
+		t.Fatalf("AddLabels: %v", err)
+	}
+	if err := m.SetAssignedTo("Toast", "dave"); err != nil {
+		t.Fatalf("SetAssignedTo: %v", err)
+	}
+	st, err := m.loadState("Toast")
+	if err != nil {
+		t.Fatalf("loadState: %v", err)
+	}
+	if st.AssignedTo != "dave" || !reflect.DeepEqual(st.Labels, []string{"frontend"}) {
+		t.Errorf("state = %+v, want assigned to dave with labels kept", st)
+	}
+
+	if err := m.SetAssignedTo("Toast", ""); err != nil {
+		t.Fatalf("SetAssignedTo clear: %v", err)
+	}
+	if st, _ := m.loadState("Toast"); st.AssignedTo != "" {
+		t.Errorf("AssignedTo after clear = %q, want empty", st.AssignedTo)
+	}
+
+	if err := m.SetAssignedTo("Nobody", "dave"); !errors.Is(err, ErrPolecatNotFound) {
+		t.Errorf("SetAssignedTo on a missing polecat = %v, want ErrPolecatNotFound", err)
+	}
+}
+
+func TestPausedState(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "polecats", "Toast"), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	m := NewManager(&rig.Rig{Name: "test-rig", Path: root}, git.NewGit(root), nil)
+
+	if err := m.SetAssignedTo("Toast", "dave"); err != nil {
+		t.Fatalf("SetAssignedTo: %v", err)
+	}
+	if err := m.setPaused("Toast", true, "waiting on gt-123"); err != nil {
+		t.Fatalf("setPaused: %v", err)
+	}
+	st, err := m.loadState("Toast")
+	if err != nil {
+		t.Fatalf("loadState: %v", err)
+	}
+	if !st.Paused || st.PauseReason != "waiting on gt-123" || st.AssignedTo != "dave" {
+		t.Errorf("state = %+v, want paused with reason and assignment kept", st)
+	}
+
+	// Paused only overrides working
+	for _, tt := range []struct{ state, want State }{
+		{StateWorking, StatePaused},
+		{StateDone, StateDone},
+		{StateConflict, StateConflict},
+	} {
+		p := &Polecat{Name: "Toast", State: tt.state}
+		st.applyTo(p)
+		if p.State != tt.want {
+			t.Errorf("applyTo(%s) state = %s, want %s", tt.state, p.State, tt.want)
+		}
+		if tt.want == StatePaused && p.PauseReason != "waiting on gt-123" {
+			t.Errorf("applyTo(%s) PauseReason = %q", tt.state, p.PauseReason)
+		}
+	}
+
+	if err := m.setPaused("Toast", false, ""); err != nil {
+		t.Fatalf("setPaused(false): %v", err)
+	}
+	p := &Polecat{Name: "Toast", State: StateWorking}
+	if st, _ := m.loadState("Toast"); st.Paused || st.PauseReason != "" {
+		t.Errorf("state after resume = %+v, want not paused", st)
+	} else if st.applyTo(p); p.State != StateWorking {
+		t.Errorf("state after resume = %s, want working", p.State)
+	}
+}
@@ -44,6 +44,17 @@ const (
 	// This is a detected condition: the polecat was incompletely nuked or has a
 	// session naming mismatch, leaving an orphaned tmux session.
 	StateZombie State = "zombie"
+
+	// StateConflict means the polecat's worktree is stopped mid-rebase with
+	// conflicts, e.g. after 'gt polecat sync'. Like zombie, it is detected
+	// (from the worktree's rebase state), not stored.
+	StateConflict State = "conflict"
+
+	// StatePaused means an operator has paused a working polecat, e.g. while
+	// it waits on a dependency ('gt polecat pause'). Unlike the detected
+	// states, it is stored (in the polecat's state file) and only ever
+	// overrides working; 'gt polecat resume' clears it.
+	StatePaused State = "paused"
 )
 
 // IsWorking returns true if the polecat is currently working.
@@ -76,6 +87,17 @@ type Polecat struct {
 	// Issue is the currently assigned issue ID (if any).
 	Issue string `json:"issue,omitempty"`
 
+	// Labels are operator-assigned tags (see Manager.AddLabels).
+	Labels []string `json:"labels,omitempty"`
+
+	// AssignedTo is the crew member responsible for reviewing or guiding
+	// the polecat (see Manager.SetAssignedTo).
+	AssignedTo string `json:"assigned_to,omitempty"`
+
+	// PauseReason is why the polecat was paused, if it is paused and a
+	// reason was given (see Manager.Pause).
+	PauseReason string `json:"pause_reason,omitempty"`
+
 	// CreatedAt is when the polecat was created.
 	CreatedAt time.Time `json:"created_at"`
 
@@ -912,7 +912,7 @@ func (e *Engineer) HandleMRInfoSuccess(mr *MRInfo, result ProcessResult) {
 
 	// 2. Delete source branch if configured (local and remote)
 	if e.config.DeleteMergedBranches && mr.Branch != "" {
-		if err := e.git.DeleteBranch(mr.Branch, true); err != nil {
+		if err := e.git.DeleteBranch(mr.Branch, git.BranchDeleteOptions{Force: true}); err != nil {
 			_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to delete local branch %s: %v\n", mr.Branch, err)
 		} else {
 			_, _ = fmt.Fprintf(e.output, "[Engineer] Deleted local branch: %s\n", mr.Branch)
@@ -1691,7 +1691,9 @@ func (e *Engineer) landConvoySwarm(townRoot string, convoy convoyInfo) {
 // pruneStaleRemoteRefs prunes remote tracking refs that no longer exist on origin.
 // This cleans up refs from branches that were deleted on the remote after merge.
 func (e *Engineer) pruneStaleRemoteRefs() {
-	if err := e.git.FetchPrune("origin"); err != nil {
+	ctx, cancel := context.WithTimeout(context.Background(), git.DefaultFetchTimeout)
+	defer cancel()
+	if err := e.git.FetchPrune(ctx, "origin"); err != nil {
 		_, _ = fmt.Fprintf(e.output, "[Engineer] Warning: failed to prune stale remote refs: %v\n", err)
 	}
 }
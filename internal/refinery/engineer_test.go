@@ -615,11 +615,11 @@ func TestNotifyDeaconConvoyFeeding_SkipsWhenNoConvoyID(t *testing.T) {
 func TestNotifyDeaconConvoyFeeding_AttemptsWhenConvoyID(t *testing.T) {
 	// notifyDeaconConvoyFeeding should attempt to send mail when ConvoyID is set.
 	// The send will fail (no beads setup in tmpdir) but we verify the attempt via output.
-	tmpDir, err := os.MkdirTemp("", "engineer-notify-test-*")
-	if err != nil {
-		t.Fatal(err)
-	}
-	defer os.RemoveAll(tmpDir)
+	tmpDir := t.TempDir()
+	// The events log is written under the town root found from the cwd. Run
+	// from tmpDir so it can't land in the source tree (internal/mayor makes
+	// internal/ look like a town root).
+	t.Chdir(tmpDir)
 
 	rigDir := filepath.Join(tmpDir, "testrig")
 	if err := os.MkdirAll(rigDir, 0755); err != nil {
@@ -0,0 +1,64 @@
+package rig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// nonRigDirs are town-root directories that are never rigs.
+// Hidden directories (including .git and .beads) are skipped separately.
+var nonRigDirs = map[string]bool{
+	"mayor":  true,
+	"deacon": true,
+	"daemon": true,
+	"docs":   true,
+}
+
+// rigMarkers are entries whose presence marks a directory as a rig.
+var rigMarkers = []string{"config.json", "crew", "polecats", "witness", "refinery", ".beads"}
+
+// Discover finds rigs by scanning the directories directly under root.
+// Unlike Manager.DiscoverRigs it does not need mayor/rigs.json, so it also
+// finds rigs that exist on disk but are not registered. Remote URLs are read
+// from each rig's config.json when present. Rigs are returned sorted by name.
+func Discover(root string) ([]*Rig, error) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("reading town root: %w", err)
+	}
+
+	var rigs []*Rig
+	for _, entry := range entries {
+		if !entry.IsDir() || !IsRigDir(root, entry.Name()) {
+			continue
+		}
+
+		r := &Rig{Name: entry.Name(), Path: filepath.Join(root, entry.Name())}
+		if cfg, err := LoadRigConfig(r.Path); err == nil {
+			r.GitURL = cfg.GitURL
+			r.PushURL = strings.TrimSpace(cfg.PushURL)
+			r.LocalRepo = cfg.LocalRepo
+		}
+		scanRigAgents(r)
+		rigs = append(rigs, r)
+	}
+	return rigs, nil
+}
+
+// IsRigDir reports whether root/name looks like a rig directory: not a
+// known town-level or hidden directory, and containing a rig marker
+// (config.json, crew/, polecats/, witness/, refinery/, or .beads/).
+func IsRigDir(root, name string) bool {
+	if name == "" || strings.HasPrefix(name, ".") || nonRigDirs[name] {
+		return false
+	}
+	path := filepath.Join(root, name)
+	for _, marker := range rigMarkers {
+		if _, err := os.Stat(filepath.Join(path, marker)); err == nil {
+			return true
+		}
+	}
+	return false
+}
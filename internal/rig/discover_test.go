@@ -0,0 +1,63 @@
+package rig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDiscover(t *testing.T) {
+	root := t.TempDir()
+	mkdir := func(parts ...string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Join(append([]string{root}, parts...)...), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mkdir("gastown", "polecats", "toast")
+	mkdir("gastown", "crew", "max")
+	mkdir("gastown", "witness")
+	mkdir("beads", "refinery", "rig")
+	if err := os.WriteFile(filepath.Join(root, "beads", "config.json"),
+		[]byte(`{"type":"rig","name":"beads","git_url":"https://example.com/beads.git"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Not rigs: town-level dirs (even with markers), hidden dirs, plain dirs
+	mkdir("mayor", "rig")
+	mkdir("deacon", "crew")
+	mkdir("docs", "polecats")
+	mkdir(".beads")
+	mkdir(".hidden", "polecats")
+	mkdir("scratch")
+
+	rigs, err := Discover(root)
+	if err != nil {
+		t.Fatalf("Discover: %v", err)
+	}
+	if len(rigs) != 2 {
+		t.Fatalf("Discover found %d rigs, want 2: %+v", len(rigs), rigs)
+	}
+
+	beads, gastown := rigs[0], rigs[1]
+	if beads.Name != "beads" || gastown.Name != "gastown" {
+		t.Fatalf("rigs = %s, %s; want beads, gastown (sorted)", beads.Name, gastown.Name)
+	}
+	if beads.GitURL != "https://example.com/beads.git" || !beads.HasRefinery {
+		t.Errorf("beads = %+v, want git URL from config.json and refinery", beads)
+	}
+	if gastown.Path != filepath.Join(root, "gastown") {
+		t.Errorf("gastown.Path = %q", gastown.Path)
+	}
+	if len(gastown.Polecats) != 1 || gastown.Polecats[0] != "toast" ||
+		len(gastown.Crew) != 1 || !gastown.HasWitness {
+		t.Errorf("gastown agents = %+v", gastown)
+	}
+}
+
+func TestDiscover_MissingRoot(t *testing.T) {
+	if _, err := Discover(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("expected error for missing root")
+	}
+}
@@ -169,6 +169,14 @@ func (m *Manager) loadRig(name string, entry config.RigEntry) (*Rig, error) {
 		LocalRepo: entry.LocalRepo,
 		Config:    entry.BeadsConfig,
 	}
+	scanRigAgents(rig)
+	return rig, nil
+}
+
+// scanRigAgents fills in the rig's polecats, crew, and which singleton
+// agents (witness, refinery, mayor clone) exist on disk.
+func scanRigAgents(rig *Rig) {
+	rigPath := rig.Path
 
 	// Scan for polecats
 	polecatsDir := filepath.Join(rigPath, "polecats")
@@ -212,8 +220,33 @@ func (m *Manager) loadRig(name string, entry config.RigEntry) (*Rig, error) {
 	if _, err := os.Stat(mayorPath); err == nil {
 		rig.HasMayor = true
 	}
+}
 
-	return rig, nil
+// validateRigName rejects names that break agent ID parsing or collide with
+// town-level infrastructure.
+func validateRigName(name string) error {
+	// Agent IDs use format <prefix>-<rig>-<role>[-<name>] with hyphens as delimiters
+	if strings.ContainsAny(name, "-. ") {
+		sanitized := strings.NewReplacer("-", "_", ".", "_", " ", "_").Replace(name)
+		sanitized = strings.ToLower(sanitized)
+		return fmt.Errorf("rig name %q contains invalid characters; hyphens, dots, and spaces are reserved for agent ID parsing. Try %q instead (underscores are allowed)", name, sanitized)
+	}
+
+	// "hq" is special-cased by EnsureMetadata and dolt routing as the town-level alias.
+	if isReservedRigName(name) {
+		return fmt.Errorf("rig name %q is reserved for town-level infrastructure", name)
+	}
+	return nil
+}
+
+// isReservedRigName reports whether name is one of reservedRigNames.
+func isReservedRigName(name string) bool {
+	for _, reserved := range reservedRigNames {
+		if strings.EqualFold(name, reserved) {
+			return true
+		}
+	}
+	return false
 }
 
 // AddRigOptions configures rig creation.
@@ -273,20 +306,8 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 		return nil, ErrRigExists
 	}
 
-	// Validate rig name: reject characters that break agent ID parsing
-	// Agent IDs use format <prefix>-<rig>-<role>[-<name>] with hyphens as delimiters
-	if strings.ContainsAny(opts.Name, "-. ") {
-		sanitized := strings.NewReplacer("-", "_", ".", "_", " ", "_").Replace(opts.Name)
-		sanitized = strings.ToLower(sanitized)
-		return nil, fmt.Errorf("rig name %q contains invalid characters; hyphens, dots, and spaces are reserved for agent ID parsing. Try %q instead (underscores are allowed)", opts.Name, sanitized)
-	}
-
-	// Reject reserved names that collide with town-level infrastructure.
-	// "hq" is special-cased by EnsureMetadata and dolt routing as the town-level alias.
-	for _, reserved := range reservedRigNames {
-		if strings.EqualFold(opts.Name, reserved) {
-			return nil, fmt.Errorf("rig name %q is reserved for town-level infrastructure", opts.Name)
-		}
+	if err := validateRigName(opts.Name); err != nil {
+		return nil, err
 	}
 
 	rigPath := filepath.Join(m.townRoot, opts.Name)
@@ -1179,6 +1200,124 @@ func (m *Manager) RemoveRig(name string) error {
 	return nil
 }
 
+// RenameRig renames a registered rig. It moves the rig directory (a single
+// atomic rename on the same filesystem), updates the name in config.json,
+// re-links git worktrees to their new paths, and re-keys the registry entry.
+// The registry change is in memory only; callers save rigs.json.
+// If any step fails, completed steps are rolled back.
+func (m *Manager) RenameRig(oldName, newName string) error {
+	entry, ok := m.config.Rigs[oldName]
+	if !ok {
+		return ErrRigNotFound
+	}
+	if m.RigExists(newName) {
+		return ErrRigExists
+	}
+	if err := validateRigName(newName); err != nil {
+		return err
+	}
+
+	oldPath := filepath.Join(m.townRoot, oldName)
+	newPath := filepath.Join(m.townRoot, newName)
+	if _, err := os.Stat(newPath); err == nil {
+		return fmt.Errorf("directory already exists: %s", newPath)
+	}
+
+	// Worktree links hold absolute paths, so capture them before moving.
+	worktrees := rigWorktrees(oldPath)
+
+	if err := os.Rename(oldPath, newPath); err != nil {
+		return fmt.Errorf("renaming rig directory: %w", err)
+	}
+	rollbackMove := func() {
+		_ = os.Rename(newPath, oldPath)
+		_ = repairRigWorktrees(oldPath, newPath, oldPath, worktrees)
+	}
+
+	if err := repairRigWorktrees(newPath, oldPath, newPath, worktrees); err != nil {
+		rollbackMove()
+		return fmt.Errorf("repairing worktrees: %w", err)
+	}
+
+	if err := m.renameRigConfig(newPath, newName); err != nil {
+		rollbackMove()
+		return fmt.Errorf("updating config.json: %w", err)
+	}
+
+	m.config.Rigs[newName] = entry
+	delete(m.config.Rigs, oldName)
+	return nil
+}
+
+// rigRepoDirs returns the repositories under a rig that may own worktrees.
+func rigRepoDirs(rigPath string) []string {
+	return []string{
+		filepath.Join(rigPath, ".repo.git"),
+		filepath.Join(rigPath, "mayor", "rig"),
+	}
+}
+
+// rigRepoGit returns a Git for a bare (.git) or non-bare repository path.
+func rigRepoGit(repo string) *git.Git {
+	if filepath.Ext(repo) == ".git" {
+		return git.NewGitWithDir(repo, "")
+	}
+	return git.NewGit(repo)
+}
+
+// rigWorktrees returns, per owning repository (relative to rigPath), the
+// linked worktree paths registered under rigPath.
+func rigWorktrees(rigPath string) map[string][]string {
+	result := make(map[string][]string)
+	for _, repo := range rigRepoDirs(rigPath) {
+		if _, err := os.Stat(repo); err != nil {
+			continue
+		}
+		list, err := rigRepoGit(repo).WorktreeList()
+		if err != nil {
+			continue
+		}
+		rel, _ := filepath.Rel(rigPath, repo)
+		for _, wt := range list {
+			if wt.Path == repo || !strings.HasPrefix(wt.Path, rigPath+string(filepath.Separator)) {
+				continue
+			}
+			result[rel] = append(result[rel], wt.Path)
+		}
+	}
+	return result
+}
+
+// repairRigWorktrees re-links worktrees recorded under fromPath to their
+// location under toPath, running git worktree repair from each owning repo
+// (now located under rigPath).
+func repairRigWorktrees(rigPath, fromPath, toPath string, worktrees map[string][]string) error {
+	for rel, paths := range worktrees {
+		repo := filepath.Join(rigPath, rel)
+		moved := make([]string, 0, len(paths))
+		for _, p := range paths {
+			moved = append(moved, toPath+strings.TrimPrefix(p, fromPath))
+		}
+		if err := rigRepoGit(repo).WorktreeRepair(moved...); err != nil {
+			return fmt.Errorf("%s: %w", rel, err)
+		}
+	}
+	return nil
+}
+
+// renameRigConfig updates the name in a rig's config.json, if present.
+func (m *Manager) renameRigConfig(rigPath, newName string) error {
+	cfg, err := LoadRigConfig(rigPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	cfg.Name = newName
+	return m.saveRigConfig(rigPath, cfg)
+}
+
 // ListRigNames returns the names of all registered rigs.
 // RegisterRigOptions contains options for registering an existing rig directory.
 type RegisterRigOptions struct {
@@ -1206,16 +1345,8 @@ func (m *Manager) RegisterRig(opts RegisterRigOptions) (*RegisterRigResult, erro
 		return nil, ErrRigExists
 	}
 
-	if strings.ContainsAny(opts.Name, "-. ") {
-		sanitized := strings.NewReplacer("-", "_", ".", "_", " ", "_").Replace(opts.Name)
-		sanitized = strings.ToLower(sanitized)
-		return nil, fmt.Errorf("rig name %q contains invalid characters; hyphens, dots, and spaces are reserved for agent ID parsing. Try %q instead (underscores are allowed)", opts.Name, sanitized)
-	}
-
-	for _, reserved := range reservedRigNames {
-		if strings.EqualFold(opts.Name, reserved) {
-			return nil, fmt.Errorf("rig name %q is reserved for town-level infrastructure", opts.Name)
-		}
+	if err := validateRigName(opts.Name); err != nil {
+		return nil, err
 	}
 
 	rigPath := filepath.Join(m.townRoot, opts.Name)
@@ -204,6 +204,92 @@ func TestRemoveRigNotFound(t *testing.T) {
 	}
 }
 
+func TestRenameRig(t *testing.T) {
+	root, rigsConfig := setupTestTown(t)
+	rigsConfig.Rigs["oldrig"] = config.RigEntry{GitURL: "git@github.com:test/oldrig.git"}
+
+	// Rig with a bare repo and a polecat worktree linked to it
+	oldPath := filepath.Join(root, "oldrig")
+	src := filepath.Join(root, "src")
+	for _, args := range [][]string{
+		{"init", "--initial-branch=main", src},
+		{"-C", src, "-c", "user.email=t@t", "-c", "user.name=t", "commit", "--allow-empty", "-m", "init"},
+		{"clone", "--bare", src, filepath.Join(oldPath, ".repo.git")},
+		{"--git-dir", filepath.Join(oldPath, ".repo.git"), "worktree", "add",
+			filepath.Join(oldPath, "polecats", "toast", "oldrig"), "-b", "polecat/toast"},
+	} {
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(oldPath, "config.json"), []byte(`{"type":"rig","name":"oldrig"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	manager := NewManager(root, rigsConfig, git.NewGit(root))
+	if err := manager.RenameRig("oldrig", "newrig"); err != nil {
+		t.Fatalf("RenameRig: %v", err)
+	}
+
+	if manager.RigExists("oldrig") || !manager.RigExists("newrig") {
+		t.Errorf("registry not re-keyed: %v", rigsConfig.Rigs)
+	}
+	if rigsConfig.Rigs["newrig"].GitURL != "git@github.com:test/oldrig.git" {
+		t.Error("registry entry not preserved")
+	}
+	newPath := filepath.Join(root, "newrig")
+	if _, err := os.Stat(oldPath); !os.IsNotExist(err) {
+		t.Error("old rig directory still exists")
+	}
+	cfg, err := LoadRigConfig(newPath)
+	if err != nil || cfg.Name != "newrig" {
+		t.Errorf("config.json name = %v (err %v), want newrig", cfg, err)
+	}
+
+	// The moved worktree must still be usable
+	wt := filepath.Join(newPath, "polecats", "toast", "oldrig")
+	if out, err := exec.Command("git", "-C", wt, "status", "--porcelain").CombinedOutput(); err != nil {
+		t.Errorf("worktree broken after rename: %v\n%s", err, out)
+	}
+}
+
+func TestRenameRig_Errors(t *testing.T) {
+	root, rigsConfig := setupTestTown(t)
+	rigsConfig.Rigs["alpha"] = config.RigEntry{}
+	rigsConfig.Rigs["beta"] = config.RigEntry{}
+	createTestRig(t, root, "alpha")
+
+	manager := NewManager(root, rigsConfig, git.NewGit(root))
+
+	if err := manager.RenameRig("missing", "gamma"); err != ErrRigNotFound {
+		t.Errorf("rename missing rig = %v, want ErrRigNotFound", err)
+	}
+	if err := manager.RenameRig("alpha", "beta"); err != ErrRigExists {
+		t.Errorf("rename onto registered rig = %v, want ErrRigExists", err)
+	}
+	if err := manager.RenameRig("alpha", "bad-name"); err == nil {
+		t.Error("expected error for invalid rig name")
+	}
+	if err := manager.RenameRig("alpha", "hq"); err == nil {
+		t.Error("expected error for reserved rig name")
+	}
+
+	// Unregistered directory in the way
+	if err := os.MkdirAll(filepath.Join(root, "gamma"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := manager.RenameRig("alpha", "gamma"); err == nil {
+		t.Error("expected error when target directory exists")
+	}
+
+	if !manager.RigExists("alpha") {
+		t.Error("failed renames must leave the rig registered under its old name")
+	}
+	if _, err := os.Stat(filepath.Join(root, "alpha")); err != nil {
+		t.Errorf("failed renames must leave the rig directory in place: %v", err)
+	}
+}
+
 func TestAddRig_RejectsInvalidNames(t *testing.T) {
 	root, rigsConfig := setupTestTown(t)
 	manager := NewManager(root, rigsConfig, git.NewGit(root))
@@ -2,7 +2,13 @@
 package rig
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
 )
 
 // Rig represents a managed repository in the workspace.
@@ -73,6 +79,29 @@ func (r *Rig) Summary() RigSummary {
 	}
 }
 
+// PolecatCounterFunc counts a rig's polecats: those working, and all of them.
+type PolecatCounterFunc func(r *Rig) (active, total int, err error)
+
+// polecatCounter is used by PolecatCount. Polecat state lives in the polecat
+// package, which imports rig, so it registers the counter via
+// RegisterPolecatCounter from its init().
+var polecatCounter PolecatCounterFunc
+
+// RegisterPolecatCounter registers the function PolecatCount uses.
+func RegisterPolecatCounter(fn PolecatCounterFunc) {
+	polecatCounter = fn
+}
+
+// PolecatCount returns how many of the rig's polecats are working (active)
+// and how many it has in total. Returns an error if no counter is
+// registered, i.e. the polecat package isn't linked in.
+func (r *Rig) PolecatCount() (active, total int, err error) {
+	if polecatCounter == nil {
+		return 0, 0, fmt.Errorf("rig %s: no polecat counter registered", r.Name)
+	}
+	return polecatCounter(r)
+}
+
 // BeadsPath returns the path to use for beads operations.
 // Always returns the rig root path where .beads/ contains either:
 //   - A local beads database (when repo doesn't track .beads/)
@@ -96,3 +125,66 @@ func (r *Rig) DefaultBranch() string {
 	}
 	return cfg.DefaultBranch
 }
+
+// RepoBase returns the rig's shared repository: the bare repo (.repo.git)
+// that polecat and refinery worktrees hang off, or mayor/rig for legacy rigs
+// created before the bare repo architecture.
+func (r *Rig) RepoBase() (*git.Git, error) {
+	bareRepoPath := filepath.Join(r.Path, ".repo.git")
+	if info, err := os.Stat(bareRepoPath); err == nil && info.IsDir() {
+		return git.NewGitWithDir(bareRepoPath, ""), nil
+	}
+
+	mayorPath := filepath.Join(r.Path, "mayor", "rig")
+	if _, err := os.Stat(mayorPath); os.IsNotExist(err) {
+		return nil, fmt.Errorf("no repo base found (neither .repo.git nor mayor/rig exists)")
+	}
+	return git.NewGit(mayorPath), nil
+}
+
+// ValidationError lists the structural problems found by Rig.Validate.
+type ValidationError struct {
+	Rig      string
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("rig %q is not valid:\n  - %s", e.Rig, strings.Join(e.Problems, "\n  - "))
+}
+
+// Validate checks the rig's directory structure for consistency. It returns
+// a *ValidationError listing every problem found (a reserved name, or a
+// missing rig directory, witness, refinery or repository), or nil.
+//
+// Character rules for names are only enforced when a rig is added, so
+// existing rigs with older names still validate.
+func (r *Rig) Validate() error {
+	var problems []string
+	if isReservedRigName(r.Name) {
+		problems = append(problems, fmt.Sprintf("name %q is reserved for town-level infrastructure", r.Name))
+	}
+
+	if !isDir(r.Path) {
+		problems = append(problems, fmt.Sprintf("rig directory %s does not exist", r.Path))
+		return &ValidationError{Rig: r.Name, Problems: problems}
+	}
+	for _, dir := range []string{"witness", "refinery"} {
+		if !isDir(filepath.Join(r.Path, dir)) {
+			problems = append(problems, fmt.Sprintf("missing %s/ directory", dir))
+		}
+	}
+	if !isDir(filepath.Join(r.Path, ".repo.git")) && !isDir(filepath.Join(r.Path, "mayor", "rig")) {
+		problems = append(problems, "missing repository: neither .repo.git nor mayor/rig exists")
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Rig: r.Name, Problems: problems}
+	}
+	return nil
+}
+
+// isDir reports whether path exists and is a directory.
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
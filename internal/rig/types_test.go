@@ -1,6 +1,10 @@
 package rig
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -144,3 +148,92 @@ func TestDefaultBranch_FallsBackToMain(t *testing.T) {
 		t.Errorf("DefaultBranch() = %q, want %q", got, "main")
 	}
 }
+
+func TestRepoBase(t *testing.T) {
+	t.Parallel()
+
+	r := Rig{Name: "testrig", Path: t.TempDir()}
+	if _, err := r.RepoBase(); err == nil {
+		t.Error("RepoBase() with no repo should fail")
+	}
+
+	// Legacy rigs fall back to mayor/rig
+	mayorPath := filepath.Join(r.Path, "mayor", "rig")
+	if err := os.MkdirAll(mayorPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	g, err := r.RepoBase()
+	if err != nil {
+		t.Fatalf("RepoBase() error = %v", err)
+	}
+	if g.WorkDir() != mayorPath {
+		t.Errorf("RepoBase() work dir = %q, want %q", g.WorkDir(), mayorPath)
+	}
+
+	// The shared bare repo is preferred when present
+	if err := os.MkdirAll(filepath.Join(r.Path, ".repo.git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	g, err = r.RepoBase()
+	if err != nil {
+		t.Fatalf("RepoBase() error = %v", err)
+	}
+	if g.WorkDir() != "" {
+		t.Errorf("RepoBase() work dir = %q, want the bare repo", g.WorkDir())
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	r := Rig{Name: "testrig", Path: t.TempDir()}
+	err := r.Validate()
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Validate() on an empty rig = %v, want a *ValidationError", err)
+	}
+	if len(verr.Problems) != 3 {
+		t.Errorf("Problems = %q, want witness, refinery and repository", verr.Problems)
+	}
+
+	for _, dir := range []string{"witness", filepath.Join("refinery", "rig"), filepath.Join("mayor", "rig")} {
+		if err := os.MkdirAll(filepath.Join(r.Path, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := r.Validate(); err != nil {
+		t.Errorf("Validate() on a complete rig = %v, want nil", err)
+	}
+
+	// Every problem is reported, not just the first
+	reserved := Rig{Name: "HQ", Path: filepath.Join(t.TempDir(), "missing")}
+	err = reserved.Validate()
+	if !errors.As(err, &verr) || len(verr.Problems) != 2 {
+		t.Fatalf("Validate() = %v, want reserved name and missing directory", err)
+	}
+	if !strings.Contains(err.Error(), "reserved") || !strings.Contains(err.Error(), "does not exist") {
+		t.Errorf("Error() = %q", err.Error())
+	}
+}
+
+func TestPolecatCount(t *testing.T) {
+	old := polecatCounter
+	t.Cleanup(func() { polecatCounter = old })
+
+	r := &Rig{Name: "gastown"}
+	polecatCounter = nil
+	if _, _, err := r.PolecatCount(); err == nil {
+		t.Error("PolecatCount with no counter registered should fail")
+	}
+
+	RegisterPolecatCounter(func(r *Rig) (int, int, error) {
+		if r.Name != "gastown" {
+			return 0, 0, errors.New("wrong rig")
+		}
+		return 2, 5, nil
+	})
+	active, total, err := r.PolecatCount()
+	if err != nil || active != 2 || total != 5 {
+		t.Errorf("PolecatCount = %d, %d, %v; want 2, 5, nil", active, total, err)
+	}
+}
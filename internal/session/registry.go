@@ -3,11 +3,14 @@ package session
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 	"sync"
+
+	"github.com/steveyegge/gastown/internal/constants"
 )
 
 // PrefixRegistry maps beads prefixes to rig names and vice versa.
@@ -82,6 +85,72 @@ func (r *PrefixRegistry) Prefixes() []string {
 	return prefixes
 }
 
+// PrefixEntry is a single prefix↔rig mapping in the registry.
+type PrefixEntry struct {
+	Prefix  string
+	RigName string
+}
+
+// List returns all registered mappings sorted by rig name.
+// Used for shell completion of rig name arguments.
+func (r *PrefixRegistry) List() []PrefixEntry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	entries := make([]PrefixEntry, 0, len(r.rigToPrefix))
+	for rig, prefix := range r.rigToPrefix {
+		entries = append(entries, PrefixEntry{Prefix: prefix, RigName: rig})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].RigName < entries[j].RigName
+	})
+	return entries
+}
+
+// Save writes the registry to path as a JSON object of rig name → prefix,
+// replacing any existing file atomically.
+func (r *PrefixRegistry) Save(path string) error {
+	data, err := json.MarshalIndent(r.AllRigs(), "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating registry directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing prefix registry: %w", err)
+	}
+	return os.Rename(tmp, path)
+}
+
+// Load replaces the registry's mappings with those saved at path by Save.
+func (r *PrefixRegistry) Load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var rigs map[string]string
+	if err := json.Unmarshal(data, &rigs); err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.prefixToRig = make(map[string]string, len(rigs))
+	r.rigToPrefix = make(map[string]string, len(rigs))
+	for rigName, prefix := range rigs {
+		r.prefixToRig[prefix] = rigName
+		r.rigToPrefix[rigName] = prefix
+	}
+	return nil
+}
+
+// RegistryCachePath returns where SaveRegistry keeps the town's last known
+// prefix registry (<town>/.runtime/prefix-registry.json).
+func RegistryCachePath(townRoot string) string {
+	return filepath.Join(townRoot, constants.DirRuntime, "prefix-registry.json")
+}
+
 // defaultRegistry is the package-level registry used by convenience functions.
 var defaultRegistry = NewPrefixRegistry()
 
@@ -96,17 +165,35 @@ func SetDefaultRegistry(r *PrefixRegistry) {
 }
 
 // InitRegistry populates the default registry from the town's rigs.json.
+// If rigs.json can't be read (e.g. it is mid-rewrite or corrupt), the cache
+// written by SaveRegistry is loaded instead and the rigs.json error returned.
 // Should be called early in the process lifecycle.
 // Safe to call multiple times; later calls replace earlier data.
 func InitRegistry(townRoot string) error {
 	r, err := BuildPrefixRegistryFromTown(townRoot)
 	if err != nil {
+		cached := NewPrefixRegistry()
+		if cached.Load(RegistryCachePath(townRoot)) == nil {
+			SetDefaultRegistry(cached)
+		}
 		return err
 	}
 	SetDefaultRegistry(r)
 	return nil
 }
 
+// SaveRegistry reloads the default registry from the town's rigs.json and
+// saves it to RegistryCachePath. Commands that change rig prefixes (adding,
+// renaming or removing rigs) call it after saving rigs.json.
+func SaveRegistry(townRoot string) error {
+	r, err := BuildPrefixRegistryFromTown(townRoot)
+	if err != nil {
+		return err
+	}
+	SetDefaultRegistry(r)
+	return r.Save(RegistryCachePath(townRoot))
+}
+
 // PrefixFor returns the beads prefix for a rig, using the default registry.
 // Returns DefaultPrefix if the rig is unknown.
 func PrefixFor(rigName string) string {
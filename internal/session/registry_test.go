@@ -0,0 +1,99 @@
+package session
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestPrefixRegistry_List(t *testing.T) {
+	r := NewPrefixRegistry()
+	r.Register("gt", "gastown")
+	r.Register("bd", "beads")
+	r.Register("ap", "api")
+
+	want := []PrefixEntry{
+		{Prefix: "ap", RigName: "api"},
+		{Prefix: "bd", RigName: "beads"},
+		{Prefix: "gt", RigName: "gastown"},
+	}
+	if got := r.List(); !reflect.DeepEqual(got, want) {
+		t.Errorf("List() = %v, want %v", got, want)
+	}
+}
+
+func TestPrefixRegistry_ListEmpty(t *testing.T) {
+	r := NewPrefixRegistry()
+
+	got := r.List()
+	if got == nil {
+		t.Fatal("List() on empty registry returned nil, want empty slice")
+	}
+	if len(got) != 0 {
+		t.Errorf("List() on empty registry = %v, want empty", got)
+	}
+}
+
+func TestPrefixRegistry_ListReRegister(t *testing.T) {
+	r := NewPrefixRegistry()
+	r.Register("gt", "gastown")
+	r.Register("gs", "gastown") // prefix changed for the same rig
+
+	got := r.List()
+	if len(got) != 1 || got[0].Prefix != "gs" {
+		t.Errorf("List() = %v, want single entry with latest prefix", got)
+	}
+}
+
+func TestPrefixRegistry_SaveLoad(t *testing.T) {
+	r := NewPrefixRegistry()
+	r.Register("gt", "gastown")
+	r.Register("bd", "beads")
+
+	path := filepath.Join(t.TempDir(), "nested", "prefix-registry.json")
+	if err := r.Save(path); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+
+	loaded := NewPrefixRegistry()
+	loaded.Register("old", "stale") // Load replaces existing mappings
+	if err := loaded.Load(path); err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if got, want := loaded.List(), r.List(); !reflect.DeepEqual(got, want) {
+		t.Errorf("loaded List() = %v, want %v", got, want)
+	}
+	if got := loaded.RigForPrefix("gt"); got != "gastown" {
+		t.Errorf("RigForPrefix(gt) = %q, want gastown", got)
+	}
+}
+
+func TestInitRegistry_FallsBackToCache(t *testing.T) {
+	orig := DefaultRegistry()
+	defer SetDefaultRegistry(orig)
+
+	townRoot := t.TempDir()
+	rigsPath := filepath.Join(townRoot, "mayor", "rigs.json")
+	if err := os.MkdirAll(filepath.Dir(rigsPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(rigsPath, []byte(`{"rigs":{"gastown":{"beads":{"prefix":"gt"}}}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveRegistry(townRoot); err != nil {
+		t.Fatalf("SaveRegistry() error: %v", err)
+	}
+
+	// Corrupt rigs.json: InitRegistry reports it but keeps the cached mappings.
+	if err := os.WriteFile(rigsPath, []byte("{not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	SetDefaultRegistry(NewPrefixRegistry())
+	if err := InitRegistry(townRoot); err == nil {
+		t.Error("InitRegistry() with corrupt rigs.json should return an error")
+	}
+	if got := PrefixFor("gastown"); got != "gt" {
+		t.Errorf("PrefixFor(gastown) = %q, want gt from the cache", got)
+	}
+}
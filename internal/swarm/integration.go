@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"os/exec"
 	"strings"
+
+	"github.com/steveyegge/gastown/internal/git"
 )
 
 // Integration branch errors
@@ -184,8 +186,8 @@ func (m *Manager) GetWorkerBranch(swarmID, worker, taskID string) string {
 
 // branchExists checks if a branch exists locally.
 func (m *Manager) branchExists(branch string) bool {
-	err := m.gitRun("show-ref", "--verify", "--quiet", "refs/heads/"+branch)
-	return err == nil
+	exists, err := git.NewGit(m.gitDir).BranchExists(branch)
+	return err == nil && exists
 }
 
 // getCurrentBranch returns the current branch name.
@@ -752,6 +752,30 @@ func (t *Tmux) ListSessionIDs() (map[string]string, error) {
 	return result, nil
 }
 
+// ListSessionsCreated returns a map of session name to the Unix time the
+// session was created (#{session_created}), from a single list-sessions call.
+func (t *Tmux) ListSessionsCreated() (map[string]int64, error) {
+	out, err := t.run("list-sessions", "-F", "#{session_name}:#{session_created}")
+	if err != nil {
+		if errors.Is(err, ErrNoServer) {
+			return nil, nil // No server = no sessions
+		}
+		return nil, err
+	}
+
+	result := make(map[string]int64)
+	for _, line := range strings.Split(out, "\n") {
+		idx := strings.LastIndex(line, ":")
+		if idx <= 0 {
+			continue
+		}
+		// Sessions whose time doesn't parse are still listed, with 0
+		created, _ := strconv.ParseInt(line[idx+1:], 10, 64)
+		result[line[:idx]] = created
+	}
+	return result, nil
+}
+
 // SendKeys sends keystrokes to a session and presses Enter.
 // Always sends Enter as a separate command for reliability.
 // Uses a debounce delay between paste and Enter to ensure paste completes.
@@ -1192,6 +1216,35 @@ func (t *Tmux) GetPaneWorkDir(session string) (string, error) {
 	return result, nil
 }
 
+// GetPaneStartCommand returns the command pane 0 was started with, as
+// passed to NewSessionWithCommand. Returns "" if the pane was started
+// with the default shell.
+func (t *Tmux) GetPaneStartCommand(session string) (string, error) {
+	out, err := t.run("display-message", "-t", session+":0.0", "-p", "#{pane_start_command}")
+	if err != nil {
+		return "", err
+	}
+	return unquoteStartCommand(strings.TrimSpace(out)), nil
+}
+
+// unquoteStartCommand undoes the quoting tmux applies to pane_start_command:
+// a command with spaces is wrapped in double quotes, with ", $ and \
+// backslash-escaped.
+func unquoteStartCommand(s string) string {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return s
+	}
+	var sb strings.Builder
+	inner := s[1 : len(s)-1]
+	for i := 0; i < len(inner); i++ {
+		if inner[i] == '\\' && i+1 < len(inner) {
+			i++
+		}
+		sb.WriteByte(inner[i])
+	}
+	return sb.String()
+}
+
 // GetPanePID returns the PID of the pane's main process.
 // When target is a session name, explicitly targets pane 0 (:0.0) to avoid
 // returning the active pane's PID in multi-pane sessions. When target is
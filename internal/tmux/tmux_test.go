@@ -2252,3 +2252,70 @@ func TestCheckSessionHealth_ActivityCheck(t *testing.T) {
 	// (if the agent were actually running). This tests the activity threshold logic
 	// without needing a real Claude process.
 }
+
+func TestGetPaneStartCommand(t *testing.T) {
+	if !hasTmux() {
+		t.Skip("tmux not installed")
+	}
+
+	tm := NewTmux()
+	sessionName := "gt-test-startcmd-" + t.Name()
+
+	_ = tm.KillSession(sessionName)
+
+	command := `echo "a $HOME \ b"; exec sleep 300`
+	if err := tm.NewSessionWithCommand(sessionName, "", command); err != nil {
+		t.Fatalf("NewSessionWithCommand: %v", err)
+	}
+	defer func() { _ = tm.KillSession(sessionName) }()
+
+	got, err := tm.GetPaneStartCommand(sessionName)
+	if err != nil {
+		t.Fatalf("GetPaneStartCommand: %v", err)
+	}
+	if got != command {
+		t.Errorf("GetPaneStartCommand = %q, want %q", got, command)
+	}
+}
+
+func TestUnquoteStartCommand(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"", ""},
+		{"bash", "bash"},
+		{`"sleep 300"`, "sleep 300"},
+		{`"echo \"a \$HOME \\\\ b\""`, `echo "a $HOME \\ b"`},
+	}
+	for _, tt := range tests {
+		if got := unquoteStartCommand(tt.in); got != tt.want {
+			t.Errorf("unquoteStartCommand(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestListSessionsCreated(t *testing.T) {
+	if !hasTmux() {
+		t.Skip("tmux not installed")
+	}
+
+	tm := NewTmux()
+	sessionName := "gt-test-created-" + t.Name()
+	_ = tm.KillSession(sessionName)
+	if err := tm.NewSession(sessionName, ""); err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	defer func() { _ = tm.KillSession(sessionName) }()
+
+	created, err := tm.ListSessionsCreated()
+	if err != nil {
+		t.Fatalf("ListSessionsCreated: %v", err)
+	}
+	want, err := tm.GetSessionCreatedUnix(sessionName)
+	if err != nil {
+		t.Fatalf("GetSessionCreatedUnix: %v", err)
+	}
+	if created[sessionName] != want || want <= 0 {
+		t.Errorf("created[%s] = %d, want %d", sessionName, created[sessionName], want)
+	}
+}